@@ -0,0 +1,42 @@
+// repair-ratings คำนวณยอดรวม rating ของทุก recipe ใหม่จากตาราง ratings
+// ใช้เมื่อยอดใน food_recipes ไม่ตรง เช่น หลังแก้ข้อมูลใน database โดยตรง
+package main
+
+import (
+	"log"
+	"wongnok/internal/config"
+	"wongnok/internal/rating"
+
+	"github.com/caarlos0/env/v11"
+	_ "github.com/joho/godotenv/autoload"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func main() {
+	// Load configuration
+	var conf config.Database
+
+	if err := env.Parse(&conf); err != nil {
+		log.Fatal("Error when decoding configuration:", err)
+	}
+
+	// Database connection
+	db, err := gorm.Open(postgres.Open(conf.URL), &gorm.Config{})
+	if err != nil {
+		log.Fatal("Error when connect to database:", err)
+	}
+	// Ensure close connection when terminated
+	defer func() {
+		sqldb, _ := db.DB()
+		sqldb.Close()
+	}()
+
+	repaired, err := rating.NewService(db).RepairSummaries()
+	if err != nil {
+		log.Fatal("Error when repair rating summaries:", err)
+	}
+
+	log.Printf("Repaired rating summaries of %d recipes", repaired)
+}
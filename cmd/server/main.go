@@ -3,12 +3,19 @@ package main
 import (
 	"context"
 	"log"
+	"wongnok/internal/account"
 	"wongnok/internal/auth"
+	"wongnok/internal/comment"
 	"wongnok/internal/config"
+	"wongnok/internal/cookingduration"
+	"wongnok/internal/difficulty"
+	"wongnok/internal/email"
 	"wongnok/internal/foodrecipe"
 	"wongnok/internal/middleware"
+	"wongnok/internal/model"
 	"wongnok/internal/rating"
 	"wongnok/internal/users"
+	"wongnok/internal/view"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/coreos/go-oidc"
@@ -28,7 +35,9 @@ import (
 
 	//ส่วนเพิ่ม
     "wongnok/internal/favorites"
-    "wongnok/internal/profile"
+    "wongnok/internal/feed"
+    "wongnok/internal/notification"
+    "wongnok/internal/preference"
 
 	
 
@@ -67,9 +76,32 @@ func main() {
 	}
 	verifierSkipClientIDCheck := provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
 
+	// View recorder
+	viewRecorder := view.NewRecorder(db, conf.View)
+	viewRecorder.Start()
+	// Ensure pending views are saved when terminated
+	defer viewRecorder.Close()
+
+	// Email digest ส่งผ่าน outbox
+	if conf.Mail.Enabled() {
+		mailDispatcher := email.NewDispatcher(db, conf.Mail, conf.Trending, conf.Rating)
+		mailDispatcher.Start()
+		// Ensure the current round finishes when terminated
+		defer mailDispatcher.Close()
+	}
+
+	// ลบข้อมูลส่วนตัวของบัญชีที่พ้นช่วงผ่อนผัน
+	accountPurger := account.NewPurger(db, conf.Account)
+	accountPurger.Start()
+	// Ensure the current round finishes when terminated
+	defer accountPurger.Close()
+
 	// Handler
-	foodRecipeHandler := foodrecipe.NewHandler(db)
+	foodRecipeHandler := foodrecipe.NewHandler(db, conf.Concurrency, conf.Trending, conf.Rating, viewRecorder)
 	ratingHandler := rating.NewHandler(db)
+	commentHandler := comment.NewHandler(db, conf.Comment)
+	difficultyHandler := difficulty.NewHandler(db)
+	cookingDurationHandler := cookingduration.NewHandler(db)
 	authHandler := auth.NewHandler(
 		db,
 		conf.Keycloak,
@@ -90,9 +122,12 @@ func main() {
 	
 	// Handlerส่วนเพิ่ม
 	favoriteHandler := favorite.NewHandler(db)
-	profileHandler := profile.NewHandler(db)
 	
 	userHandler := user.NewHandler(db)
+	feedHandler := feed.NewHandler(db)
+	notificationHandler := notification.NewHandler(db)
+	preferenceHandler := preference.NewHandler(db)
+	accountHandler := account.NewHandler(db, conf.Account)
 
 	// Router
 	router := gin.Default()
@@ -103,9 +138,9 @@ func main() {
 	// Middleware
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:3000"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Content-Type", "Authorization", "If-Match", "If-None-Match", "If-Modified-Since"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", "Last-Modified"},
 		AllowCredentials: true,
 	}));
 
@@ -116,46 +151,100 @@ func main() {
 
 	// Food recipe
 	group.POST("/food-recipes", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Create)
-	group.GET("/food-recipes", foodRecipeHandler.Get)
-	group.GET("/food-recipes/:id", foodRecipeHandler.GetByID)
+	group.GET("/food-recipes", middleware.OptionalAuthorize(verifierSkipClientIDCheck), middleware.CacheControl(conf.Cache.RecipeList), foodRecipeHandler.Get)
+	group.GET("/food-recipes/trending", middleware.OptionalAuthorize(verifierSkipClientIDCheck), middleware.CacheControl(conf.Cache.RecipeTrending), foodRecipeHandler.GetTrending)
+	group.GET("/food-recipes/:id", middleware.OptionalAuthorize(verifierSkipClientIDCheck), middleware.CacheControl(conf.Cache.RecipeDetail), foodRecipeHandler.GetByID)
 	group.PUT("/food-recipes/:id", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Update)
+	group.PATCH("/food-recipes/:id", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Patch)
 	group.DELETE("/food-recipes/:id", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Delete)
 
 	// Rating
-	group.GET("/food-recipes/:id/ratings", ratingHandler.Get)
+	group.GET("/food-recipes/:id/ratings", middleware.OptionalAuthorize(verifierSkipClientIDCheck), ratingHandler.Get)
 	group.POST("/food-recipes/:id/ratings", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Create)
+	group.GET("/food-recipes/:id/ratings/me", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.GetMine)
+	group.PUT("/food-recipes/:id/ratings/me", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.UpdateMine)
+	group.DELETE("/food-recipes/:id/ratings/me", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.DeleteMine)
+	group.PUT("/food-recipes/:id/ratings/:ratingId/vote", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Vote)
+	group.DELETE("/food-recipes/:id/ratings/:ratingId/vote", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Unvote)
+
+	// Comment
+	group.GET("/food-recipes/:id/comments", middleware.OptionalAuthorize(verifierSkipClientIDCheck), commentHandler.Get)
+	group.POST("/food-recipes/:id/comments", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Create)
+	group.PUT("/food-recipes/:id/comments/:commentId", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Update)
+	group.DELETE("/food-recipes/:id/comments/:commentId", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Delete)
+	group.PUT("/food-recipes/:id/comments/:commentId/pin", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Pin)
+	group.DELETE("/food-recipes/:id/comments/:commentId/pin", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Unpin)
+
+	// Reference data
+	group.GET("/difficulties", difficultyHandler.Get)
+	group.POST("/difficulties", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), difficultyHandler.Create)
+	group.PUT("/difficulties/:id", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), difficultyHandler.Update)
+	group.PUT("/difficulties/:id/retire", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), difficultyHandler.Retire)
+	group.DELETE("/difficulties/:id/retire", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), difficultyHandler.Restore)
+	group.GET("/cooking-durations", cookingDurationHandler.Get)
+	group.POST("/cooking-durations", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), cookingDurationHandler.Create)
+	group.PUT("/cooking-durations/:id", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), cookingDurationHandler.Update)
+	group.PUT("/cooking-durations/:id/retire", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), cookingDurationHandler.Retire)
+	group.DELETE("/cooking-durations/:id/retire", middleware.Authorize(verifierSkipClientIDCheck), middleware.RequirePermission(model.PermissionManageReferenceData), cookingDurationHandler.Restore)
 
 	// Auth
 	group.GET("/login", authHandler.Login)
 	group.GET("/callback", authHandler.Callback)
 	group.GET("/logout", authHandler.Logout)
+	group.POST("/token/refresh", authHandler.Refresh)
 
 	// User
 	group.GET("/users/:id/food-recipes", middleware.Authorize(verifierSkipClientIDCheck), userHandler.GetRecipes)
 	group.GET("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Get)
 	group.POST("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Create)
 	group.PUT("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Update)
+	group.PATCH("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Patch)
 	//group.DELETE("/users/:id", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Delete)
 	
+	// Favorite
+	group.GET("/users/self/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.GetMine)
+	group.PUT("/users/self/favorites/:recipeId", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.PutMine)
+	group.DELETE("/users/self/favorites/:recipeId", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.DeleteMine)
+
+	// Profile แก้ไขผ่าน PUT/PATCH /users/ ส่วน :id รับได้ทั้ง id, @handle และ self
+	group.GET("/users/:id/profile", middleware.OptionalAuthorize(verifierSkipClientIDCheck), userHandler.GetProfile)
+	group.PUT("/users/self/handle", middleware.Authorize(verifierSkipClientIDCheck), userHandler.ChangeHandle)
+
+	// Follow
+	group.GET("/users/:id/followers", middleware.OptionalAuthorize(verifierSkipClientIDCheck), userHandler.GetFollowers)
+	group.GET("/users/:id/following", middleware.OptionalAuthorize(verifierSkipClientIDCheck), userHandler.GetFollowing)
+	group.PUT("/users/self/following/:userId", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Follow)
+	group.DELETE("/users/self/following/:userId", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Unfollow)
+
+	// Block / Mute
+	group.GET("/users/self/blocks", middleware.Authorize(verifierSkipClientIDCheck), userHandler.GetBlocked)
+	group.PUT("/users/self/blocks/:userId", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Block)
+	group.DELETE("/users/self/blocks/:userId", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Unblock)
+	group.GET("/users/self/mutes", middleware.Authorize(verifierSkipClientIDCheck), userHandler.GetMuted)
+	group.PUT("/users/self/mutes/:userId", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Mute)
+	group.DELETE("/users/self/mutes/:userId", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Unmute)
+
+	// Feed
+	group.GET("/feed", middleware.Authorize(verifierSkipClientIDCheck), feedHandler.Get)
+
+	// Notification
+	group.GET("/users/self/notifications", middleware.Authorize(verifierSkipClientIDCheck), notificationHandler.GetMine)
+	group.GET("/users/self/notifications/unread-count", middleware.Authorize(verifierSkipClientIDCheck), notificationHandler.CountUnread)
+	group.PUT("/users/self/notifications/read-all", middleware.Authorize(verifierSkipClientIDCheck), notificationHandler.MarkAllRead)
+	group.PUT("/users/self/notifications/:notificationId/read", middleware.Authorize(verifierSkipClientIDCheck), notificationHandler.MarkRead)
+	group.GET("/users/self/notification-preferences", middleware.Authorize(verifierSkipClientIDCheck), notificationHandler.GetPreferences)
+	group.PUT("/users/self/notification-preferences", middleware.Authorize(verifierSkipClientIDCheck), notificationHandler.UpdatePreferences)
+
+	// Preferences ค่าเริ่มต้นของรายการ recipe สำหรับผู้ใช้ที่ login
+	group.GET("/users/self/preferences", middleware.Authorize(verifierSkipClientIDCheck), preferenceHandler.Get)
+	group.PUT("/users/self/preferences", middleware.Authorize(verifierSkipClientIDCheck), preferenceHandler.Update)
+
+	// Account ขอข้อมูลและขอลบตาม PDPA
+	group.GET("/users/self/export", middleware.Authorize(verifierSkipClientIDCheck), accountHandler.Export)
+	group.DELETE("/users/self", middleware.Authorize(verifierSkipClientIDCheck), accountHandler.Delete)
+
+	// router.Run ทำงานจนปิด server route ทุกอันจึงต้องลงทะเบียนก่อนหน้านี้
 	if err := router.Run(":8000"); err != nil {
 		log.Fatal("Server error:", err)
 	}
-
-	
-	// Route ส่วนเพิ่ม
-	group.POST("/users/:id/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Create)
-	group.DELETE("/users/:id/favorites/:recipeId", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Delete)
-
-	group.GET("/users/:id/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Get)
-
-	group.POST("/users/:id/profile", middleware.Authorize(verifierSkipClientIDCheck), profileHandler.Update)
-	group.GET("/users/:id/profile", middleware.Authorize(verifierSkipClientIDCheck), profileHandler.Get)
-
-
-	/*// Favorite
-	group.POST("/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Add)
-	group.GET("/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Get)
-	//group.PUT("/favorites/:id", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.)
-	group.DELETE("/favorites/:id", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Remove)*/
-
 }
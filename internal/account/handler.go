@@ -0,0 +1,146 @@
+package account
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Export(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB, conf config.Account) *Handler {
+	return &Handler{
+		Service: NewService(db, conf),
+	}
+}
+
+// Export godoc
+// @Summary Export the caller's personal data
+// @Description Download a ZIP containing profile.json, recipes.json, ratings.json and favorites.json
+// @Tags users
+// @Produce application/zip
+// @Success 200 {file} file
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/export [get]
+func (handler Handler) Export(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	export, err := handler.Service.Export(claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	filename := fmt.Sprintf("wongnok-export-%s.zip", time.Now().Format("20060102"))
+	ctx.Header("Content-Type", "application/zip")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Status(http.StatusOK)
+
+	// ส่ง header ไปแล้ว error ระหว่างเขียนทำได้แค่ log
+	if err := WriteExport(ctx.Writer, export.ToResponse()); err != nil {
+		log.Println("Error when write export:", err)
+	}
+}
+
+// WriteExport เขียน ZIP ลง writer โดยตรงโดยไม่พักทั้งไฟล์ไว้ในหน่วยความจำ
+func WriteExport(writer io.Writer, export dto.AccountExportResponse) error {
+	archive := zip.NewWriter(writer)
+
+	files := []struct {
+		name string
+		data any
+	}{
+		{"profile.json", export.Profile},
+		{"recipes.json", export.Recipes},
+		{"ratings.json", export.Ratings},
+		{"favorites.json", export.Favorites},
+	}
+
+	for _, file := range files {
+		entry, err := archive.Create(file.name)
+		if err != nil {
+			return errors.Wrap(err, "create "+file.name)
+		}
+
+		encoder := json.NewEncoder(entry)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return errors.Wrap(err, "write "+file.name)
+		}
+	}
+
+	return archive.Close()
+}
+
+// Delete godoc
+// @Summary Delete the caller's account
+// @Description The account is deactivated immediately and personal data is purged after the grace period. Logging in before then cancels the deletion. Recipes are transferred to another user or deleted.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body dto.AccountDeletionRequest true "What to do with the caller's recipes"
+// @Success 202 {object} dto.AccountDeletionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.AccountDeletionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	deletion, err := handler.Service.Delete(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, deletion.ToResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, global.ErrInvalidTransfer):
+		return http.StatusBadRequest
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,267 @@
+package account_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/account"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := account.NewHandler(&gorm.DB{}, config.Account{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler account.IHandler
+	service *MockIService
+
+	// Helper
+	server func(method string, url string, body string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = account.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, body string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/users/self/export", suite.handler.Export)
+		router.DELETE("/api/v1/users/self", suite.handler.Delete)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, strings.NewReader(body))
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// readZip คืนเนื้อหาแต่ละไฟล์ใน ZIP ตามชื่อ
+func (suite *HandlerTestSuite) readZip(body []byte) map[string]string {
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	suite.NoError(err)
+
+	files := map[string]string{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		suite.NoError(err)
+
+		content, err := io.ReadAll(reader)
+		suite.NoError(err)
+		reader.Close()
+
+		files[file.Name] = string(content)
+	}
+
+	return files
+}
+
+type HandlerExportTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respServiceExport model.AccountExport
+	errServiceExport  error
+}
+
+func (suite *HandlerExportTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.respServiceExport = model.AccountExport{
+		User:      model.User{ID: "UID", FirstName: "Demo", Email: "demo@example.com"},
+		Recipes:   model.FoodRecipes{{Name: "Omlet"}},
+		Ratings:   model.Ratings{{Score: 4, Title: "Good"}},
+		Favorites: model.FoodRecipes{},
+	}
+	suite.errServiceExport = nil
+
+	suite.service.On("Export", mock.Anything).Return(func(model.Claims) (model.AccountExport, error) {
+		return suite.respServiceExport, suite.errServiceExport
+	})
+}
+
+func (suite *HandlerExportTestSuite) TestResponseZip() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/export", "", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("application/zip", response.Header().Get("Content-Type"))
+	suite.Contains(response.Header().Get("Content-Disposition"), "attachment")
+	suite.service.AssertCalled(suite.T(), "Export", claims)
+
+	files := suite.readZip(response.Body.Bytes())
+	suite.Len(files, 4)
+
+	var profile dto.AccountResponse
+	suite.NoError(json.Unmarshal([]byte(files["profile.json"]), &profile))
+	suite.Equal("demo@example.com", profile.Email)
+
+	var recipes dto.FoodRecipesResponse
+	suite.NoError(json.Unmarshal([]byte(files["recipes.json"]), &recipes))
+	suite.Equal("Omlet", recipes.Results[0].Name)
+
+	var ratings dto.RatingsResponse
+	suite.NoError(json.Unmarshal([]byte(files["ratings.json"]), &ratings))
+	suite.Equal("Good", ratings.Results[0].Title)
+
+	var favorites dto.FoodRecipesResponse
+	suite.NoError(json.Unmarshal([]byte(files["favorites.json"]), &favorites))
+	suite.Empty(favorites.Results)
+}
+
+func (suite *HandlerExportTestSuite) TestResponseStatusCode401WhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/export", "", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Export", mock.Anything)
+}
+
+func (suite *HandlerExportTestSuite) TestResponseStatusCode404WhenUserNotFound() {
+	suite.errServiceExport = errors.Wrap(gorm.ErrRecordNotFound, "find user")
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/export", "", &claims)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerExportTestSuite) TestResponseErrorWhenExport() {
+	suite.errServiceExport = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/export", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerExport(t *testing.T) {
+	suite.Run(t, new(HandlerExportTestSuite))
+}
+
+type HandlerDeleteTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respServiceDelete model.AccountDeletion
+	errServiceDelete  error
+}
+
+func (suite *HandlerDeleteTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	transferTo := "Receiver"
+	suite.respServiceDelete = model.AccountDeletion{
+		UserID:       "UID",
+		Recipes:      model.RecipesTransfer,
+		TransferToID: &transferTo,
+		PurgeAt:      time.Date(2026, 11, 18, 0, 0, 0, 0, time.UTC),
+	}
+	suite.errServiceDelete = nil
+
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(dto.AccountDeletionRequest, model.Claims) (model.AccountDeletion, error) {
+		return suite.respServiceDelete, suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseAccepted() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self", `{"recipes":"transfer","transferTo":"Receiver"}`, &claims)
+
+	suite.Equal(http.StatusAccepted, response.Code)
+	suite.JSONEq(`{"recipes":"transfer","transferTo":"Receiver","purgeAt":"2026-11-18T00:00:00Z"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", dto.AccountDeletionRequest{Recipes: model.RecipesTransfer, TransferTo: "Receiver"}, claims)
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseStatusCode400WhenTransferWithoutTarget() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self", `{"recipes":"transfer"}`, &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseStatusCode400WhenRecipesChoiceInvalid() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self", `{"recipes":"keep"}`, &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseStatusCode400WhenTransferInvalid() {
+	suite.errServiceDelete = global.ErrInvalidTransfer
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self", `{"recipes":"transfer","transferTo":"UID"}`, &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseStatusCode401WhenNoClaims() {
+	response := suite.server(http.MethodDelete, "/api/v1/users/self", `{"recipes":"delete"}`, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseErrorWhenDelete() {
+	suite.errServiceDelete = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self", `{"recipes":"delete"}`, &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerDelete(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteTestSuite))
+}
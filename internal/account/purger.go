@@ -0,0 +1,78 @@
+package account
+
+import (
+	"log"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Purger ทำงานใน goroutine แยก ทุก PurgeInterval จะ purge บัญชีที่พ้นช่วงผ่อนผันแล้ว
+// บัญชีที่ purge ไม่สำเร็จจะถูกลองใหม่ในรอบถัดไป
+type Purger struct {
+	Repository IRepository
+	Config     config.Account
+
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func NewPurger(db *gorm.DB, conf config.Account) *Purger {
+	return &Purger{
+		Repository: NewRepository(db),
+		Config:     conf,
+		done:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+}
+
+// Start ต้องเรียก Close ก่อนปิดโปรแกรม
+func (purger *Purger) Start() {
+	go purger.run()
+}
+
+// Close รอให้รอบที่กำลังทำอยู่เสร็จก่อน
+func (purger *Purger) Close() {
+	close(purger.done)
+	<-purger.stopped
+}
+
+func (purger *Purger) run() {
+	defer close(purger.stopped)
+
+	ticker := time.NewTicker(purger.Config.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := purger.Purge(now); err != nil {
+				log.Println("Error when purge accounts:", err)
+			}
+		case <-purger.done:
+			return
+		}
+	}
+}
+
+func (purger *Purger) Purge(now time.Time) error {
+	deletions, err := purger.Repository.GetDueDeletions(now, purger.Config.PurgeBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "find due deletions")
+	}
+
+	for _, deletion := range deletions {
+		// id ใหม่ที่ไม่เกี่ยวกับ id เดิม จึงย้อนกลับไปหาเจ้าของเดิมไม่ได้
+		placeholder := model.DeletedUser("deleted-" + uuid.NewString())
+
+		if err := purger.Repository.Purge(deletion, &placeholder); err != nil {
+			log.Println("Error when purge account:", errors.Wrap(err, deletion.UserID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package account_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/account"
+	"wongnok/internal/config"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewPurger(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		purger := account.NewPurger(&gorm.DB{}, config.Account{PurgeInterval: time.Hour})
+
+		value := reflect.Indirect(reflect.ValueOf(purger))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type PurgerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	purger *account.Purger
+	repo   *MockIRepository
+
+	// Mock data
+	now        time.Time
+	respGetDue []model.AccountDeletion
+	errGetDue  error
+	errPurge   error
+}
+
+func (suite *PurgerTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+
+	suite.now = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	suite.purger = account.NewPurger(&gorm.DB{}, config.Account{PurgeInterval: time.Hour, PurgeBatchSize: 20})
+	suite.purger.Repository = suite.repo
+
+	suite.respGetDue = []model.AccountDeletion{
+		{UserID: "First", Recipes: model.RecipesDelete},
+		{UserID: "Second", Recipes: model.RecipesDelete},
+	}
+	suite.errGetDue = nil
+	suite.errPurge = nil
+
+	suite.repo.On("GetDueDeletions", mock.Anything, mock.Anything).Return(func(time.Time, int) ([]model.AccountDeletion, error) {
+		return suite.respGetDue, suite.errGetDue
+	})
+	suite.repo.On("Purge", mock.Anything, mock.Anything).Return(func(model.AccountDeletion, *model.User) error {
+		return suite.errPurge
+	})
+}
+
+func (suite *PurgerTestSuite) TestPurgeWithSeparatePlaceholders() {
+	suite.NoError(suite.purger.Purge(suite.now))
+
+	suite.repo.AssertCalled(suite.T(), "GetDueDeletions", suite.now, 20)
+	suite.repo.AssertNumberOfCalls(suite.T(), "Purge", 2)
+
+	first := suite.repo.Calls[1].Arguments.Get(1).(*model.User)
+	second := suite.repo.Calls[2].Arguments.Get(1).(*model.User)
+
+	suite.True(strings.HasPrefix(first.ID, "deleted-"))
+	suite.NotEqual(first.ID, second.ID)
+	suite.True(first.HideAvatar)
+}
+
+func (suite *PurgerTestSuite) TestContinueWhenPurgeFailed() {
+	suite.errPurge = assert.AnError
+
+	suite.NoError(suite.purger.Purge(suite.now))
+
+	suite.repo.AssertNumberOfCalls(suite.T(), "Purge", 2)
+}
+
+func (suite *PurgerTestSuite) TestErrorWhenGetDueDeletions() {
+	suite.errGetDue = assert.AnError
+
+	err := suite.purger.Purge(suite.now)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.repo.AssertNotCalled(suite.T(), "Purge", mock.Anything, mock.Anything)
+}
+
+func (suite *PurgerTestSuite) TestStartAndClose() {
+	suite.purger.Start()
+	suite.purger.Close()
+}
+
+func TestPurger(t *testing.T) {
+	suite.Run(t, new(PurgerTestSuite))
+}
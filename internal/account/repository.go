@@ -0,0 +1,147 @@
+package account
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	GetUser(userID string) (model.User, error)
+	GetRecipes(userID string) (model.FoodRecipes, error)
+	GetRatings(userID string) (model.Ratings, error)
+	GetFavorites(userID string) (model.FoodRecipes, error)
+	// Delete soft delete ผู้ใช้พร้อมบันทึกคำขอใน transaction เดียวกัน
+	Delete(deletion *model.AccountDeletion) error
+	GetDueDeletions(now time.Time, limit int) ([]model.AccountDeletion, error)
+	// Purge ย้ายข้อมูลที่เก็บไว้ไปที่ placeholder แล้วลบผู้ใช้จริง
+	Purge(deletion model.AccountDeletion, placeholder *model.User) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) GetUser(userID string) (model.User, error) {
+	var user model.User
+
+	if err := repo.DB.First(&user, "id = ?", userID).Error; err != nil {
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+func (repo Repository) GetRecipes(userID string) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if err := repo.DB.Preload(clause.Associations).Where("user_id = ?", userID).Order("id").Find(&recipes).Error; err != nil {
+		return model.FoodRecipes{}, err
+	}
+
+	return recipes, nil
+}
+
+func (repo Repository) GetRatings(userID string) (model.Ratings, error) {
+	var ratings = make(model.Ratings, 0)
+
+	if err := repo.DB.Preload("User").Where("user_id = ?", userID).Order("id").Find(&ratings).Error; err != nil {
+		return model.Ratings{}, err
+	}
+
+	return ratings, nil
+}
+
+func (repo Repository) GetFavorites(userID string) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if err := repo.DB.Preload(clause.Associations).
+		Joins("JOIN favorites ON food_recipes.id = favorites.food_recipe_id AND favorites.deleted_at IS NULL").
+		Where("favorites.user_id = ?", userID).
+		Order("favorites.created_at").
+		Find(&recipes).Error; err != nil {
+		return model.FoodRecipes{}, err
+	}
+
+	return recipes, nil
+}
+
+// Delete ขอลบซ้ำระหว่างรอจะแทนที่คำขอเดิม
+func (repo Repository) Delete(deletion *model.AccountDeletion) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(deletion).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.User{}, "id = ?", deletion.UserID).Error
+	})
+}
+
+func (repo Repository) GetDueDeletions(now time.Time, limit int) ([]model.AccountDeletion, error) {
+	var deletions []model.AccountDeletion
+
+	if err := repo.DB.Where("purge_at <= ?", now).Order("purge_at").Limit(limit).Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+
+	return deletions, nil
+}
+
+// Purge รีวิว ความคิดเห็นและโหวตถูกเก็บไว้ใต้ placeholder ส่วน recipe โอนให้ผู้รับหรือลบตามที่เลือก
+// ผู้รับที่ถูกลบไปก่อนถึงกำหนดทำให้ recipe ตกเป็นของ placeholder แทน
+// แถวที่อ้าง users แบบ ON DELETE CASCADE เช่น follows และ notifications ถูกลบไปพร้อมผู้ใช้
+func (repo Repository) Purge(deletion model.AccountDeletion, placeholder *model.User) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(placeholder).Error; err != nil {
+			return err
+		}
+
+		for _, table := range []string{"ratings", "comments", "rating_votes"} {
+			if err := tx.Exec("UPDATE "+table+" SET user_id = ? WHERE user_id = ?", placeholder.ID, deletion.UserID).Error; err != nil {
+				return err
+			}
+		}
+
+		// log คงไว้เป็นหลักฐานแต่ไม่ผูกกับ id เดิม
+		for _, column := range []string{"actor_id", "owner_id"} {
+			if err := tx.Exec("UPDATE moderation_logs SET "+column+" = ? WHERE "+column+" = ?", placeholder.ID, deletion.UserID).Error; err != nil {
+				return err
+			}
+		}
+
+		if deletion.Recipes == model.RecipesTransfer {
+			if err := tx.Exec(`
+				UPDATE food_recipes
+				SET user_id = COALESCE((SELECT id FROM users WHERE id = ? AND deleted_at IS NULL), ?)
+				WHERE user_id = ?`, deletion.TransferToID, placeholder.ID, deletion.UserID).Error; err != nil {
+				return err
+			}
+		} else {
+			if err := tx.Exec(`
+				UPDATE food_recipes
+				SET user_id = ?, deleted_at = COALESCE(deleted_at, CURRENT_TIMESTAMP)
+				WHERE user_id = ?`, placeholder.ID, deletion.UserID).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Exec("DELETE FROM favorites WHERE user_id = ?", deletion.UserID).Error; err != nil {
+			return err
+		}
+
+		// viewer ของผู้ใช้ที่ login ตาม helper.DecodeViewer
+		if err := tx.Exec("DELETE FROM recipe_view_keys WHERE viewer = ?", "u:"+deletion.UserID).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(&model.User{}, "id = ?", deletion.UserID).Error
+	})
+}
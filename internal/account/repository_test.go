@@ -0,0 +1,208 @@
+package account_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/account"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := account.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository account.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &account.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+
+	// ผู้ใช้ที่จะถูกลบในแต่ละ test มี recipe รีวิวสูตร Omlet และ favorite ของตัวเอง
+	suite.NoError(db.Create(&model.User{ID: leaving, FirstName: "Leaving", LastName: "User", Email: "leaving@example.com"}).Error)
+	suite.NoError(db.Exec(`
+		INSERT INTO food_recipes (name, description, ingredient, instruction, cooking_duration_id, difficulty_id, user_id, created_at, updated_at)
+		VALUES ('Khao Pad', 'Fried rice', 'Rice', 'Fry', 1, 1, ?, NOW(), NOW())`, leaving).Error)
+	suite.NoError(db.Exec("INSERT INTO ratings (food_recipe_id, score, title, user_id, created_at, updated_at) VALUES (1, 4, 'Tasty', ?, NOW(), NOW())", leaving).Error)
+	suite.NoError(db.Exec("INSERT INTO favorites (food_recipe_id, user_id, created_at, updated_at) VALUES (1, ?, NOW(), NOW())", leaving).Error)
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM favorites")
+	suite.db.Exec("DELETE FROM ratings WHERE id > 2")
+	suite.db.Exec("DELETE FROM food_recipes WHERE id > 1")
+	suite.db.Exec("DELETE FROM users WHERE id NOT IN (?, ?)", owner, receiver)
+
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+const (
+	owner    = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	receiver = "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+	leaving  = "leaving-user"
+)
+
+type RepositoryExportTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryExportTestSuite) TestGetCallerData() {
+	recipes, err := suite.repository.GetRecipes(leaving)
+	suite.NoError(err)
+	suite.Len(recipes, 1)
+	suite.Equal("Khao Pad", recipes[0].Name)
+
+	ratings, err := suite.repository.GetRatings(leaving)
+	suite.NoError(err)
+	suite.Len(ratings, 1)
+	suite.Equal("Tasty", ratings[0].Title)
+
+	favorites, err := suite.repository.GetFavorites(leaving)
+	suite.NoError(err)
+	suite.Len(favorites, 1)
+	suite.Equal("Omlet", favorites[0].Name)
+}
+
+func TestRepositoryExport(t *testing.T) {
+	suite.Run(t, new(RepositoryExportTestSuite))
+}
+
+type RepositoryDeleteTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryDeleteTestSuite) TestSoftDeleteAndReplaceRequest() {
+	purgeAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	suite.NoError(suite.repository.Delete(&model.AccountDeletion{UserID: leaving, Recipes: model.RecipesDelete, PurgeAt: purgeAt}))
+
+	_, err := suite.repository.GetUser(leaving)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	// ขอซ้ำเปลี่ยนเป็นโอน recipe
+	transferTo := receiver
+	suite.NoError(suite.repository.Delete(&model.AccountDeletion{UserID: leaving, Recipes: model.RecipesTransfer, TransferToID: &transferTo, PurgeAt: purgeAt}))
+
+	deletions, err := suite.repository.GetDueDeletions(purgeAt, 10)
+	suite.NoError(err)
+	suite.Len(deletions, 1)
+	suite.Equal(model.RecipesTransfer, deletions[0].Recipes)
+
+	notDue, err := suite.repository.GetDueDeletions(purgeAt.Add(-time.Minute), 10)
+	suite.NoError(err)
+	suite.Empty(notDue)
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	suite.Run(t, new(RepositoryDeleteTestSuite))
+}
+
+type RepositoryPurgeTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryPurgeTestSuite) purge(deletion model.AccountDeletion) model.User {
+	suite.NoError(suite.repository.Delete(&deletion))
+
+	placeholder := model.DeletedUser("deleted-" + deletion.Recipes)
+	suite.NoError(suite.repository.Purge(deletion, &placeholder))
+
+	var count int64
+	suite.NoError(suite.db.Unscoped().Model(&model.User{}).Where("id = ?", leaving).Count(&count).Error)
+	suite.Equal(int64(0), count)
+
+	suite.NoError(suite.db.Model(&model.Favorite{}).Where("user_id = ?", leaving).Count(&count).Error)
+	suite.Equal(int64(0), count)
+
+	var rating model.Rating
+	suite.NoError(suite.db.Preload("User").First(&rating, "title = ?", "Tasty").Error)
+	suite.Equal(placeholder.ID, rating.UserID)
+	suite.Equal("Deleted user", rating.User.NickName)
+
+	return placeholder
+}
+
+func (suite *RepositoryPurgeTestSuite) TestDeleteRecipes() {
+	placeholder := suite.purge(model.AccountDeletion{UserID: leaving, Recipes: model.RecipesDelete, PurgeAt: time.Now()})
+
+	var recipe model.FoodRecipe
+	suite.NoError(suite.db.Unscoped().First(&recipe, "name = ?", "Khao Pad").Error)
+	suite.Equal(placeholder.ID, recipe.UserID)
+	suite.True(recipe.DeletedAt.Valid)
+}
+
+func (suite *RepositoryPurgeTestSuite) TestTransferRecipes() {
+	transferTo := receiver
+	suite.purge(model.AccountDeletion{UserID: leaving, Recipes: model.RecipesTransfer, TransferToID: &transferTo, PurgeAt: time.Now()})
+
+	var recipe model.FoodRecipe
+	suite.NoError(suite.db.First(&recipe, "name = ?", "Khao Pad").Error)
+	suite.Equal(receiver, recipe.UserID)
+}
+
+func TestRepositoryPurge(t *testing.T) {
+	suite.Run(t, new(RepositoryPurgeTestSuite))
+}
@@ -0,0 +1,86 @@
+package account
+
+import (
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Export(claims model.Claims) (model.AccountExport, error)
+	Delete(request dto.AccountDeletionRequest, claims model.Claims) (model.AccountDeletion, error)
+}
+
+type Service struct {
+	Repository IRepository
+	Config     config.Account
+}
+
+func NewService(db *gorm.DB, conf config.Account) IService {
+	return &Service{
+		Repository: NewRepository(db),
+		Config:     conf,
+	}
+}
+
+func (service Service) Export(claims model.Claims) (model.AccountExport, error) {
+	user, err := service.Repository.GetUser(claims.ID)
+	if err != nil {
+		return model.AccountExport{}, errors.Wrap(err, "find user")
+	}
+
+	recipes, err := service.Repository.GetRecipes(claims.ID)
+	if err != nil {
+		return model.AccountExport{}, errors.Wrap(err, "find recipes")
+	}
+
+	ratings, err := service.Repository.GetRatings(claims.ID)
+	if err != nil {
+		return model.AccountExport{}, errors.Wrap(err, "find ratings")
+	}
+
+	favorites, err := service.Repository.GetFavorites(claims.ID)
+	if err != nil {
+		return model.AccountExport{}, errors.Wrap(err, "find favorites")
+	}
+
+	return model.AccountExport{
+		User:      user,
+		Recipes:   recipes.CalculateAverageRatings(),
+		Ratings:   ratings,
+		Favorites: favorites.CalculateAverageRatings(),
+	}, nil
+}
+
+// Delete ผู้รับ recipe ต้องเป็นผู้ใช้อื่นที่ยังไม่ถูกลบ
+func (service Service) Delete(request dto.AccountDeletionRequest, claims model.Claims) (model.AccountDeletion, error) {
+	if _, err := service.Repository.GetUser(claims.ID); err != nil {
+		return model.AccountDeletion{}, errors.Wrap(err, "find user")
+	}
+
+	if request.Recipes == model.RecipesTransfer {
+		if request.TransferTo == claims.ID {
+			return model.AccountDeletion{}, global.ErrInvalidTransfer
+		}
+
+		if _, err := service.Repository.GetUser(request.TransferTo); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.AccountDeletion{}, global.ErrInvalidTransfer
+			}
+			return model.AccountDeletion{}, errors.Wrap(err, "find transfer target")
+		}
+	}
+
+	deletion := model.AccountDeletion{}.FromRequest(request, claims.ID, time.Now().Add(service.Config.DeletionGrace))
+
+	if err := service.Repository.Delete(&deletion); err != nil {
+		return model.AccountDeletion{}, errors.Wrap(err, "delete user")
+	}
+
+	return deletion, nil
+}
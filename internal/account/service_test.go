@@ -0,0 +1,226 @@
+package account_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/account"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := account.NewService(&gorm.DB{}, config.Account{DeletionGrace: time.Hour})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServiceExportTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service account.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetUser      model.User
+	errGetUser       error
+	respGetRecipes   model.FoodRecipes
+	errGetRecipes    error
+	respGetRatings   model.Ratings
+	errGetRatings    error
+	respGetFavorites model.FoodRecipes
+	errGetFavorites  error
+}
+
+func (suite *ServiceExportTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &account.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetUser = model.User{ID: "UID", FirstName: "Demo"}
+	suite.errGetUser = nil
+	suite.respGetRecipes = model.FoodRecipes{{Name: "Omlet", RatingSummary: model.RatingSummary{RatingCount: 2, RatingSum: 8}}}
+	suite.errGetRecipes = nil
+	suite.respGetRatings = model.Ratings{{Score: 4}}
+	suite.errGetRatings = nil
+	suite.respGetFavorites = model.FoodRecipes{{Name: "Pad Thai"}}
+	suite.errGetFavorites = nil
+
+	suite.repo.On("GetUser", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetUser, suite.errGetUser
+	})
+	suite.repo.On("GetRecipes", mock.Anything).Return(func(string) (model.FoodRecipes, error) {
+		return suite.respGetRecipes, suite.errGetRecipes
+	})
+	suite.repo.On("GetRatings", mock.Anything).Return(func(string) (model.Ratings, error) {
+		return suite.respGetRatings, suite.errGetRatings
+	})
+	suite.repo.On("GetFavorites", mock.Anything).Return(func(string) (model.FoodRecipes, error) {
+		return suite.respGetFavorites, suite.errGetFavorites
+	})
+}
+
+func (suite *ServiceExportTestSuite) TestExportCallerData() {
+	export, err := suite.service.Export(model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respGetUser, export.User)
+	suite.Equal(4.0, export.Recipes[0].AverageRating)
+	suite.Equal(suite.respGetRatings, export.Ratings)
+	suite.Equal("Pad Thai", export.Favorites[0].Name)
+	suite.repo.AssertCalled(suite.T(), "GetUser", "UID")
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", "UID")
+	suite.repo.AssertCalled(suite.T(), "GetRatings", "UID")
+	suite.repo.AssertCalled(suite.T(), "GetFavorites", "UID")
+}
+
+func (suite *ServiceExportTestSuite) TestErrorWhenGetUser() {
+	suite.errGetUser = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Export(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "GetRecipes", mock.Anything)
+}
+
+func (suite *ServiceExportTestSuite) TestErrorWhenGetRecipes() {
+	suite.errGetRecipes = assert.AnError
+
+	_, err := suite.service.Export(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceExportTestSuite) TestErrorWhenGetRatings() {
+	suite.errGetRatings = assert.AnError
+
+	_, err := suite.service.Export(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceExportTestSuite) TestErrorWhenGetFavorites() {
+	suite.errGetFavorites = assert.AnError
+
+	_, err := suite.service.Export(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceExport(t *testing.T) {
+	suite.Run(t, new(ServiceExportTestSuite))
+}
+
+type ServiceDeleteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service account.IService
+	repo    *MockIRepository
+
+	// Mock data
+	errGetUser       error
+	errGetTransferTo error
+	errDelete        error
+}
+
+func (suite *ServiceDeleteTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &account.Service{
+		Repository: suite.repo,
+		Config:     config.Account{DeletionGrace: 720 * time.Hour},
+	}
+
+	suite.errGetUser = nil
+	suite.errGetTransferTo = nil
+	suite.errDelete = nil
+
+	suite.repo.On("GetUser", "UID").Return(func(string) (model.User, error) {
+		return model.User{ID: "UID"}, suite.errGetUser
+	})
+	suite.repo.On("GetUser", "Receiver").Return(func(string) (model.User, error) {
+		return model.User{ID: "Receiver"}, suite.errGetTransferTo
+	})
+	suite.repo.On("Delete", mock.Anything).Return(func(*model.AccountDeletion) error {
+		return suite.errDelete
+	})
+}
+
+func (suite *ServiceDeleteTestSuite) TestScheduleTransferAfterGrace() {
+	deletion, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesTransfer, TransferTo: "Receiver"}, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal("UID", deletion.UserID)
+	suite.Equal("Receiver", *deletion.TransferToID)
+	suite.WithinDuration(time.Now().Add(720*time.Hour), deletion.PurgeAt, time.Minute)
+	suite.repo.AssertCalled(suite.T(), "Delete", &deletion)
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteRecipesWithoutTransferTarget() {
+	deletion, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesDelete, TransferTo: "Receiver"}, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Nil(deletion.TransferToID)
+	suite.repo.AssertNotCalled(suite.T(), "GetUser", "Receiver")
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenTransferToSelf() {
+	_, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesTransfer, TransferTo: "UID"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, global.ErrInvalidTransfer)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenTransferTargetNotFound() {
+	suite.errGetTransferTo = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesTransfer, TransferTo: "Receiver"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, global.ErrInvalidTransfer)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenGetTransferTarget() {
+	suite.errGetTransferTo = assert.AnError
+
+	_, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesTransfer, TransferTo: "Receiver"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceDeleteTestSuite) TestNotFoundWhenAlreadyDeleted() {
+	suite.errGetUser = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesDelete}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenDelete() {
+	suite.errDelete = assert.AnError
+
+	_, err := suite.service.Delete(dto.AccountDeletionRequest{Recipes: model.RecipesDelete}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
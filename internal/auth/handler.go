@@ -3,11 +3,13 @@ package auth
 import (
 	"net/http"
 	"wongnok/internal/config"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/users"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -17,6 +19,7 @@ type IHandler interface {
 	Login(ctx *gin.Context)
 	Callback(ctx *gin.Context)
 	Logout(ctx *gin.Context)
+	Refresh(ctx *gin.Context)
 }
 
 type Handler struct {
@@ -31,6 +34,12 @@ func NewHandler(db *gorm.DB, kc config.Keycloak, oauth2Conf IOAuth2Config, verif
 	}
 }
 
+// Login godoc
+// @Summary Login
+// @Description Redirect to the Keycloak login page
+// @Tags auth
+// @Success 307 "Redirect to Keycloak"
+// @Router /api/v1/login [get]
 func (handler Handler) Login(ctx *gin.Context) {
 	// Generate state
 	state := handler.Service.GenerateState()
@@ -42,6 +51,17 @@ func (handler Handler) Login(ctx *gin.Context) {
 	ctx.Redirect(http.StatusTemporaryRedirect, handler.Service.AuthCodeURL(state))
 }
 
+// Callback godoc
+// @Summary Login callback
+// @Description Exchange the authorization code from Keycloak for a credential
+// @Tags auth
+// @Produce json
+// @Param state query string true "State from the login redirect"
+// @Param code query string true "Authorization code"
+// @Success 200 {object} dto.CredentialResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/callback [get]
 func (handler Handler) Callback(ctx *gin.Context) {
 	var query dto.KeycloakCallbackQuery
 	ctx.BindQuery(&query)
@@ -83,6 +103,15 @@ func (handler Handler) Callback(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, credential.ToResponse())
 }
 
+// Logout godoc
+// @Summary Logout
+// @Description Redirect to the Keycloak logout page
+// @Tags auth
+// @Param idTokenHint query string false "ID token of the session"
+// @Param postLogoutRedirectUri query string false "Where Keycloak redirects after logout"
+// @Success 307 "Redirect to Keycloak"
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/logout [get]
 func (handler Handler) Logout(ctx *gin.Context) {
 	var query dto.LogoutQuery
 	ctx.BindQuery(&query)
@@ -97,3 +126,53 @@ func (handler Handler) Logout(ctx *gin.Context) {
 	// Redirect
 	ctx.Redirect(http.StatusTemporaryRedirect, logoutURL)
 }
+
+// Refresh godoc
+// @Summary Refresh token
+// @Description Exchange a refresh token for a new credential
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} dto.CredentialResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/token/refresh [post]
+func (handler Handler) Refresh(ctx *gin.Context) {
+	var request dto.RefreshTokenRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Refresh token
+	credential, err := handler.Service.Refresh(ctx.Request.Context(), request.RefreshToken)
+	if err != nil {
+		status, code := refreshErrorStatus(err)
+		ctx.JSON(status, gin.H{"message": err.Error(), "code": code})
+		return
+	}
+
+	// Verify
+	if _, err := handler.Service.VerifyToken(ctx.Request.Context(), credential.IDToken); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, credential.ToResponse())
+}
+
+// refreshErrorStatus code ให้ client แยกได้ว่าควรส่งผู้ใช้ไป login ใหม่หรือไม่
+func refreshErrorStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, global.ErrRefreshTokenInvalid):
+		return http.StatusBadRequest, "invalid_refresh_token"
+	case errors.Is(err, global.ErrRefreshTokenExpired):
+		return http.StatusUnauthorized, "refresh_token_expired"
+	case errors.Is(err, global.ErrRefreshTokenRevoked):
+		return http.StatusUnauthorized, "refresh_token_revoked"
+	default:
+		return http.StatusInternalServerError, "internal_error"
+	}
+}
@@ -9,13 +9,16 @@ import (
 	"net/url"
 	"reflect"
 	"slices"
+	"strings"
 	"testing"
 	"wongnok/internal/auth"
 	"wongnok/internal/config"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -428,3 +431,139 @@ func TestHandlerLogout(t *testing.T) {
 }
 
 
+
+// Extend
+type HandlerRefreshTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respRefresh     model.Credential
+	errRefresh      error
+	respVerifyToken *MockIOIDCIDToken
+	errVerifyToken  error
+
+	// Helper
+	server func(body string) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerRefreshTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.userService = new(MockIUserService)
+	suite.handler = &auth.Handler{
+		Service:     suite.service,
+		UserService: suite.userService,
+	}
+
+	suite.server = func(body string) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+		router.POST("/api/v1/token/refresh", suite.handler.Refresh)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(
+			http.MethodPost,
+			"/api/v1/token/refresh",
+			strings.NewReader(body),
+		)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respRefresh = model.Credential{
+		Token: &oauth2.Token{
+			AccessToken:  "token",
+			RefreshToken: "newrefresh",
+		},
+		IDToken: "idtoken",
+	}
+	suite.errRefresh = nil
+	suite.respVerifyToken = new(MockIOIDCIDToken)
+	suite.errVerifyToken = nil
+
+	suite.service.On("Refresh", mock.Anything, mock.Anything).Return(func(context.Context, string) (model.Credential, error) {
+		return suite.respRefresh, suite.errRefresh
+	})
+	suite.service.On("VerifyToken", mock.Anything, mock.Anything).Return(func(ctx context.Context, token string) (auth.IOIDCIDToken, error) {
+		return suite.respVerifyToken, suite.errVerifyToken
+	})
+}
+
+func (suite *HandlerRefreshTestSuite) TestResponseCredential() {
+	response := suite.server(`{"refreshToken":"refresh"}`)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedResponse := dto.CredentialResponse{
+		AccessToken:  "token",
+		RefreshToken: "newrefresh",
+		IDToken:      "idtoken",
+	}
+	expectedJson, _ := json.Marshal(expectedResponse)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+
+	suite.service.AssertCalled(suite.T(), "Refresh", mock.Anything, "refresh")
+	suite.service.AssertCalled(suite.T(), "VerifyToken", mock.Anything, "idtoken")
+}
+
+func (suite *HandlerRefreshTestSuite) TestErrorWhenBodyInvalid() {
+	response := suite.server(`{}`)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Refresh", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerRefreshTestSuite) TestErrorWhenRefresh() {
+	cases := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{global.ErrRefreshTokenInvalid, http.StatusBadRequest, "invalid_refresh_token"},
+		{errors.Wrap(global.ErrRefreshTokenExpired, "Token is not active"), http.StatusUnauthorized, "refresh_token_expired"},
+		{errors.Wrap(global.ErrRefreshTokenRevoked, "Session not active"), http.StatusUnauthorized, "refresh_token_revoked"},
+		{assert.AnError, http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, c := range cases {
+		suite.errRefresh = c.err
+
+		response := suite.server(`{"refreshToken":"refresh"}`)
+
+		var body map[string]string
+		suite.NoError(json.Unmarshal(response.Body.Bytes(), &body))
+
+		suite.Equal(c.status, response.Code)
+		suite.Equal(c.code, body["code"])
+		suite.Equal(c.err.Error(), body["message"])
+	}
+
+	suite.service.AssertNotCalled(suite.T(), "VerifyToken", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerRefreshTestSuite) TestErrorWhenVerifyToken() {
+	suite.errVerifyToken = assert.AnError
+
+	response := suite.server(`{"refreshToken":"refresh"}`)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
+func TestHandlerRefresh(t *testing.T) {
+	suite.Run(t, new(HandlerRefreshTestSuite))
+}
@@ -43,107 +43,1078 @@ func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
 	return &MockIUserService_Expecter{mock: &_m.Mock}
 }
 
-// GetByID provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
+// Block provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Block(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockIUserService_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Block(userID interface{}, claims interface{}) *MockIUserService_Block_Call {
+	return &MockIUserService_Block_Call{Call: _e.mock.On("Block", userID, claims)}
+}
+
+func (_c *MockIUserService_Block_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Block_Call) Return(err error) *MockIUserService_Block_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Block_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeHandle provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) ChangeHandle(request dto.HandleRequest, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeHandle")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.HandleRequest, model.Claims) (model.User, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.HandleRequest, model.Claims) model.User); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.HandleRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_ChangeHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeHandle'
+type MockIUserService_ChangeHandle_Call struct {
+	*mock.Call
+}
+
+// ChangeHandle is a helper method to define mock.On call
+//   - request dto.HandleRequest
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) ChangeHandle(request interface{}, claims interface{}) *MockIUserService_ChangeHandle_Call {
+	return &MockIUserService_ChangeHandle_Call{Call: _e.mock.On("ChangeHandle", request, claims)}
+}
+
+func (_c *MockIUserService_ChangeHandle_Call) Run(run func(request dto.HandleRequest, claims model.Claims)) *MockIUserService_ChangeHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.HandleRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.HandleRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_ChangeHandle_Call) Return(user model.User, err error) *MockIUserService_ChangeHandle_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_ChangeHandle_Call) RunAndReturn(run func(request dto.HandleRequest, claims model.Claims) (model.User, error)) *MockIUserService_ChangeHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBlocked provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) CheckBlocked(userID string, otherID string) error {
+	ret := _mock.Called(userID, otherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlocked")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, otherID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_CheckBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBlocked'
+type MockIUserService_CheckBlocked_Call struct {
+	*mock.Call
+}
+
+// CheckBlocked is a helper method to define mock.On call
+//   - userID string
+//   - otherID string
+func (_e *MockIUserService_Expecter) CheckBlocked(userID interface{}, otherID interface{}) *MockIUserService_CheckBlocked_Call {
+	return &MockIUserService_CheckBlocked_Call{Call: _e.mock.On("CheckBlocked", userID, otherID)}
+}
+
+func (_c *MockIUserService_CheckBlocked_Call) Run(run func(userID string, otherID string)) *MockIUserService_CheckBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_CheckBlocked_Call) Return(err error) *MockIUserService_CheckBlocked_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_CheckBlocked_Call) RunAndReturn(run func(userID string, otherID string) error) *MockIUserService_CheckBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Create(claims model.Claims) (model.User, error) {
 	ret := _mock.Called(claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIUserService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Create(claims interface{}) *MockIUserService_Create_Call {
+	return &MockIUserService_Create_Call{Call: _e.mock.On("Create", claims)}
+}
+
+func (_c *MockIUserService_Create_Call) Run(run func(claims model.Claims)) *MockIUserService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) Return(user model.User, err error) *MockIUserService_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Follow(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockIUserService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Follow(userID interface{}, claims interface{}) *MockIUserService_Follow_Call {
+	return &MockIUserService_Follow_Call{Call: _e.mock.On("Follow", userID, claims)}
+}
+
+func (_c *MockIUserService_Follow_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Follow_Call) Return(err error) *MockIUserService_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Follow_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlocked provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetBlocked(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocked")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocked'
+type MockIUserService_GetBlocked_Call struct {
+	*mock.Call
+}
+
+// GetBlocked is a helper method to define mock.On call
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetBlocked(query interface{}, claims interface{}) *MockIUserService_GetBlocked_Call {
+	return &MockIUserService_GetBlocked_Call{Call: _e.mock.On("GetBlocked", query, claims)}
+}
+
+func (_c *MockIUserService_GetBlocked_Call) Run(run func(query model.FollowQuery, claims model.Claims)) *MockIUserService_GetBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FollowQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FollowQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetBlocked_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetBlocked_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetBlocked_Call) RunAndReturn(run func(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
+	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+}
+
+func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetFollowers(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockIUserService_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetFollowers(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetFollowers_Call {
+	return &MockIUserService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetFollowers_Call) Run(run func(userID string, query model.FollowQuery, claims model.Claims)) *MockIUserService_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowers_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetFollowers_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowers_Call) RunAndReturn(run func(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetFollowing(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type MockIUserService_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetFollowing(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetFollowing_Call {
+	return &MockIUserService_GetFollowing_Call{Call: _e.mock.On("GetFollowing", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetFollowing_Call) Run(run func(userID string, query model.FollowQuery, claims model.Claims)) *MockIUserService_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowing_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetFollowing_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowing_Call) RunAndReturn(run func(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMuted provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetMuted(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMuted")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMuted'
+type MockIUserService_GetMuted_Call struct {
+	*mock.Call
+}
+
+// GetMuted is a helper method to define mock.On call
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetMuted(query interface{}, claims interface{}) *MockIUserService_GetMuted_Call {
+	return &MockIUserService_GetMuted_Call{Call: _e.mock.On("GetMuted", query, claims)}
+}
+
+func (_c *MockIUserService_GetMuted_Call) Run(run func(query model.FollowQuery, claims model.Claims)) *MockIUserService_GetMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FollowQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FollowQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetMuted_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetMuted_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetMuted_Call) RunAndReturn(run func(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetProfile(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery, model.Claims) (model.Profile, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery, model.Claims) model.Profile); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		r0 = ret.Get(0).(model.Profile)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.ProfileQuery, model.Claims) error); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockIUserService_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - userID string
+//   - query model.ProfileQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetProfile(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetProfile_Call {
+	return &MockIUserService_GetProfile_Call{Call: _e.mock.On("GetProfile", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetProfile_Call) Run(run func(userID string, query model.ProfileQuery, claims model.Claims)) *MockIUserService_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.ProfileQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.ProfileQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetProfile_Call) Return(profile model.Profile, err error) *MockIUserService_GetProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetProfile_Call) RunAndReturn(run func(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error)) *MockIUserService_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(userID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIUserService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
+	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Mute(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockIUserService_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Mute(userID interface{}, claims interface{}) *MockIUserService_Mute_Call {
+	return &MockIUserService_Mute_Call{Call: _e.mock.On("Mute", userID, claims)}
+}
+
+func (_c *MockIUserService_Mute_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Mute_Call) Return(err error) *MockIUserService_Mute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Mute_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Patch(patch []byte, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(patch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) (model.User, error)); ok {
+		return returnFunc(patch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) model.User); ok {
+		r0 = returnFunc(patch, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, model.Claims) error); ok {
+		r1 = returnFunc(patch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIUserService_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - patch []byte
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Patch(patch interface{}, claims interface{}) *MockIUserService_Patch_Call {
+	return &MockIUserService_Patch_Call{Call: _e.mock.On("Patch", patch, claims)}
+}
+
+func (_c *MockIUserService_Patch_Call) Run(run func(patch []byte, claims model.Claims)) *MockIUserService_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Patch_Call) Return(user model.User, err error) *MockIUserService_Patch_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Patch_Call) RunAndReturn(run func(patch []byte, claims model.Claims) (model.User, error)) *MockIUserService_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Unblock(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockIUserService_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Unblock(userID interface{}, claims interface{}) *MockIUserService_Unblock_Call {
+	return &MockIUserService_Unblock_Call{Call: _e.mock.On("Unblock", userID, claims)}
+}
+
+func (_c *MockIUserService_Unblock_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Unblock_Call) Return(err error) *MockIUserService_Unblock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Unblock_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Unfollow(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
 	}
 
-	var r0 model.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
-	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
-	} else {
-		r0 = ret.Get(0).(model.User)
-	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIUserService_GetByID_Call struct {
+// MockIUserService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockIUserService_Unfollow_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Unfollow is a helper method to define mock.On call
+//   - userID string
 //   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
-	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+func (_e *MockIUserService_Expecter) Unfollow(userID interface{}, claims interface{}) *MockIUserService_Unfollow_Call {
+	return &MockIUserService_Unfollow_Call{Call: _e.mock.On("Unfollow", userID, claims)}
 }
 
-func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+func (_c *MockIUserService_Unfollow_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Unfollow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIUserService_Unfollow_Call) Return(err error) *MockIUserService_Unfollow_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+func (_c *MockIUserService_Unfollow_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Unfollow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
+// Unmute provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Unmute(userID string, claims model.Claims) error {
 	ret := _mock.Called(userID, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for Unmute")
 	}
 
-	var r0 model.FoodRecipes
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID, claims)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
 		r0 = returnFunc(userID, claims)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
-		r1 = returnFunc(userID, claims)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIUserService_GetRecipes_Call struct {
+// MockIUserService_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockIUserService_Unmute_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
+// Unmute is a helper method to define mock.On call
 //   - userID string
 //   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
-	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+func (_e *MockIUserService_Expecter) Unmute(userID interface{}, claims interface{}) *MockIUserService_Unmute_Call {
+	return &MockIUserService_Unmute_Call{Call: _e.mock.On("Unmute", userID, claims)}
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIUserService_Unmute_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Unmute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -161,12 +1132,72 @@ func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims m
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIUserService_Unmute_Call) Return(err error) *MockIUserService_Unmute_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIUserService_Unmute_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIUserService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIUserService_Expecter) Update(user interface{}) *MockIUserService_Update_Call {
+	return &MockIUserService_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIUserService_Update_Call) Run(run func(user *model.User)) *MockIUserService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) Return(user model.User, err error) *MockIUserService_Update_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIUserService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -378,6 +1409,46 @@ func (_c *MockIHandler_Logout_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// Refresh provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Refresh(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockIHandler_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Refresh(ctx interface{}) *MockIHandler_Refresh_Call {
+	return &MockIHandler_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockIHandler_Refresh_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Refresh_Call) Return() *MockIHandler_Refresh_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Refresh_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Refresh_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockIOAuth2Config creates a new instance of MockIOAuth2Config. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIOAuth2Config(t interface {
@@ -554,6 +1625,65 @@ func (_c *MockIOAuth2Config_Exchange_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// TokenSource provides a mock function for the type MockIOAuth2Config
+func (_mock *MockIOAuth2Config) TokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TokenSource")
+	}
+
+	var r0 oauth2.TokenSource
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *oauth2.Token) oauth2.TokenSource); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(oauth2.TokenSource)
+		}
+	}
+	return r0
+}
+
+// MockIOAuth2Config_TokenSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenSource'
+type MockIOAuth2Config_TokenSource_Call struct {
+	*mock.Call
+}
+
+// TokenSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *oauth2.Token
+func (_e *MockIOAuth2Config_Expecter) TokenSource(ctx interface{}, token interface{}) *MockIOAuth2Config_TokenSource_Call {
+	return &MockIOAuth2Config_TokenSource_Call{Call: _e.mock.On("TokenSource", ctx, token)}
+}
+
+func (_c *MockIOAuth2Config_TokenSource_Call) Run(run func(ctx context.Context, token *oauth2.Token)) *MockIOAuth2Config_TokenSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *oauth2.Token
+		if args[1] != nil {
+			arg1 = args[1].(*oauth2.Token)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOAuth2Config_TokenSource_Call) Return(tokenSource oauth2.TokenSource) *MockIOAuth2Config_TokenSource_Call {
+	_c.Call.Return(tokenSource)
+	return _c
+}
+
+func (_c *MockIOAuth2Config_TokenSource_Call) RunAndReturn(run func(ctx context.Context, token *oauth2.Token) oauth2.TokenSource) *MockIOAuth2Config_TokenSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIOIDCTokenVerifier creates a new instance of MockIOIDCTokenVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIOIDCTokenVerifier(t interface {
@@ -975,6 +2105,72 @@ func (_c *MockIService_LogoutURL_Call) RunAndReturn(run func(logoutQuery dto.Log
 	return _c
 }
 
+// Refresh provides a mock function for the type MockIService
+func (_mock *MockIService) Refresh(ctx context.Context, refreshToken string) (model.Credential, error) {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 model.Credential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Credential, error)); ok {
+		return returnFunc(ctx, refreshToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Credential); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		r0 = ret.Get(0).(model.Credential)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockIService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *MockIService_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockIService_Refresh_Call {
+	return &MockIService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *MockIService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *MockIService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Refresh_Call) Return(credential model.Credential, err error) *MockIService_Refresh_Call {
+	_c.Call.Return(credential, err)
+	return _c
+}
+
+func (_c *MockIService_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (model.Credential, error)) *MockIService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyToken provides a mock function for the type MockIService
 func (_mock *MockIService) VerifyToken(ctx context.Context, token string) (auth.IOIDCIDToken, error) {
 	ret := _mock.Called(ctx, token)
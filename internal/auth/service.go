@@ -4,13 +4,18 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
+	"time"
 	"wongnok/internal/config"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
 )
 
 type IOAuth2Config config.IOAuth2Config
@@ -21,6 +26,8 @@ type IService interface {
 	GenerateState() string
 	AuthCodeURL(state string) string
 	Exchange(ctx context.Context, code string) (model.Credential, error)
+	// Refresh คืน global.ErrRefreshTokenInvalid, ErrRefreshTokenExpired หรือ ErrRefreshTokenRevoked เมื่อใช้ refresh token ไม่ได้
+	Refresh(ctx context.Context, refreshToken string) (model.Credential, error)
 	VerifyToken(ctx context.Context, token string) (IOIDCIDToken, error)
 	LogoutURL(logoutQuery dto.LogoutQuery) (string, error)
 }
@@ -66,6 +73,78 @@ func (service Service) Exchange(ctx context.Context, code string) (model.Credent
 	}, nil
 }
 
+func (service Service) Refresh(ctx context.Context, refreshToken string) (model.Credential, error) {
+	if err := checkRefreshToken(refreshToken, time.Now()); err != nil {
+		return model.Credential{}, err
+	}
+
+	// token ที่ไม่มี access token ถือว่าหมดอายุ TokenSource จึงขอใหม่ด้วย refresh token ทันที
+	token, err := service.OAuth2Config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return model.Credential{}, refreshError(err)
+	}
+
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return model.Credential{}, fmt.Errorf("id token is missing")
+	}
+
+	return model.Credential{
+		Token:   token,
+		IDToken: idToken,
+	}, nil
+}
+
+// checkRefreshToken refresh token ของ Keycloak เป็น JWT จึงตรวจรูปแบบ ชนิด และวันหมดอายุได้ก่อนส่งไป Keycloak
+// ลายเซ็นยังให้ Keycloak เป็นผู้ตรวจ
+func checkRefreshToken(refreshToken string, now time.Time) error {
+	parts := strings.Split(refreshToken, ".")
+	if len(parts) != 3 {
+		return global.ErrRefreshTokenInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return errors.Wrap(global.ErrRefreshTokenInvalid, err.Error())
+	}
+
+	var claims struct {
+		Type      string `json:"typ"`
+		ExpiresAt int64  `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return errors.Wrap(global.ErrRefreshTokenInvalid, err.Error())
+	}
+
+	if claims.Type != "" && claims.Type != "Refresh" && claims.Type != "Offline" {
+		return errors.Wrapf(global.ErrRefreshTokenInvalid, "token type is %s", claims.Type)
+	}
+
+	if claims.ExpiresAt > 0 && !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return global.ErrRefreshTokenExpired
+	}
+
+	return nil
+}
+
+// refreshError Keycloak ตอบ invalid_grant ทุกกรณี จึงแยกจากคำอธิบาย
+// Token is not active คือหมดอายุ Invalid refresh token คือ token ผิด ที่เหลือ เช่น Session not active คือ session ถูกปิดหรือ token ถูกยกเลิก
+func refreshError(err error) error {
+	var retrieveError *oauth2.RetrieveError
+	if !errors.As(err, &retrieveError) || retrieveError.ErrorCode != "invalid_grant" {
+		return errors.Wrap(err, "refresh token")
+	}
+
+	switch {
+	case strings.Contains(retrieveError.ErrorDescription, "Token is not active"):
+		return errors.Wrap(global.ErrRefreshTokenExpired, retrieveError.ErrorDescription)
+	case strings.Contains(retrieveError.ErrorDescription, "Invalid refresh token"):
+		return errors.Wrap(global.ErrRefreshTokenInvalid, retrieveError.ErrorDescription)
+	default:
+		return errors.Wrap(global.ErrRefreshTokenRevoked, retrieveError.ErrorDescription)
+	}
+}
+
 func (service Service) VerifyToken(ctx context.Context, token string) (IOIDCIDToken, error) {
 	idToken, err := service.Verifier.Verify(ctx, token)
 	if err != nil {
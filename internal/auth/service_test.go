@@ -3,11 +3,14 @@ package auth_test
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 	"wongnok/internal/auth"
 	"wongnok/internal/config"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
@@ -160,6 +163,126 @@ func TestServiceExchange(t *testing.T) {
 	suite.Run(t, new(ServiceExchangeTestSuite))
 }
 
+// tokenSource แทน oauth2.TokenSource ที่ได้จาก config
+type tokenSource func() (*oauth2.Token, error)
+
+func (source tokenSource) Token() (*oauth2.Token, error) {
+	return source()
+}
+
+// refreshToken JWT ที่ไม่มีลายเซ็นจริง พอให้ service อ่าน payload ได้
+func refreshToken(payload string) string {
+	return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+// Extend
+type ServiceRefreshTestSuite struct {
+	ServiceTestSuite
+
+	// Mock data
+	refreshToken string
+	respToken    *oauth2.Token
+	errToken     error
+}
+
+func (suite *ServiceRefreshTestSuite) SetupTest() {
+	// Super
+	suite.ServiceTestSuite.SetupTest()
+
+	suite.refreshToken = refreshToken(fmt.Sprintf(`{"typ":"Refresh","exp":%d}`, time.Now().Add(time.Hour).Unix()))
+
+	token := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh"}
+	suite.respToken = token.WithExtra(map[string]any{"id_token": "token"})
+	suite.errToken = nil
+
+	suite.oauth2config.On("TokenSource", mock.Anything, mock.Anything).Return(func(context.Context, *oauth2.Token) oauth2.TokenSource {
+		return tokenSource(func() (*oauth2.Token, error) {
+			return suite.respToken, suite.errToken
+		})
+	})
+}
+
+func (suite *ServiceRefreshTestSuite) TestReturnCredential() {
+	cred, err := suite.service.Refresh(context.Background(), suite.refreshToken)
+	suite.NoError(err)
+
+	suite.Equal(model.Credential{Token: suite.respToken, IDToken: "token"}, cred)
+	suite.oauth2config.AssertCalled(suite.T(), "TokenSource", mock.Anything, &oauth2.Token{RefreshToken: suite.refreshToken})
+}
+
+func (suite *ServiceRefreshTestSuite) TestAcceptOfflineToken() {
+	cred, err := suite.service.Refresh(context.Background(), refreshToken(`{"typ":"Offline"}`))
+	suite.NoError(err)
+
+	suite.Equal("token", cred.IDToken)
+}
+
+func (suite *ServiceRefreshTestSuite) TestErrorWhenMalformed() {
+	for _, token := range []string{"token", refreshToken("{"), "header.!!!.signature"} {
+		_, err := suite.service.Refresh(context.Background(), token)
+		suite.ErrorIs(err, global.ErrRefreshTokenInvalid)
+	}
+
+	suite.oauth2config.AssertNotCalled(suite.T(), "TokenSource", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceRefreshTestSuite) TestErrorWhenNotRefreshToken() {
+	_, err := suite.service.Refresh(context.Background(), refreshToken(`{"typ":"Bearer"}`))
+	suite.ErrorIs(err, global.ErrRefreshTokenInvalid)
+
+	suite.oauth2config.AssertNotCalled(suite.T(), "TokenSource", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceRefreshTestSuite) TestErrorWhenExpired() {
+	token := refreshToken(fmt.Sprintf(`{"typ":"Refresh","exp":%d}`, time.Now().Add(-time.Minute).Unix()))
+
+	_, err := suite.service.Refresh(context.Background(), token)
+	suite.ErrorIs(err, global.ErrRefreshTokenExpired)
+
+	suite.oauth2config.AssertNotCalled(suite.T(), "TokenSource", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceRefreshTestSuite) TestErrorWhenInvalidGrant() {
+	cases := map[string]error{
+		"Token is not active":   global.ErrRefreshTokenExpired,
+		"Invalid refresh token": global.ErrRefreshTokenInvalid,
+		"Session not active":    global.ErrRefreshTokenRevoked,
+		"Stale token":           global.ErrRefreshTokenRevoked,
+	}
+
+	for description, expected := range cases {
+		suite.errToken = &oauth2.RetrieveError{ErrorCode: "invalid_grant", ErrorDescription: description}
+
+		cred, err := suite.service.Refresh(context.Background(), suite.refreshToken)
+		suite.ErrorIs(err, expected, description)
+
+		suite.Empty(cred)
+	}
+}
+
+func (suite *ServiceRefreshTestSuite) TestErrorWhenRequestFailed() {
+	suite.errToken = assert.AnError
+
+	cred, err := suite.service.Refresh(context.Background(), suite.refreshToken)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "refresh token"))
+
+	suite.Empty(cred)
+}
+
+func (suite *ServiceRefreshTestSuite) TestErrorWhenGetExtra() {
+	suite.respToken = suite.respToken.WithExtra(map[string]any{"id_token": nil})
+
+	cred, err := suite.service.Refresh(context.Background(), suite.refreshToken)
+	suite.EqualError(err, "id token is missing")
+
+	suite.Empty(cred)
+}
+
+func TestServiceRefresh(t *testing.T) {
+	suite.Run(t, new(ServiceRefreshTestSuite))
+}
+
 // Extend
 type ServiceVerifyTokenTestSuite struct {
 	ServiceTestSuite
@@ -0,0 +1,263 @@
+package comment
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Pin(ctx *gin.Context)
+	Unpin(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB, conf config.Comment) *Handler {
+	return &Handler{
+		Service: NewService(db, conf),
+	}
+}
+
+// Get godoc
+// @Summary Get comments
+// @Description Get comments of a food recipe with their replies, pinned comment first. Deleted comments and replies are shown as "comment removed" while their thread has other comments. Signed-in callers don't see comments of users they muted or share a block with
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param page query int true "Page number"
+// @Param limit query int true "Items per page"
+// @Success 200 {object} dto.CommentsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id}/comments [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	var query model.CommentQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	// ไม่ login ก็ดูได้
+	if claims, err := helper.DecodeClaims(ctx); err == nil {
+		query.ViewerID = claims.ID
+	}
+
+	comments, total, err := handler.Service.Get(pathParamID(ctx, "id"), query)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comments.ToResponse(total))
+}
+
+// Create godoc
+// @Summary Create a comment
+// @Description Comment on a food recipe, or reply to a top-level comment with parentID
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param request body dto.CommentRequest true "Comment Request"
+// @Success 201 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/comments [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CommentRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Create(request, pathParamID(ctx, "id"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, comment.ToResponse())
+}
+
+// Update godoc
+// @Summary Update a comment
+// @Description Edit your own comment within the edit window
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param commentId path int true "Comment ID"
+// @Param request body dto.CommentUpdateRequest true "Comment Update Request"
+// @Success 200 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/comments/{commentId} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CommentUpdateRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Update(request, pathParamID(ctx, "id"), pathParamID(ctx, "commentId"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment.ToResponse())
+}
+
+// Delete godoc
+// @Summary Delete a comment
+// @Description Remove your own comment, replies stay visible under a "comment removed" placeholder
+// @Tags comments
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param commentId path int true "Comment ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/comments/{commentId} [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(pathParamID(ctx, "id"), pathParamID(ctx, "commentId"), claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
+
+// Pin godoc
+// @Summary Pin a comment
+// @Description Pin a top-level comment, only the recipe author can pin
+// @Tags comments
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param commentId path int true "Comment ID"
+// @Success 200 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/comments/{commentId}/pin [put]
+func (handler Handler) Pin(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Pin(pathParamID(ctx, "id"), pathParamID(ctx, "commentId"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment.ToResponse())
+}
+
+// Unpin godoc
+// @Summary Unpin a comment
+// @Description Unpin a comment, only the recipe author can unpin
+// @Tags comments
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param commentId path int true "Comment ID"
+// @Success 200 {object} dto.CommentResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/comments/{commentId}/pin [delete]
+func (handler Handler) Unpin(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Unpin(pathParamID(ctx, "id"), pathParamID(ctx, "commentId"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment.ToResponse())
+}
+
+func pathParamID(ctx *gin.Context, name string) int {
+	var id int
+
+	pathParam := ctx.Param(name)
+	if pathParam != "" {
+		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
+			id = parsed
+		}
+	}
+
+	return id
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}), errors.Is(err, global.ErrInvalidParent):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden), errors.Is(err, global.ErrEditWindowExpired), errors.Is(err, global.ErrBlocked):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,198 @@
+package comment_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/comment"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := comment.NewHandler(&gorm.DB{}, config.Comment{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerUpdateCommentTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler comment.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceUpdate model.Comment
+	errServiceUpdate  error
+
+	// Helper
+	server func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerUpdateCommentTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerUpdateCommentTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = comment.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+		router.PUT("/api/v1/food-recipes/:id/comments/:commentId", suite.handler.Update)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodPut, "/api/v1/food-recipes/1/comments/2", payload)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceUpdate = model.Comment{FoodRecipeID: 1, Body: "Updated"}
+	suite.errServiceUpdate = nil
+
+	suite.service.On("Update",
+		mock.AnythingOfType("dto.CommentUpdateRequest"),
+		mock.AnythingOfType("int"),
+		mock.AnythingOfType("int"),
+		mock.AnythingOfType("model.Claims"),
+	).Return(func(dto.CommentUpdateRequest, int, int, model.Claims) (model.Comment, error) {
+		return suite.respServiceUpdate, suite.errServiceUpdate
+	})
+}
+
+func (suite *HandlerUpdateCommentTestSuite) TestResponseCommentWithStatusCode200() {
+	response := suite.server(strings.NewReader(`{"body":"Updated"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Contains(response.Body.String(), `"body":"Updated"`)
+	suite.service.AssertCalled(suite.T(), "Update", dto.CommentUpdateRequest{Body: "Updated"}, 1, 2, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerUpdateCommentTestSuite) TestResponseErrorStatusCode401() {
+	response := suite.server(strings.NewReader(`{"body":"Updated"}`), nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerUpdateCommentTestSuite) TestResponseErrorStatusCode403WhenEditWindowExpired() {
+	suite.errServiceUpdate = global.ErrEditWindowExpired
+
+	response := suite.server(strings.NewReader(`{"body":"Updated"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.Equal(`{"message":"edit window expired"}`, response.Body.String())
+}
+
+func (suite *HandlerUpdateCommentTestSuite) TestResponseErrorStatusCode404() {
+	suite.errServiceUpdate = gorm.ErrRecordNotFound
+
+	response := suite.server(strings.NewReader(`{"body":"Updated"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerUpdateComment(t *testing.T) {
+	suite.Run(t, new(HandlerUpdateCommentTestSuite))
+}
+
+type HandlerGetCommentsTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler comment.IHandler
+	service *MockIService
+
+	// Mock data
+	errServiceGet error
+
+	// Helper
+	server func() *httptest.ResponseRecorder
+}
+
+func (suite *HandlerGetCommentsTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerGetCommentsTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = comment.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func() *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.GET("/api/v1/food-recipes/:id/comments", suite.handler.Get)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/food-recipes/1/comments?page=1&limit=10", nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.errServiceGet = nil
+
+	suite.service.On("Get", mock.AnythingOfType("int"), mock.AnythingOfType("model.CommentQuery")).Return(func(int, model.CommentQuery) (model.Comments, int64, error) {
+		if suite.errServiceGet != nil {
+			return nil, 0, suite.errServiceGet
+		}
+		return model.Comments{{Body: "Body"}}, 1, nil
+	})
+}
+
+func (suite *HandlerGetCommentsTestSuite) TestResponseCommentsWithStatusCode200() {
+	response := suite.server()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Contains(response.Body.String(), `"total":1`)
+	suite.service.AssertCalled(suite.T(), "Get", 1, model.CommentQuery{Page: 1, Limit: 10})
+}
+
+func (suite *HandlerGetCommentsTestSuite) TestResponseErrorStatusCode404WhenRecipeNotFound() {
+	suite.errServiceGet = errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+
+	response := suite.server()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerGetComments(t *testing.T) {
+	suite.Run(t, new(HandlerGetCommentsTestSuite))
+}
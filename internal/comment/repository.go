@@ -0,0 +1,135 @@
+package comment
+
+import (
+	"time"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/notification"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(recipeID int, query model.CommentQuery) (model.Comments, error)
+	Count(recipeID int, query model.CommentQuery) (int64, error)
+	GetByID(id int) (model.Comment, error)
+	Create(comment *model.Comment, notice *model.Notification) error
+	Update(comment *model.Comment) error
+	Delete(id int) error
+	Pin(recipeID int, id int) error
+	Unpin(id int) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// visibleRoots คืน query ของความคิดเห็นระดับบนสุด
+// ความคิดเห็นที่ถูกลบ ทั้งระดับบนสุดและ reply แสดงเป็น "comment removed" ตามลำดับเดิมในกระทู้
+// กระทู้ที่ถูกลบหมดทุกความคิดเห็นจะไม่แสดง
+// ความคิดเห็นของคนที่ viewer mute หรือ block กันถูกซ่อนไปพร้อม reply ทั้งหมด
+func (repo Repository) visibleRoots(recipeID int, viewerID string) *gorm.DB {
+	return repo.DB.Unscoped().
+		Model(&model.Comment{}).
+		Where("food_recipe_id = ? AND parent_id IS NULL", recipeID).
+		Where("deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments replies WHERE replies.parent_id = comments.id AND replies.deleted_at IS NULL)").
+		Scopes(helper.HideUsers(viewerID, "comments.user_id"))
+}
+
+func (repo Repository) Get(recipeID int, query model.CommentQuery) (model.Comments, error) {
+	var comments = make(model.Comments, 0)
+
+	offset := (query.Page - 1) * query.Limit
+
+	err := repo.visibleRoots(recipeID, query.ViewerID).
+		Preload("User").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Scopes(helper.HideUsers(query.ViewerID, "comments.user_id")).Order("created_at asc")
+		}).
+		Preload("Replies.User").
+		Order("pinned_at desc nulls last").
+		Order("created_at desc").
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (repo Repository) Count(recipeID int, query model.CommentQuery) (int64, error) {
+	var count int64
+
+	if err := repo.visibleRoots(recipeID, query.ViewerID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo Repository) GetByID(id int) (model.Comment, error) {
+	var comment model.Comment
+
+	if err := repo.DB.Preload("User").First(&comment, id).Error; err != nil {
+		return model.Comment{}, err
+	}
+
+	return comment, nil
+}
+
+func (repo Repository) Create(comment *model.Comment, notice *model.Notification) error {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+
+		if notice == nil {
+			return nil
+		}
+
+		notice.CommentID = &comment.ID
+		return notification.Notify(tx, notice)
+	})
+	if err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("User").First(comment, comment.ID).Error
+}
+
+func (repo Repository) Update(comment *model.Comment) error {
+	if err := repo.DB.Model(comment).Update("body", comment.Body).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("User").First(comment, comment.ID).Error
+}
+
+func (repo Repository) Delete(id int) error {
+	return repo.DB.Delete(&model.Comment{}, id).Error
+}
+
+func (repo Repository) Pin(recipeID int, id int) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		// ปักหมุดได้ครั้งละหนึ่งความคิดเห็นต่อสูตรอาหาร
+		if err := tx.Model(&model.Comment{}).
+			Where("food_recipe_id = ? AND pinned_at IS NOT NULL", recipeID).
+			Update("pinned_at", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.Comment{}).Where("id = ?", id).Update("pinned_at", time.Now()).Error
+	})
+}
+
+func (repo Repository) Unpin(id int) error {
+	return repo.DB.Model(&model.Comment{}).Where("id = ?", id).Update("pinned_at", nil).Error
+}
@@ -0,0 +1,212 @@
+package comment
+
+import (
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/users"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IFoodRecipeService foodrecipe.IService
+
+type IUserService user.IService
+
+type IService interface {
+	Get(recipeID int, query model.CommentQuery) (model.Comments, int64, error)
+	Create(request dto.CommentRequest, recipeID int, claims model.Claims) (model.Comment, error)
+	Update(request dto.CommentUpdateRequest, recipeID int, id int, claims model.Claims) (model.Comment, error)
+	Delete(recipeID int, id int, claims model.Claims) error
+	Pin(recipeID int, id int, claims model.Claims) (model.Comment, error)
+	Unpin(recipeID int, id int, claims model.Claims) (model.Comment, error)
+}
+
+type Service struct {
+	Repository        IRepository
+	FoodRecipeService IFoodRecipeService
+	UserService       IUserService
+	Config            config.Comment
+}
+
+func NewService(db *gorm.DB, conf config.Comment) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+		UserService:       user.NewService(db),
+		Config:            conf,
+	}
+}
+
+func (service Service) Get(recipeID int, query model.CommentQuery) (model.Comments, int64, error) {
+	if _, err := service.FoodRecipeService.GetByID(recipeID); err != nil {
+		return nil, 0, errors.Wrap(err, "find recipe")
+	}
+
+	total, err := service.Repository.Count(recipeID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	comments, err := service.Repository.Get(recipeID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+func (service Service) Create(request dto.CommentRequest, recipeID int, claims model.Claims) (model.Comment, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Comment{}, errors.Wrap(err, "request invalid")
+	}
+
+	recipe, err := service.FoodRecipeService.GetByID(recipeID)
+	if err != nil {
+		return model.Comment{}, errors.Wrap(err, "find recipe")
+	}
+
+	if err := service.UserService.CheckBlocked(claims.ID, recipe.UserID); err != nil {
+		return model.Comment{}, err
+	}
+
+	if request.ParentID != nil {
+		parent, err := service.Repository.GetByID(int(*request.ParentID))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.Comment{}, global.ErrInvalidParent
+			}
+			return model.Comment{}, errors.Wrap(err, "find parent comment")
+		}
+
+		// ตอบกลับได้เพียงหนึ่งระดับ และต้องอยู่ในสูตรอาหารเดียวกัน
+		if parent.ParentID != nil || parent.FoodRecipeID != uint(recipeID) {
+			return model.Comment{}, global.ErrInvalidParent
+		}
+
+		if err := service.UserService.CheckBlocked(claims.ID, parent.UserID); err != nil {
+			return model.Comment{}, err
+		}
+	}
+
+	comment := model.Comment{FoodRecipeID: uint(recipeID)}
+	comment = comment.FromRequest(request, claims)
+
+	notice := model.Notification{
+		RecipientID:  recipe.UserID,
+		ActorID:      claims.ID,
+		Type:         model.NotificationComment,
+		FoodRecipeID: &comment.FoodRecipeID,
+	}
+
+	if err := service.Repository.Create(&comment, &notice); err != nil {
+		return model.Comment{}, errors.Wrap(err, "create comment")
+	}
+
+	return comment, nil
+}
+
+func (service Service) Update(request dto.CommentUpdateRequest, recipeID int, id int, claims model.Claims) (model.Comment, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Comment{}, errors.Wrap(err, "request invalid")
+	}
+
+	comment, err := service.find(recipeID, id)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	if comment.UserID != claims.ID {
+		return model.Comment{}, global.ErrForbidden
+	}
+
+	if time.Since(comment.CreatedAt) > service.Config.EditWindow {
+		return model.Comment{}, global.ErrEditWindowExpired
+	}
+
+	comment.Body = request.Body
+
+	if err := service.Repository.Update(&comment); err != nil {
+		return model.Comment{}, errors.Wrap(err, "update comment")
+	}
+
+	return comment, nil
+}
+
+func (service Service) Delete(recipeID int, id int, claims model.Claims) error {
+	comment, err := service.find(recipeID, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != claims.ID {
+		return global.ErrForbidden
+	}
+
+	return service.Repository.Delete(id)
+}
+
+func (service Service) Pin(recipeID int, id int, claims model.Claims) (model.Comment, error) {
+	comment, err := service.findForRecipeAuthor(recipeID, id, claims)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	// ปักหมุดได้เฉพาะความคิดเห็นระดับบนสุด
+	if comment.ParentID != nil {
+		return model.Comment{}, global.ErrInvalidParent
+	}
+
+	if err := service.Repository.Pin(recipeID, id); err != nil {
+		return model.Comment{}, errors.Wrap(err, "pin comment")
+	}
+
+	return service.Repository.GetByID(id)
+}
+
+func (service Service) Unpin(recipeID int, id int, claims model.Claims) (model.Comment, error) {
+	if _, err := service.findForRecipeAuthor(recipeID, id, claims); err != nil {
+		return model.Comment{}, err
+	}
+
+	if err := service.Repository.Unpin(id); err != nil {
+		return model.Comment{}, errors.Wrap(err, "unpin comment")
+	}
+
+	return service.Repository.GetByID(id)
+}
+
+// find คืนความคิดเห็นที่อยู่ในสูตรอาหารที่ระบุเท่านั้น
+func (service Service) find(recipeID int, id int) (model.Comment, error) {
+	comment, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Comment{}, errors.Wrap(err, "find comment")
+	}
+
+	if comment.FoodRecipeID != uint(recipeID) {
+		return model.Comment{}, errors.Wrap(gorm.ErrRecordNotFound, "find comment")
+	}
+
+	return comment, nil
+}
+
+func (service Service) findForRecipeAuthor(recipeID int, id int, claims model.Claims) (model.Comment, error) {
+	recipe, err := service.FoodRecipeService.GetByID(recipeID)
+	if err != nil {
+		return model.Comment{}, errors.Wrap(err, "find recipe")
+	}
+
+	if recipe.UserID != claims.ID {
+		// เฉพาะเจ้าของสูตรอาหารเท่านั้นที่ปักหมุดได้
+		return model.Comment{}, global.ErrForbidden
+	}
+
+	return service.find(recipeID, id)
+}
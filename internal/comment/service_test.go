@@ -0,0 +1,362 @@
+package comment_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/comment"
+	"wongnok/internal/config"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := comment.NewService(&gorm.DB{}, config.Comment{EditWindow: time.Minute})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           comment.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	errFoodRecipeGetByID error
+}
+
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &comment.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.errFoodRecipeGetByID = nil
+
+	suite.foodRecipeService.On("GetByID", mock.AnythingOfType("int")).Return(func(int) (model.FoodRecipe, error) {
+		return model.FoodRecipe{}, suite.errFoodRecipeGetByID
+	})
+	suite.repo.On("Count", mock.Anything, mock.Anything).Return(int64(1), nil)
+	suite.repo.On("Get", mock.Anything, mock.Anything).Return(model.Comments{{Body: "Body"}}, nil)
+}
+
+func (suite *ServiceGetTestSuite) TestReturnComments() {
+	query := model.CommentQuery{Page: 1, Limit: 10}
+
+	comments, total, err := suite.service.Get(1, query)
+	suite.NoError(err)
+
+	suite.Equal(model.Comments{{Body: "Body"}}, comments)
+	suite.Equal(int64(1), total)
+	suite.foodRecipeService.AssertCalled(suite.T(), "GetByID", 1)
+	suite.repo.AssertCalled(suite.T(), "Get", 1, query)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errFoodRecipeGetByID = gorm.ErrRecordNotFound
+
+	comments, total, err := suite.service.Get(1, model.CommentQuery{Page: 1, Limit: 10})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.Nil(comments)
+	suite.Zero(total)
+	suite.repo.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func TestServiceGetComments(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           comment.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+	userService       *MockIUserService
+
+	// Mock data
+	errFoodRecipeGetByID error
+	respParent           model.Comment
+	errParent            error
+	blockedUserID        string
+}
+
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.userService = new(MockIUserService)
+	suite.service = &comment.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+		UserService:       suite.userService,
+	}
+
+	suite.errFoodRecipeGetByID = nil
+	suite.respParent = model.Comment{Model: gorm.Model{ID: 10}, FoodRecipeID: 1, UserID: "PARENT"}
+	suite.errParent = nil
+	suite.blockedUserID = ""
+
+	suite.foodRecipeService.On("GetByID", mock.AnythingOfType("int")).Return(func(int) (model.FoodRecipe, error) {
+		return model.FoodRecipe{UserID: "AUTHOR"}, suite.errFoodRecipeGetByID
+	})
+	suite.repo.On("GetByID", mock.AnythingOfType("int")).Return(func(int) (model.Comment, error) {
+		return suite.respParent, suite.errParent
+	})
+	suite.repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	suite.userService.On("CheckBlocked", mock.Anything, mock.Anything).Return(func(_ string, otherID string) error {
+		if otherID == suite.blockedUserID {
+			return global.ErrBlocked
+		}
+		return nil
+	})
+}
+
+func (suite *ServiceCreateTestSuite) TestReturnCommentCreated() {
+	result, err := suite.service.Create(dto.CommentRequest{Body: "Body"}, 1, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	expected := model.Comment{FoodRecipeID: 1, UserID: "UID", Body: "Body"}
+	suite.Equal(expected, result)
+
+	recipeID := uint(1)
+	suite.repo.AssertCalled(suite.T(), "Create", &expected, &model.Notification{
+		RecipientID:  "AUTHOR",
+		ActorID:      "UID",
+		Type:         model.NotificationComment,
+		FoodRecipeID: &recipeID,
+	})
+	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestReturnReplyCreated() {
+	parentID := uint(10)
+
+	result, err := suite.service.Create(dto.CommentRequest{Body: "Body", ParentID: &parentID}, 1, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(&parentID, result.ParentID)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 10)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestValidate() {
+	result, err := suite.service.Create(dto.CommentRequest{}, 1, model.Claims{ID: "UID"})
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errFoodRecipeGetByID = gorm.ErrRecordNotFound
+
+	result, err := suite.service.Create(dto.CommentRequest{Body: "Body"}, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenReplyToReply() {
+	grandParentID := uint(9)
+	suite.respParent.ParentID = &grandParentID
+	parentID := uint(10)
+
+	result, err := suite.service.Create(dto.CommentRequest{Body: "Body", ParentID: &parentID}, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrInvalidParent)
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenParentInOtherRecipe() {
+	suite.respParent.FoodRecipeID = 2
+	parentID := uint(10)
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "Body", ParentID: &parentID}, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrInvalidParent)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenBlockedByRecipeAuthor() {
+	suite.blockedUserID = "AUTHOR"
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "Body"}, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrBlocked)
+
+	suite.userService.AssertCalled(suite.T(), "CheckBlocked", "UID", "AUTHOR")
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenBlockedByParentAuthor() {
+	suite.blockedUserID = "PARENT"
+	parentID := uint(10)
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "Body", ParentID: &parentID}, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrBlocked)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func TestServiceCreateComment(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service comment.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID         model.Comment
+	errRepositoryUpdate error
+}
+
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &comment.Service{
+		Repository: suite.repo,
+		Config:     config.Comment{EditWindow: 15 * time.Minute},
+	}
+
+	suite.respGetByID = model.Comment{
+		Model:        gorm.Model{ID: 1, CreatedAt: time.Now()},
+		FoodRecipeID: 1,
+		UserID:       "UID",
+		Body:         "Body",
+	}
+	suite.errRepositoryUpdate = nil
+
+	suite.repo.On("GetByID", mock.AnythingOfType("int")).Return(func(int) (model.Comment, error) {
+		return suite.respGetByID, nil
+	})
+	suite.repo.On("Update", mock.Anything).Return(func(*model.Comment) error {
+		return suite.errRepositoryUpdate
+	})
+}
+
+func (suite *ServiceUpdateTestSuite) TestReturnCommentUpdated() {
+	result, err := suite.service.Update(dto.CommentUpdateRequest{Body: "Updated"}, 1, 1, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal("Updated", result.Body)
+	suite.repo.AssertCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorForbidden() {
+	_, err := suite.service.Update(dto.CommentUpdateRequest{Body: "Updated"}, 1, 1, model.Claims{ID: "FAKE"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenEditWindowExpired() {
+	suite.respGetByID.CreatedAt = time.Now().Add(-time.Hour)
+
+	_, err := suite.service.Update(dto.CommentUpdateRequest{Body: "Updated"}, 1, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrEditWindowExpired)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenCommentInOtherRecipe() {
+	_, err := suite.service.Update(dto.CommentUpdateRequest{Body: "Updated"}, 2, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenRepositoryUpdate() {
+	suite.errRepositoryUpdate = assert.AnError
+
+	_, err := suite.service.Update(dto.CommentUpdateRequest{Body: "Updated"}, 1, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "update comment"))
+}
+
+func TestServiceUpdateComment(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServicePinTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           comment.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	respGetByID model.Comment
+}
+
+func (suite *ServicePinTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &comment.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.respGetByID = model.Comment{
+		Model:        gorm.Model{ID: 1},
+		FoodRecipeID: 1,
+		UserID:       "COMMENTER",
+	}
+
+	suite.foodRecipeService.On("GetByID", mock.AnythingOfType("int")).Return(model.FoodRecipe{UserID: "AUTHOR"}, nil)
+	suite.repo.On("GetByID", mock.AnythingOfType("int")).Return(func(int) (model.Comment, error) {
+		return suite.respGetByID, nil
+	})
+	suite.repo.On("Pin", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(nil)
+}
+
+func (suite *ServicePinTestSuite) TestPinWhenRecipeAuthor() {
+	_, err := suite.service.Pin(1, 1, model.Claims{ID: "AUTHOR"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Pin", 1, 1)
+}
+
+func (suite *ServicePinTestSuite) TestErrorForbiddenWhenNotRecipeAuthor() {
+	_, err := suite.service.Pin(1, 1, model.Claims{ID: "COMMENTER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Pin", mock.Anything, mock.Anything)
+}
+
+func (suite *ServicePinTestSuite) TestErrorWhenPinReply() {
+	parentID := uint(2)
+	suite.respGetByID.ParentID = &parentID
+
+	_, err := suite.service.Pin(1, 1, model.Claims{ID: "AUTHOR"})
+	suite.ErrorIs(err, global.ErrInvalidParent)
+
+	suite.repo.AssertNotCalled(suite.T(), "Pin", mock.Anything, mock.Anything)
+}
+
+func TestServicePinComment(t *testing.T) {
+	suite.Run(t, new(ServicePinTestSuite))
+}
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type Account struct {
+	// ผู้ใช้ login ภายในช่วงนี้หลังขอลบบัญชีเพื่อยกเลิกได้ พ้นจากนี้ข้อมูลส่วนตัวจะถูก purge
+	DeletionGrace time.Duration `env:"ACCOUNT_DELETION_GRACE" envDefault:"720h"`
+	// ตรวจบัญชีที่ถึงกำหนด purge ทุกช่วงนี้ ครั้งละไม่เกิน PurgeBatchSize
+	PurgeInterval  time.Duration `env:"ACCOUNT_PURGE_INTERVAL" envDefault:"1h"`
+	PurgeBatchSize int           `env:"ACCOUNT_PURGE_BATCH_SIZE" envDefault:"20"`
+}
@@ -27,6 +27,7 @@ func (kc Keycloak) LogoutURL() string {
 type IOAuth2Config interface {
 	AuthCodeURL(state string, opts ...oauth2.AuthCodeOption) string
 	Exchange(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error)
+	TokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource
 }
 
 type IOIDCTokenVerifier interface {
@@ -0,0 +1,9 @@
+package config
+
+type Cache struct {
+	// Cache-Control ของแต่ละ route ปล่อยว่างเพื่อไม่ใส่ header
+	RecipeList   string `env:"CACHE_CONTROL_RECIPE_LIST" envDefault:"public, max-age=60, stale-while-revalidate=300"`
+	RecipeDetail string `env:"CACHE_CONTROL_RECIPE_DETAIL" envDefault:"public, no-cache"`
+	// คะแนน trending เปลี่ยนช้า cache ได้นานกว่ารายการปกติ
+	RecipeTrending string `env:"CACHE_CONTROL_RECIPE_TRENDING" envDefault:"public, max-age=300"`
+}
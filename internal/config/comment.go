@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type Comment struct {
+	// ระยะเวลาที่เจ้าของความคิดเห็นยังแก้ไขได้หลังจากโพสต์
+	EditWindow time.Duration `env:"COMMENT_EDIT_WINDOW" envDefault:"15m"`
+}
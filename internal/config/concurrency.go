@@ -0,0 +1,6 @@
+package config
+
+type Concurrency struct {
+	// บังคับให้ PUT/DELETE ต้องส่ง If-Match มาด้วย
+	RequireIfMatch bool `env:"REQUIRE_IF_MATCH" envDefault:"false"`
+}
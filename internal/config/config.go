@@ -1,6 +1,14 @@
 package config
 
 type Config struct {
-	Database Database
-	Keycloak Keycloak
+	Database    Database
+	Keycloak    Keycloak
+	Comment     Comment
+	Concurrency Concurrency
+	Cache       Cache
+	View        View
+	Trending    Trending
+	Rating      Rating
+	Mail        Mail
+	Account     Account
 }
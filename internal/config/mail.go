@@ -0,0 +1,35 @@
+package config
+
+import (
+	"net"
+	"strconv"
+	"time"
+)
+
+type Mail struct {
+	// ส่งอีเมลเฉพาะเมื่อตั้ง SMTP_HOST ทดสอบในเครื่องตั้ง SMTP_HOST=localhost เพื่อใช้ MailHog ที่ port 1025
+	Host     string `env:"SMTP_HOST"`
+	Port     int    `env:"SMTP_PORT" envDefault:"1025"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	From     string `env:"SMTP_FROM" envDefault:"Wongnok <no-reply@wongnok.local>"`
+	// ลิงก์ในอีเมลชี้ไปที่ frontend
+	AppURL string `env:"MAIL_APP_URL" envDefault:"http://localhost:3000"`
+	// ส่ง digest ให้แต่ละคนไม่ถี่กว่าช่วงนี้
+	DigestInterval time.Duration `env:"MAIL_DIGEST_INTERVAL" envDefault:"168h"`
+	TrendingLimit  int           `env:"MAIL_DIGEST_TRENDING_LIMIT" envDefault:"5"`
+	// ตรวจ digest ที่ถึงกำหนดและอีเมลใน outbox ทุกช่วงนี้ ครั้งละไม่เกิน BatchSize
+	PollInterval time.Duration `env:"MAIL_POLL_INTERVAL" envDefault:"30s"`
+	BatchSize    int           `env:"MAIL_BATCH_SIZE" envDefault:"20"`
+	// ส่งไม่สำเร็จรอ RetryBackoff แล้วเพิ่มเป็นเท่าตัวทุกครั้ง ครบ MaxAttempts แล้วเลิกส่ง
+	MaxAttempts  int           `env:"MAIL_MAX_ATTEMPTS" envDefault:"5"`
+	RetryBackoff time.Duration `env:"MAIL_RETRY_BACKOFF" envDefault:"1m"`
+}
+
+func (mail Mail) Enabled() bool {
+	return mail.Host != ""
+}
+
+func (mail Mail) Addr() string {
+	return net.JoinHostPort(mail.Host, strconv.Itoa(mail.Port))
+}
@@ -0,0 +1,32 @@
+package config_test
+
+import (
+	"testing"
+	"wongnok/internal/config"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMail(t *testing.T) {
+	t.Run("ShouldJoinHostAndPort", func(t *testing.T) {
+		mail := config.Mail{Host: "localhost", Port: 1025}
+
+		assert.Equal(t, "localhost:1025", mail.Addr())
+	})
+
+	t.Run("ShouldEnableWhenHostSet", func(t *testing.T) {
+		assert.True(t, config.Mail{Host: "localhost"}.Enabled())
+	})
+
+	t.Run("ShouldDisableWhenHostEmpty", func(t *testing.T) {
+		assert.False(t, config.Mail{}.Enabled())
+	})
+
+	t.Run("ShouldDisableByDefault", func(t *testing.T) {
+		var mail config.Mail
+		assert.NoError(t, env.ParseWithOptions(&mail, env.Options{Environment: map[string]string{}}))
+
+		assert.False(t, mail.Enabled())
+	})
+}
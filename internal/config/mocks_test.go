@@ -188,6 +188,65 @@ func (_c *MockIOAuth2Config_Exchange_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// TokenSource provides a mock function for the type MockIOAuth2Config
+func (_mock *MockIOAuth2Config) TokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TokenSource")
+	}
+
+	var r0 oauth2.TokenSource
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *oauth2.Token) oauth2.TokenSource); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(oauth2.TokenSource)
+		}
+	}
+	return r0
+}
+
+// MockIOAuth2Config_TokenSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenSource'
+type MockIOAuth2Config_TokenSource_Call struct {
+	*mock.Call
+}
+
+// TokenSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *oauth2.Token
+func (_e *MockIOAuth2Config_Expecter) TokenSource(ctx interface{}, token interface{}) *MockIOAuth2Config_TokenSource_Call {
+	return &MockIOAuth2Config_TokenSource_Call{Call: _e.mock.On("TokenSource", ctx, token)}
+}
+
+func (_c *MockIOAuth2Config_TokenSource_Call) Run(run func(ctx context.Context, token *oauth2.Token)) *MockIOAuth2Config_TokenSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *oauth2.Token
+		if args[1] != nil {
+			arg1 = args[1].(*oauth2.Token)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOAuth2Config_TokenSource_Call) Return(tokenSource oauth2.TokenSource) *MockIOAuth2Config_TokenSource_Call {
+	_c.Call.Return(tokenSource)
+	return _c
+}
+
+func (_c *MockIOAuth2Config_TokenSource_Call) RunAndReturn(run func(ctx context.Context, token *oauth2.Token) oauth2.TokenSource) *MockIOAuth2Config_TokenSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIOIDCTokenVerifier creates a new instance of MockIOIDCTokenVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIOIDCTokenVerifier(t interface {
@@ -0,0 +1,6 @@
+package config
+
+type Rating struct {
+	// จำนวน rating สมมติที่ค่าเฉลี่ยรวมของทุกสูตร ยิ่งมากสูตรที่มี rating น้อยยิ่งถูกดึงเข้าหาค่าเฉลี่ยรวม
+	PriorWeight float64 `env:"RATING_PRIOR_WEIGHT" envDefault:"10"`
+}
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+type Trending struct {
+	// คะแนนลดลงครึ่งหนึ่งทุกช่วงเวลานี้
+	HalfLife time.Duration `env:"TRENDING_HALF_LIFE" envDefault:"24h"`
+	// นับเฉพาะกิจกรรมย้อนหลังไม่เกินช่วงนี้
+	Window time.Duration `env:"TRENDING_WINDOW" envDefault:"168h"`
+	// น้ำหนักเทียบกับการเปิดดู 1 ครั้ง
+	FavoriteWeight float64 `env:"TRENDING_FAVORITE_WEIGHT" envDefault:"5"`
+	RatingWeight   float64 `env:"TRENDING_RATING_WEIGHT" envDefault:"3"`
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+type View struct {
+	// ผู้ชมคนเดิมเปิดสูตรเดิมซ้ำในช่วงนี้นับเป็นครั้งเดียว
+	DedupWindow time.Duration `env:"VIEW_DEDUP_WINDOW" envDefault:"30m"`
+	// เขียนยอดวิวลงฐานข้อมูลทุกช่วงนี้ หรือเมื่อสะสมครบ BatchSize
+	FlushInterval time.Duration `env:"VIEW_FLUSH_INTERVAL" envDefault:"10s"`
+	BatchSize     int           `env:"VIEW_BATCH_SIZE" envDefault:"500"`
+	// จำนวนวิวที่รอเขียนได้สูงสุด เกินจากนี้จะทิ้งเพื่อไม่ให้ request ต้องรอ
+	BufferSize int `env:"VIEW_BUFFER_SIZE" envDefault:"10000"`
+	// ระยะเวลาที่เก็บยอดวิวรายชั่วโมง ยอดรายวันเก็บตลอด
+	HourlyRetention time.Duration `env:"VIEW_HOURLY_RETENTION" envDefault:"720h"`
+}
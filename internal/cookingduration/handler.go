@@ -0,0 +1,192 @@
+package cookingduration
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Retire(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// Get godoc
+// @Summary Get cooking durations
+// @Description Get cooking durations in sort order, names localized by lang query or Accept-Language
+// @Tags cooking-durations
+// @Accept json
+// @Produce json
+// @Param lang query string false "Language (en, th)"
+// @Param includeRetired query bool false "Include retired cooking durations"
+// @Success 200 {object} dto.CookingDurationsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/cooking-durations [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	var query model.ReferenceDataQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	durations, err := handler.Service.Get(query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, durations.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Create godoc
+// @Summary Create a cooking duration
+// @Description Requires the reference-data:manage permission, granted to the admin role
+// @Tags cooking-durations
+// @Accept json
+// @Produce json
+// @Param request body dto.CookingDurationRequest true "Cooking Duration Request"
+// @Success 201 {object} dto.CookingDurationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/cooking-durations [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	var request dto.CookingDurationRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	duration, err := handler.Service.Create(request)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, duration.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Update godoc
+// @Summary Update a cooking duration
+// @Description Requires the reference-data:manage permission, granted to the admin role
+// @Tags cooking-durations
+// @Accept json
+// @Produce json
+// @Param id path int true "Cooking Duration ID"
+// @Param request body dto.CookingDurationRequest true "Cooking Duration Request"
+// @Success 200 {object} dto.CookingDurationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/cooking-durations/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	var request dto.CookingDurationRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	duration, err := handler.Service.Update(request, pathParamID(ctx, "id"))
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, duration.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Retire godoc
+// @Summary Retire a cooking duration
+// @Description Requires the reference-data:manage permission, granted to the admin role. Retired cooking durations stay on existing recipes but can't be used by new ones
+// @Tags cooking-durations
+// @Produce json
+// @Param id path int true "Cooking Duration ID"
+// @Success 200 {object} dto.CookingDurationResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/cooking-durations/{id}/retire [put]
+func (handler Handler) Retire(ctx *gin.Context) {
+	duration, err := handler.Service.Retire(pathParamID(ctx, "id"))
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, duration.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Restore godoc
+// @Summary Restore a retired cooking duration
+// @Description Requires the reference-data:manage permission, granted to the admin role
+// @Tags cooking-durations
+// @Produce json
+// @Param id path int true "Cooking Duration ID"
+// @Success 200 {object} dto.CookingDurationResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/cooking-durations/{id}/retire [delete]
+func (handler Handler) Restore(ctx *gin.Context) {
+	duration, err := handler.Service.Restore(pathParamID(ctx, "id"))
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, duration.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+func pathParamID(ctx *gin.Context, name string) int {
+	var id int
+
+	pathParam := ctx.Param(name)
+	if pathParam != "" {
+		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
+			id = parsed
+		}
+	}
+
+	return id
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,75 @@
+package cookingduration
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(query model.ReferenceDataQuery) (model.CookingDurations, error)
+	GetByID(id int) (model.CookingDuration, error)
+	GetByMinutes(minutes int) (model.CookingDuration, error)
+	Create(duration *model.CookingDuration) error
+	Update(duration *model.CookingDuration) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) Get(query model.ReferenceDataQuery) (model.CookingDurations, error) {
+	var durations = make(model.CookingDurations, 0)
+
+	db := repo.DB
+	if !query.IncludeRetired {
+		db = db.Where("retired_at IS NULL")
+	}
+
+	if err := db.Order("sort_order asc, id asc").Find(&durations).Error; err != nil {
+		return nil, err
+	}
+
+	return durations, nil
+}
+
+func (repo Repository) GetByID(id int) (model.CookingDuration, error) {
+	var duration model.CookingDuration
+
+	if err := repo.DB.First(&duration, id).Error; err != nil {
+		return model.CookingDuration{}, err
+	}
+
+	return duration, nil
+}
+
+// GetByMinutes หาหมวดเวลาแรกที่ครอบคลุมเวลารวม ไม่รวมหมวดที่เลิกใช้แล้ว
+// เวลาที่น้อยกว่าหมวดแรกจะได้หมวดแรก
+func (repo Repository) GetByMinutes(minutes int) (model.CookingDuration, error) {
+	var duration model.CookingDuration
+
+	err := repo.DB.
+		Where("retired_at IS NULL").
+		Where("(max_minutes IS NULL OR max_minutes >= ?)", minutes).
+		Order("min_minutes asc, sort_order asc, id asc").
+		First(&duration).Error
+	if err != nil {
+		return model.CookingDuration{}, err
+	}
+
+	return duration, nil
+}
+
+func (repo Repository) Create(duration *model.CookingDuration) error {
+	return repo.DB.Create(duration).Error
+}
+
+func (repo Repository) Update(duration *model.CookingDuration) error {
+	return repo.DB.Save(duration).Error
+}
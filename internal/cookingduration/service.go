@@ -0,0 +1,118 @@
+package cookingduration
+
+import (
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.ReferenceDataQuery) (model.CookingDurations, error)
+	GetByID(id int) (model.CookingDuration, error)
+	GetByMinutes(minutes int) (model.CookingDuration, error)
+	Create(request dto.CookingDurationRequest) (model.CookingDuration, error)
+	Update(request dto.CookingDurationRequest, id int) (model.CookingDuration, error)
+	Retire(id int) (model.CookingDuration, error)
+	Restore(id int) (model.CookingDuration, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.ReferenceDataQuery) (model.CookingDurations, error) {
+	return service.Repository.Get(query)
+}
+
+func (service Service) GetByID(id int) (model.CookingDuration, error) {
+	return service.Repository.GetByID(id)
+}
+
+func (service Service) GetByMinutes(minutes int) (model.CookingDuration, error) {
+	return service.Repository.GetByMinutes(minutes)
+}
+
+func (service Service) Create(request dto.CookingDurationRequest) (model.CookingDuration, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "request invalid")
+	}
+
+	var duration model.CookingDuration
+	duration = duration.FromRequest(request)
+
+	if err := service.Repository.Create(&duration); err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "create cooking duration")
+	}
+
+	return duration, nil
+}
+
+func (service Service) Update(request dto.CookingDurationRequest, id int) (model.CookingDuration, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "request invalid")
+	}
+
+	duration, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "find cooking duration")
+	}
+
+	duration = duration.FromRequest(request)
+
+	if err := service.Repository.Update(&duration); err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "update cooking duration")
+	}
+
+	return duration, nil
+}
+
+func (service Service) Retire(id int) (model.CookingDuration, error) {
+	duration, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "find cooking duration")
+	}
+
+	if duration.IsRetired() {
+		return duration, nil
+	}
+
+	now := time.Now()
+	duration.RetiredAt = &now
+
+	if err := service.Repository.Update(&duration); err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "retire cooking duration")
+	}
+
+	return duration, nil
+}
+
+func (service Service) Restore(id int) (model.CookingDuration, error) {
+	duration, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "find cooking duration")
+	}
+
+	if !duration.IsRetired() {
+		return duration, nil
+	}
+
+	duration.RetiredAt = nil
+
+	if err := service.Repository.Update(&duration); err != nil {
+		return model.CookingDuration{}, errors.Wrap(err, "restore cooking duration")
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,192 @@
+package difficulty
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Retire(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// Get godoc
+// @Summary Get difficulties
+// @Description Get difficulties in sort order, names localized by lang query or Accept-Language
+// @Tags difficulties
+// @Accept json
+// @Produce json
+// @Param lang query string false "Language (en, th)"
+// @Param includeRetired query bool false "Include retired difficulties"
+// @Success 200 {object} dto.DifficultiesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/difficulties [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	var query model.ReferenceDataQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	difficulties, err := handler.Service.Get(query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, difficulties.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Create godoc
+// @Summary Create a difficulty
+// @Description Requires the reference-data:manage permission, granted to the admin role
+// @Tags difficulties
+// @Accept json
+// @Produce json
+// @Param request body dto.DifficultyRequest true "Difficulty Request"
+// @Success 201 {object} dto.DifficultyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/difficulties [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	var request dto.DifficultyRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	difficulty, err := handler.Service.Create(request)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, difficulty.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Update godoc
+// @Summary Update a difficulty
+// @Description Requires the reference-data:manage permission, granted to the admin role
+// @Tags difficulties
+// @Accept json
+// @Produce json
+// @Param id path int true "Difficulty ID"
+// @Param request body dto.DifficultyRequest true "Difficulty Request"
+// @Success 200 {object} dto.DifficultyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/difficulties/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	var request dto.DifficultyRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	difficulty, err := handler.Service.Update(request, pathParamID(ctx, "id"))
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, difficulty.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Retire godoc
+// @Summary Retire a difficulty
+// @Description Requires the reference-data:manage permission, granted to the admin role. Retired difficulties stay on existing recipes but can't be used by new ones
+// @Tags difficulties
+// @Produce json
+// @Param id path int true "Difficulty ID"
+// @Success 200 {object} dto.DifficultyResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/difficulties/{id}/retire [put]
+func (handler Handler) Retire(ctx *gin.Context) {
+	difficulty, err := handler.Service.Retire(pathParamID(ctx, "id"))
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, difficulty.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+// Restore godoc
+// @Summary Restore a retired difficulty
+// @Description Requires the reference-data:manage permission, granted to the admin role
+// @Tags difficulties
+// @Produce json
+// @Param id path int true "Difficulty ID"
+// @Success 200 {object} dto.DifficultyResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/difficulties/{id}/retire [delete]
+func (handler Handler) Restore(ctx *gin.Context) {
+	difficulty, err := handler.Service.Restore(pathParamID(ctx, "id"))
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, difficulty.ToResponse(helper.DecodeLanguage(ctx)))
+}
+
+func pathParamID(ctx *gin.Context, name string) int {
+	var id int
+
+	pathParam := ctx.Param(name)
+	if pathParam != "" {
+		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
+			id = parsed
+		}
+	}
+
+	return id
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
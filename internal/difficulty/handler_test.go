@@ -0,0 +1,277 @@
+package difficulty_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/difficulty"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := difficulty.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler difficulty.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceGet model.Difficulties
+	errServiceGet  error
+
+	// Helper
+	server func(url string, header http.Header) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerGetTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerGetTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = difficulty.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(url string, header http.Header) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.GET("/api/v1/difficulties", suite.handler.Get)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		suite.NoError(err)
+		if header != nil {
+			request.Header = header
+		}
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceGet = model.Difficulties{
+		{Model: gorm.Model{ID: 1}, Name: "Easy", NameTH: "ง่าย", SortOrder: 1},
+		{Model: gorm.Model{ID: 2}, Name: "Medium", SortOrder: 2},
+	}
+	suite.errServiceGet = nil
+
+	suite.service.On("Get", mock.Anything).Return(func(model.ReferenceDataQuery) (model.Difficulties, error) {
+		return suite.respServiceGet, suite.errServiceGet
+	})
+}
+
+func (suite *HandlerGetTestSuite) TestResponseDifficultiesWithStatusCode200() {
+	response := suite.server("/api/v1/difficulties", nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(suite.respServiceGet.ToResponse(model.LanguageEnglish))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Get", model.ReferenceDataQuery{})
+}
+
+func (suite *HandlerGetTestSuite) TestResponseLocalizedNames() {
+	response := suite.server("/api/v1/difficulties?includeRetired=true", http.Header{"Accept-Language": {"th-TH,th;q=0.9"}})
+
+	body := response.Result().Body
+	defer body.Close()
+
+	var result dto.DifficultiesResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &result))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("ง่าย", result.Results[0].Name)
+	// ไม่มีชื่อภาษาไทยให้ใช้ชื่อภาษาอังกฤษแทน
+	suite.Equal("Medium", result.Results[1].Name)
+	suite.service.AssertCalled(suite.T(), "Get", model.ReferenceDataQuery{IncludeRetired: true})
+}
+
+func (suite *HandlerGetTestSuite) TestResponseErrorWhenServiceGet() {
+	suite.errServiceGet = assert.AnError
+
+	response := suite.server("/api/v1/difficulties", nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+type HandlerManageTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler difficulty.IHandler
+	service *MockIService
+
+	// Mock data
+	respService model.Difficulty
+	errService  error
+
+	// Helper
+	server func(method string, url string, payload io.Reader) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerManageTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerManageTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = difficulty.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, payload io.Reader) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.POST("/api/v1/difficulties", suite.handler.Create)
+		router.PUT("/api/v1/difficulties/:id", suite.handler.Update)
+		router.PUT("/api/v1/difficulties/:id/retire", suite.handler.Retire)
+		router.DELETE("/api/v1/difficulties/:id/retire", suite.handler.Restore)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respService = model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy", SortOrder: 1}
+	suite.errService = nil
+
+	result := func(args ...any) (model.Difficulty, error) {
+		return suite.respService, suite.errService
+	}
+	suite.service.On("Create", mock.Anything).Return(func(dto.DifficultyRequest) (model.Difficulty, error) {
+		return result()
+	})
+	suite.service.On("Update", mock.Anything, mock.Anything).Return(func(dto.DifficultyRequest, int) (model.Difficulty, error) {
+		return result()
+	})
+	suite.service.On("Retire", mock.Anything).Return(func(int) (model.Difficulty, error) {
+		return result()
+	})
+	suite.service.On("Restore", mock.Anything).Return(func(int) (model.Difficulty, error) {
+		return result()
+	})
+}
+
+func (suite *HandlerManageTestSuite) TestCreateWithStatusCode201() {
+	response := suite.server(http.MethodPost, "/api/v1/difficulties", strings.NewReader(`{"name":"Easy","sortOrder":1}`))
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(suite.respService.ToResponse(model.LanguageEnglish))
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.DifficultyRequest{Name: "Easy", SortOrder: 1})
+}
+
+func (suite *HandlerManageTestSuite) TestCreateStatusCode400WhenValidationErrors() {
+	suite.errService = make(validator.ValidationErrors, 0)
+
+	response := suite.server(http.MethodPost, "/api/v1/difficulties", strings.NewReader(`{}`))
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (suite *HandlerManageTestSuite) TestUpdateWithStatusCode200() {
+	response := suite.server(http.MethodPut, "/api/v1/difficulties/1", strings.NewReader(`{"name":"Easy"}`))
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Update", dto.DifficultyRequest{Name: "Easy"}, 1)
+}
+
+func (suite *HandlerManageTestSuite) TestUpdateStatusCode404WhenNotFound() {
+	suite.errService = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodPut, "/api/v1/difficulties/9", strings.NewReader(`{"name":"Easy"}`))
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerManageTestSuite) TestRetireWithStatusCode200() {
+	response := suite.server(http.MethodPut, "/api/v1/difficulties/1/retire", nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Retire", 1)
+}
+
+func (suite *HandlerManageTestSuite) TestRestoreWithStatusCode200() {
+	response := suite.server(http.MethodDelete, "/api/v1/difficulties/1/retire", nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Restore", 1)
+}
+
+func (suite *HandlerManageTestSuite) TestResponseErrorWhenService() {
+	suite.errService = assert.AnError
+
+	response := suite.server(http.MethodPut, "/api/v1/difficulties/1/retire", nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
+func TestHandlerManage(t *testing.T) {
+	suite.Run(t, new(HandlerManageTestSuite))
+}
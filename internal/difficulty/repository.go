@@ -0,0 +1,57 @@
+package difficulty
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(query model.ReferenceDataQuery) (model.Difficulties, error)
+	GetByID(id int) (model.Difficulty, error)
+	Create(difficulty *model.Difficulty) error
+	Update(difficulty *model.Difficulty) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) Get(query model.ReferenceDataQuery) (model.Difficulties, error) {
+	var difficulties = make(model.Difficulties, 0)
+
+	db := repo.DB
+	if !query.IncludeRetired {
+		db = db.Where("retired_at IS NULL")
+	}
+
+	if err := db.Order("sort_order asc, id asc").Find(&difficulties).Error; err != nil {
+		return nil, err
+	}
+
+	return difficulties, nil
+}
+
+func (repo Repository) GetByID(id int) (model.Difficulty, error) {
+	var difficulty model.Difficulty
+
+	if err := repo.DB.First(&difficulty, id).Error; err != nil {
+		return model.Difficulty{}, err
+	}
+
+	return difficulty, nil
+}
+
+func (repo Repository) Create(difficulty *model.Difficulty) error {
+	return repo.DB.Create(difficulty).Error
+}
+
+func (repo Repository) Update(difficulty *model.Difficulty) error {
+	return repo.DB.Save(difficulty).Error
+}
@@ -0,0 +1,113 @@
+package difficulty
+
+import (
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.ReferenceDataQuery) (model.Difficulties, error)
+	GetByID(id int) (model.Difficulty, error)
+	Create(request dto.DifficultyRequest) (model.Difficulty, error)
+	Update(request dto.DifficultyRequest, id int) (model.Difficulty, error)
+	Retire(id int) (model.Difficulty, error)
+	Restore(id int) (model.Difficulty, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.ReferenceDataQuery) (model.Difficulties, error) {
+	return service.Repository.Get(query)
+}
+
+func (service Service) GetByID(id int) (model.Difficulty, error) {
+	return service.Repository.GetByID(id)
+}
+
+func (service Service) Create(request dto.DifficultyRequest) (model.Difficulty, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "request invalid")
+	}
+
+	var difficulty model.Difficulty
+	difficulty = difficulty.FromRequest(request)
+
+	if err := service.Repository.Create(&difficulty); err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "create difficulty")
+	}
+
+	return difficulty, nil
+}
+
+func (service Service) Update(request dto.DifficultyRequest, id int) (model.Difficulty, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "request invalid")
+	}
+
+	difficulty, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "find difficulty")
+	}
+
+	difficulty = difficulty.FromRequest(request)
+
+	if err := service.Repository.Update(&difficulty); err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "update difficulty")
+	}
+
+	return difficulty, nil
+}
+
+func (service Service) Retire(id int) (model.Difficulty, error) {
+	difficulty, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "find difficulty")
+	}
+
+	if difficulty.IsRetired() {
+		return difficulty, nil
+	}
+
+	now := time.Now()
+	difficulty.RetiredAt = &now
+
+	if err := service.Repository.Update(&difficulty); err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "retire difficulty")
+	}
+
+	return difficulty, nil
+}
+
+func (service Service) Restore(id int) (model.Difficulty, error) {
+	difficulty, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "find difficulty")
+	}
+
+	if !difficulty.IsRetired() {
+		return difficulty, nil
+	}
+
+	difficulty.RetiredAt = nil
+
+	if err := service.Repository.Update(&difficulty); err != nil {
+		return model.Difficulty{}, errors.Wrap(err, "restore difficulty")
+	}
+
+	return difficulty, nil
+}
@@ -0,0 +1,267 @@
+package difficulty_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/difficulty"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := difficulty.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service difficulty.IService
+	repo    *MockIRepository
+
+	// Mock data
+	errRepositoryCreate error
+}
+
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &difficulty.Service{
+		Repository: suite.repo,
+	}
+
+	suite.errRepositoryCreate = nil
+
+	suite.repo.On("Create", mock.Anything).Return(func(*model.Difficulty) error {
+		return suite.errRepositoryCreate
+	})
+}
+
+func (suite *ServiceCreateTestSuite) TestReturnDifficultyCreated() {
+	result, err := suite.service.Create(dto.DifficultyRequest{Name: "Expert", NameTH: "ยากมาก", SortOrder: 4})
+	suite.NoError(err)
+
+	expected := model.Difficulty{Name: "Expert", NameTH: "ยากมาก", SortOrder: 4}
+
+	suite.Equal(expected, result)
+	suite.repo.AssertCalled(suite.T(), "Create", &expected)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestValidate() {
+	result, err := suite.service.Create(dto.DifficultyRequest{})
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRepositoryCreate() {
+	suite.errRepositoryCreate = assert.AnError
+
+	result, err := suite.service.Create(dto.DifficultyRequest{Name: "Expert"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "create difficulty"))
+
+	suite.Empty(result)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service difficulty.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID         model.Difficulty
+	errGetByID          error
+	errRepositoryUpdate error
+}
+
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &difficulty.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetByID = model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy", SortOrder: 1}
+	suite.errGetByID = nil
+	suite.errRepositoryUpdate = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.Difficulty, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything).Return(func(*model.Difficulty) error {
+		return suite.errRepositoryUpdate
+	})
+}
+
+func (suite *ServiceUpdateTestSuite) TestReturnDifficultyUpdated() {
+	result, err := suite.service.Update(dto.DifficultyRequest{Name: "Simple", NameTH: "ง่าย", SortOrder: 2}, 1)
+	suite.NoError(err)
+
+	expected := model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Simple", NameTH: "ง่าย", SortOrder: 2}
+
+	suite.Equal(expected, result)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 1)
+	suite.repo.AssertCalled(suite.T(), "Update", &expected)
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepRetiredAtWhenUpdated() {
+	retiredAt := time.Now()
+	suite.respGetByID.RetiredAt = &retiredAt
+
+	result, err := suite.service.Update(dto.DifficultyRequest{Name: "Simple"}, 1)
+	suite.NoError(err)
+
+	suite.True(result.IsRetired())
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenRequestValidate() {
+	result, err := suite.service.Update(dto.DifficultyRequest{}, 1)
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenGetByID() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	result, err := suite.service.Update(dto.DifficultyRequest{Name: "Simple"}, 1)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.True(strings.HasPrefix(err.Error(), "find difficulty"))
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenRepositoryUpdate() {
+	suite.errRepositoryUpdate = assert.AnError
+
+	result, err := suite.service.Update(dto.DifficultyRequest{Name: "Simple"}, 1)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "update difficulty"))
+
+	suite.Empty(result)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServiceRetireTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service difficulty.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID         model.Difficulty
+	errGetByID          error
+	errRepositoryUpdate error
+}
+
+func (suite *ServiceRetireTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &difficulty.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetByID = model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy"}
+	suite.errGetByID = nil
+	suite.errRepositoryUpdate = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.Difficulty, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything).Return(func(*model.Difficulty) error {
+		return suite.errRepositoryUpdate
+	})
+}
+
+func (suite *ServiceRetireTestSuite) TestReturnDifficultyRetired() {
+	result, err := suite.service.Retire(1)
+	suite.NoError(err)
+
+	suite.True(result.IsRetired())
+	suite.repo.AssertCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceRetireTestSuite) TestSkipUpdateWhenAlreadyRetired() {
+	retiredAt := time.Now()
+	suite.respGetByID.RetiredAt = &retiredAt
+
+	result, err := suite.service.Retire(1)
+	suite.NoError(err)
+
+	suite.Equal(&retiredAt, result.RetiredAt)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceRetireTestSuite) TestReturnDifficultyRestored() {
+	retiredAt := time.Now()
+	suite.respGetByID.RetiredAt = &retiredAt
+
+	result, err := suite.service.Restore(1)
+	suite.NoError(err)
+
+	suite.False(result.IsRetired())
+	suite.repo.AssertCalled(suite.T(), "Update", &model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy"})
+}
+
+func (suite *ServiceRetireTestSuite) TestSkipUpdateWhenNotRetired() {
+	result, err := suite.service.Restore(1)
+	suite.NoError(err)
+
+	suite.False(result.IsRetired())
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceRetireTestSuite) TestErrorWhenGetByID() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	result, err := suite.service.Retire(1)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Empty(result)
+
+	result, err = suite.service.Restore(1)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Empty(result)
+}
+
+func (suite *ServiceRetireTestSuite) TestErrorWhenRepositoryUpdate() {
+	suite.errRepositoryUpdate = assert.AnError
+
+	result, err := suite.service.Retire(1)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "retire difficulty"))
+
+	suite.Empty(result)
+}
+
+func TestServiceRetire(t *testing.T) {
+	suite.Run(t, new(ServiceRetireTestSuite))
+}
@@ -0,0 +1,173 @@
+package email
+
+import (
+	"log"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/model"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IFoodRecipeService foodrecipe.IService
+
+// จำนวนสูตรใหม่จากคนที่ติดตามสูงสุดต่อ digest
+const digestRecipeLimit = 10
+
+// Dispatcher ทำงานใน goroutine แยก ทุก PollInterval จะสร้าง digest ของผู้รับที่ถึงรอบลง outbox
+// แล้วส่งอีเมลใน outbox ที่ถึงกำหนด อีเมลที่ส่งไม่สำเร็จจะถูกส่งใหม่ในรอบถัดไปตาม backoff
+type Dispatcher struct {
+	Repository        IRepository
+	Mailer            IMailer
+	FoodRecipeService IFoodRecipeService
+	Config            config.Mail
+	Trending          model.TrendingQuery
+
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func NewDispatcher(db *gorm.DB, conf config.Mail, trending config.Trending, rating config.Rating) *Dispatcher {
+	return &Dispatcher{
+		Repository:        NewRepository(db),
+		Mailer:            NewMailer(conf),
+		FoodRecipeService: foodrecipe.NewService(db),
+		Config:            conf,
+		Trending: model.TrendingQuery{
+			Limit:             conf.TrendingLimit,
+			HalfLife:          trending.HalfLife,
+			Window:            trending.Window,
+			FavoriteWeight:    trending.FavoriteWeight,
+			RatingWeight:      trending.RatingWeight,
+			RatingPriorWeight: rating.PriorWeight,
+		},
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// Start ต้องเรียก Close ก่อนปิดโปรแกรม อีเมลที่ยังไม่ได้ส่งอยู่ใน outbox และจะส่งเมื่อเริ่มใหม่
+func (dispatcher *Dispatcher) Start() {
+	go dispatcher.run()
+}
+
+// Close รอให้รอบที่กำลังทำอยู่เสร็จก่อน
+func (dispatcher *Dispatcher) Close() {
+	close(dispatcher.done)
+	<-dispatcher.stopped
+}
+
+func (dispatcher *Dispatcher) run() {
+	defer close(dispatcher.stopped)
+
+	ticker := time.NewTicker(dispatcher.Config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := dispatcher.EnqueueDigests(now); err != nil {
+				log.Println("Error when enqueue digests:", err)
+			}
+			if err := dispatcher.Deliver(now); err != nil {
+				log.Println("Error when deliver emails:", err)
+			}
+		case <-dispatcher.done:
+			return
+		}
+	}
+}
+
+// EnqueueDigests ผู้รับที่สร้าง digest ไม่สำเร็จจะถูกข้ามไปและลองใหม่ในรอบถัดไป
+func (dispatcher *Dispatcher) EnqueueDigests(now time.Time) error {
+	subscriptions, err := dispatcher.Repository.GetDueSubscriptions(now.Add(-dispatcher.Config.DigestInterval), dispatcher.Config.BatchSize)
+	if err != nil {
+		return errors.Wrap(err, "find due subscriptions")
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	// trending เหมือนกันทุกคนในรอบเดียวกัน
+	trending, err := dispatcher.FoodRecipeService.GetTrending(dispatcher.Trending)
+	if err != nil {
+		return errors.Wrap(err, "find trending recipes")
+	}
+
+	for _, subscription := range subscriptions {
+		if err := dispatcher.enqueueDigest(subscription, trending, now); err != nil {
+			log.Println("Error when enqueue digest:", err)
+		}
+	}
+
+	return nil
+}
+
+func (dispatcher *Dispatcher) enqueueDigest(subscription model.DigestSubscription, trending model.FoodRecipes, now time.Time) error {
+	since := subscription.Since(now, dispatcher.Config.DigestInterval)
+
+	ratings, err := dispatcher.Repository.GetDigestRatings(subscription.UserID, since)
+	if err != nil {
+		return errors.Wrap(err, "find digest ratings")
+	}
+
+	recipes, err := dispatcher.Repository.GetDigestRecipes(subscription.UserID, since, digestRecipeLimit)
+	if err != nil {
+		return errors.Wrap(err, "find digest recipes")
+	}
+
+	digest := model.Digest{
+		User:     subscription.User,
+		Locale:   subscription.Locale,
+		Since:    since,
+		Ratings:  ratings,
+		Recipes:  recipes,
+		Trending: trending,
+	}
+
+	var outbox *model.OutboxEmail
+	if !digest.IsEmpty() {
+		message, err := RenderDigest(digest, dispatcher.Config.AppURL)
+		if err != nil {
+			return errors.Wrap(err, "render digest")
+		}
+
+		queued := model.OutboxEmail{}.FromMessage(subscription.UserID, message, now)
+		outbox = &queued
+	}
+
+	if err := dispatcher.Repository.SaveDigest(subscription.UserID, now, outbox); err != nil {
+		return errors.Wrap(err, "save digest")
+	}
+
+	return nil
+}
+
+// Deliver ส่งอีเมลที่ถึงกำหนด ระหว่างส่งอีเมลถูกจองไว้หนึ่งช่วง RetryBackoff
+func (dispatcher *Dispatcher) Deliver(now time.Time) error {
+	outboxes, err := dispatcher.Repository.ClaimDue(now, dispatcher.Config.BatchSize, now.Add(dispatcher.Config.RetryBackoff))
+	if err != nil {
+		return errors.Wrap(err, "claim outbox")
+	}
+
+	for _, outbox := range outboxes {
+		if err := dispatcher.Mailer.Send(outbox.ToMessage()); err != nil {
+			log.Println("Error when send email:", err)
+
+			failed := outbox.Retry(err, now, dispatcher.Config.RetryBackoff, dispatcher.Config.MaxAttempts)
+			if err := dispatcher.Repository.MarkFailed(failed); err != nil {
+				log.Println("Error when mark email failed:", err)
+			}
+			continue
+		}
+
+		if err := dispatcher.Repository.MarkSent(outbox.ID, now); err != nil {
+			log.Println("Error when mark email sent:", err)
+		}
+	}
+
+	return nil
+}
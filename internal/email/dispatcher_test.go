@@ -0,0 +1,235 @@
+package email_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/email"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewDispatcher(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		dispatcher := email.NewDispatcher(&gorm.DB{}, config.Mail{TrendingLimit: 5}, config.Trending{HalfLife: time.Hour}, config.Rating{PriorWeight: 10})
+
+		value := reflect.Indirect(reflect.ValueOf(dispatcher))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type DispatcherTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	dispatcher        *email.Dispatcher
+	repo              *MockIRepository
+	mailer            *MockIMailer
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	now                  time.Time
+	conf                 config.Mail
+	respGetDue           []model.DigestSubscription
+	errGetDue            error
+	respGetDigestRatings []model.DigestRecipeRatings
+	errGetDigestRatings  error
+	respGetDigestRecipes model.FoodRecipes
+	respGetTrending      model.FoodRecipes
+	errGetTrending       error
+	errSaveDigest        error
+	respClaimDue         []model.OutboxEmail
+	errClaimDue          error
+	errSend              error
+}
+
+func (suite *DispatcherTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.mailer = new(MockIMailer)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+
+	suite.now = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	suite.conf = config.Mail{
+		AppURL:         "http://localhost:3000",
+		DigestInterval: 168 * time.Hour,
+		BatchSize:      20,
+		MaxAttempts:    3,
+		RetryBackoff:   time.Minute,
+		PollInterval:   time.Hour,
+	}
+	suite.dispatcher = email.NewDispatcher(&gorm.DB{}, suite.conf, config.Trending{}, config.Rating{})
+	suite.dispatcher.Repository = suite.repo
+	suite.dispatcher.Mailer = suite.mailer
+	suite.dispatcher.FoodRecipeService = suite.foodRecipeService
+
+	suite.respGetDue = []model.DigestSubscription{
+		{UserID: "UID", Locale: model.LocaleEnglish, User: model.User{ID: "UID", FirstName: "Demo", Email: "demo@example.com"}},
+	}
+	suite.errGetDue = nil
+	suite.respGetDigestRatings = []model.DigestRecipeRatings{{FoodRecipeID: 1, Name: "Tom Yum", Count: 1, ScoreSum: 5}}
+	suite.errGetDigestRatings = nil
+	suite.respGetDigestRecipes = model.FoodRecipes{}
+	suite.respGetTrending = model.FoodRecipes{}
+	suite.errGetTrending = nil
+	suite.errSaveDigest = nil
+	suite.respClaimDue = []model.OutboxEmail{
+		{ID: 1, ToAddress: "demo@example.com", Subject: "Subject", Attempts: 1},
+	}
+	suite.errClaimDue = nil
+	suite.errSend = nil
+
+	suite.repo.On("GetDueSubscriptions", mock.Anything, mock.Anything).Return(func(time.Time, int) ([]model.DigestSubscription, error) {
+		return suite.respGetDue, suite.errGetDue
+	})
+	suite.repo.On("GetDigestRatings", mock.Anything, mock.Anything).Return(func(string, time.Time) ([]model.DigestRecipeRatings, error) {
+		return suite.respGetDigestRatings, suite.errGetDigestRatings
+	})
+	suite.repo.On("GetDigestRecipes", mock.Anything, mock.Anything, mock.Anything).Return(func(string, time.Time, int) (model.FoodRecipes, error) {
+		return suite.respGetDigestRecipes, nil
+	})
+	suite.repo.On("SaveDigest", mock.Anything, mock.Anything, mock.Anything).Return(func(string, time.Time, *model.OutboxEmail) error {
+		return suite.errSaveDigest
+	})
+	suite.repo.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything).Return(func(time.Time, int, time.Time) ([]model.OutboxEmail, error) {
+		return suite.respClaimDue, suite.errClaimDue
+	})
+	suite.repo.On("MarkSent", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("MarkFailed", mock.Anything).Return(nil)
+	suite.foodRecipeService.On("GetTrending", mock.Anything).Return(func(model.TrendingQuery) (model.FoodRecipes, error) {
+		return suite.respGetTrending, suite.errGetTrending
+	})
+	suite.mailer.On("Send", mock.Anything).Return(func(model.EmailMessage) error {
+		return suite.errSend
+	})
+}
+
+func (suite *DispatcherTestSuite) TestEnqueueDigestToOutbox() {
+	err := suite.dispatcher.EnqueueDigests(suite.now)
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "GetDueSubscriptions", suite.now.Add(-suite.conf.DigestInterval), suite.conf.BatchSize)
+	// ยังไม่เคยได้รับ ย้อนหลังหนึ่งรอบ
+	suite.repo.AssertCalled(suite.T(), "GetDigestRatings", "UID", suite.now.Add(-suite.conf.DigestInterval))
+	suite.repo.AssertCalled(suite.T(), "SaveDigest", "UID", suite.now, mock.MatchedBy(func(outbox *model.OutboxEmail) bool {
+		return outbox != nil &&
+			outbox.RecipientID == "UID" &&
+			outbox.ToAddress == "demo@example.com" &&
+			outbox.Subject == "Your Wongnok digest" &&
+			outbox.NextAttemptAt.Equal(suite.now)
+	}))
+}
+
+func (suite *DispatcherTestSuite) TestAdvanceWithoutEmailWhenDigestEmpty() {
+	suite.respGetDigestRatings = []model.DigestRecipeRatings{}
+
+	err := suite.dispatcher.EnqueueDigests(suite.now)
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "SaveDigest", "UID", suite.now, (*model.OutboxEmail)(nil))
+}
+
+func (suite *DispatcherTestSuite) TestSkipTrendingWhenNobodyDue() {
+	suite.respGetDue = []model.DigestSubscription{}
+
+	err := suite.dispatcher.EnqueueDigests(suite.now)
+
+	suite.NoError(err)
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "GetTrending", mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestErrorWhenGetDueSubscriptions() {
+	suite.errGetDue = assert.AnError
+
+	err := suite.dispatcher.EnqueueDigests(suite.now)
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *DispatcherTestSuite) TestErrorWhenGetTrending() {
+	suite.errGetTrending = assert.AnError
+
+	err := suite.dispatcher.EnqueueDigests(suite.now)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.repo.AssertNotCalled(suite.T(), "SaveDigest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestNotAdvanceWhenBuildDigestFailed() {
+	suite.errGetDigestRatings = assert.AnError
+
+	err := suite.dispatcher.EnqueueDigests(suite.now)
+
+	// ผู้รับคนนี้จะถูกลองใหม่ในรอบถัดไป
+	suite.NoError(err)
+	suite.repo.AssertNotCalled(suite.T(), "SaveDigest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestDeliverAndMarkSent() {
+	err := suite.dispatcher.Deliver(suite.now)
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "ClaimDue", suite.now, suite.conf.BatchSize, suite.now.Add(suite.conf.RetryBackoff))
+	suite.mailer.AssertCalled(suite.T(), "Send", model.EmailMessage{To: "demo@example.com", Subject: "Subject"})
+	suite.repo.AssertCalled(suite.T(), "MarkSent", uint(1), suite.now)
+	suite.repo.AssertNotCalled(suite.T(), "MarkFailed", mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestRetryWhenSendFailed() {
+	suite.errSend = assert.AnError
+
+	err := suite.dispatcher.Deliver(suite.now)
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "MarkFailed", suite.respClaimDue[0].Retry(assert.AnError, suite.now, suite.conf.RetryBackoff, suite.conf.MaxAttempts))
+	suite.repo.AssertNotCalled(suite.T(), "MarkSent", mock.Anything, mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestErrorWhenClaimDue() {
+	suite.errClaimDue = assert.AnError
+
+	err := suite.dispatcher.Deliver(suite.now)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.mailer.AssertNotCalled(suite.T(), "Send", mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestStartAndClose() {
+	suite.dispatcher.Start()
+	suite.dispatcher.Close()
+
+	// PollInterval ยังไม่ถึง จึงยังไม่ทำงาน
+	suite.repo.AssertNotCalled(suite.T(), "ClaimDue", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DispatcherTestSuite) TestDeliverOnEachPoll() {
+	sent := make(chan model.EmailMessage, 10)
+	suite.mailer.ExpectedCalls = nil
+	suite.mailer.On("Send", mock.Anything).Return(func(message model.EmailMessage) error {
+		sent <- message
+		return nil
+	})
+	suite.dispatcher.Config.PollInterval = 10 * time.Millisecond
+
+	suite.dispatcher.Start()
+	defer suite.dispatcher.Close()
+
+	select {
+	case message := <-sent:
+		suite.Equal("demo@example.com", message.To)
+	case <-time.After(time.Second):
+		suite.Fail("email was not delivered")
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	suite.Run(t, new(DispatcherTestSuite))
+}
@@ -0,0 +1,106 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+type IMailer interface {
+	Send(message model.EmailMessage) error
+}
+
+// SMTPMailer ถ้าไม่ตั้ง Username จะส่งโดยไม่ AUTH เช่นเมื่อส่งเข้า MailHog
+type SMTPMailer struct {
+	Config config.Mail
+}
+
+func NewMailer(conf config.Mail) *SMTPMailer {
+	return &SMTPMailer{
+		Config: conf,
+	}
+}
+
+func (mailer SMTPMailer) Send(message model.EmailMessage) error {
+	from, err := mail.ParseAddress(mailer.Config.From)
+	if err != nil {
+		return errors.Wrap(err, "parse sender")
+	}
+
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return errors.Wrap(err, "parse recipient")
+	}
+
+	body, err := BuildMessage(from, to, message, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "build message")
+	}
+
+	var auth smtp.Auth
+	if mailer.Config.Username != "" {
+		auth = smtp.PlainAuth("", mailer.Config.Username, mailer.Config.Password, mailer.Config.Host)
+	}
+
+	if err := smtp.SendMail(mailer.Config.Addr(), auth, from.Address, []string{to.Address}, body); err != nil {
+		return errors.Wrap(err, "send mail")
+	}
+
+	return nil
+}
+
+// BuildMessage สร้างอีเมลแบบ multipart/alternative มีทั้ง text และ HTML
+// เนื้อหาเข้ารหัส quoted-printable และหัวเรื่องเข้ารหัสตาม RFC 2047 เพื่อให้ภาษาไทยไม่เพี้ยน
+func BuildMessage(from *mail.Address, to *mail.Address, message model.EmailMessage, date time.Time) ([]byte, error) {
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", message.Text},
+		{"text/html; charset=UTF-8", message.HTML},
+	} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err := encoder.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buffer, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&buffer, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buffer, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buffer, "Content-Type: multipart/alternative; boundary=%s\r\n", writer.Boundary())
+	fmt.Fprintf(&buffer, "\r\n")
+	buffer.Write(body.Bytes())
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,160 @@
+package email_test
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/email"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMailer(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		mailer := email.NewMailer(config.Mail{Host: "localhost"})
+
+		assert.Equal(t, "localhost", mailer.Config.Host)
+	})
+}
+
+var message = model.EmailMessage{
+	To:      "demo@example.com",
+	Subject: "สรุปความเคลื่อนไหวจาก Wongnok",
+	Text:    "สวัสดีคุณ Demo",
+	HTML:    "<p>สวัสดีคุณ Demo</p>",
+}
+
+// readMessage แยกส่วนของอีเมลกลับมาตรวจ
+func readMessage(t *testing.T, raw io.Reader) (*mail.Message, map[string]string) {
+	parsed, err := mail.ReadMessage(raw)
+	assert.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts := make(map[string]string)
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+
+		content, err := io.ReadAll(part)
+		assert.NoError(t, err)
+		parts[strings.Split(part.Header.Get("Content-Type"), ";")[0]] = string(content)
+	}
+
+	return parsed, parts
+}
+
+func TestBuildMessage(t *testing.T) {
+	t.Run("ShouldBuildMultipartAlternative", func(t *testing.T) {
+		from := &mail.Address{Name: "Wongnok", Address: "no-reply@wongnok.local"}
+		to := &mail.Address{Address: "demo@example.com"}
+		date := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+		raw, err := email.BuildMessage(from, to, message, date)
+		assert.NoError(t, err)
+
+		parsed, parts := readMessage(t, strings.NewReader(string(raw)))
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		assert.NoError(t, err)
+		assert.Equal(t, message.Subject, subject)
+		assert.Equal(t, `"Wongnok" <no-reply@wongnok.local>`, parsed.Header.Get("From"))
+		assert.Equal(t, "<demo@example.com>", parsed.Header.Get("To"))
+		assert.Equal(t, message.Text, parts["text/plain"])
+		assert.Equal(t, message.HTML, parts["text/html"])
+	})
+}
+
+// fakeSMTP รับอีเมลหนึ่งฉบับแบบเดียวกับ MailHog แล้วส่ง DATA ออกทาง channel
+func fakeSMTP(t *testing.T) (config.Mail, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		text.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+
+			switch command := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); command {
+			case "EHLO", "HELO", "MAIL", "RCPT", "RSET", "NOOP":
+				text.PrintfLine("250 OK")
+			case "DATA":
+				text.PrintfLine("354 Go ahead")
+				data, _ := io.ReadAll(text.DotReader())
+				received <- string(data)
+				text.PrintfLine("250 Queued")
+			case "QUIT":
+				text.PrintfLine("221 Bye")
+				return
+			default:
+				text.PrintfLine("502 Not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	return config.Mail{Host: host, Port: portNumber, From: "Wongnok <no-reply@wongnok.local>"}, received
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	t.Run("ShouldDeliverToSMTPServer", func(t *testing.T) {
+		conf, received := fakeSMTP(t)
+
+		err := email.NewMailer(conf).Send(message)
+		assert.NoError(t, err)
+
+		select {
+		case data := <-received:
+			_, parts := readMessage(t, bufio.NewReader(strings.NewReader(data)))
+			assert.Equal(t, message.Text, parts["text/plain"])
+		case <-time.After(time.Second):
+			t.Fatal("email was not delivered")
+		}
+	})
+
+	t.Run("ShouldErrorWhenRecipientInvalid", func(t *testing.T) {
+		err := email.NewMailer(config.Mail{Host: "localhost", Port: 1025, From: "no-reply@wongnok.local"}).Send(model.EmailMessage{To: "not an address"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("ShouldErrorWhenServerUnavailable", func(t *testing.T) {
+		conf, _ := fakeSMTP(t)
+		conf.Port = 1
+
+		err := email.NewMailer(conf).Send(message)
+
+		assert.Error(t, err)
+	})
+}
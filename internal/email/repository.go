@@ -0,0 +1,134 @@
+package email
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	// GetDueSubscriptions ผู้รับที่มีอีเมลและได้รับ digest ครั้งล่าสุดไม่หลัง before
+	GetDueSubscriptions(before time.Time, limit int) ([]model.DigestSubscription, error)
+	GetDigestRatings(userID string, since time.Time) ([]model.DigestRecipeRatings, error)
+	GetDigestRecipes(userID string, since time.Time, limit int) (model.FoodRecipes, error)
+	// SaveDigest outbox เป็น nil เมื่อไม่มีอะไรให้ส่ง แต่ยังเลื่อนรอบของผู้รับ
+	SaveDigest(userID string, sentAt time.Time, outbox *model.OutboxEmail) error
+	// ClaimDue จองอีเมลที่ถึงกำหนดไว้จนถึง leaseUntil instance อื่นจึงไม่หยิบไปส่งซ้ำ
+	ClaimDue(now time.Time, limit int, leaseUntil time.Time) ([]model.OutboxEmail, error)
+	MarkSent(id uint, sentAt time.Time) error
+	MarkFailed(outbox model.OutboxEmail) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) GetDueSubscriptions(before time.Time, limit int) ([]model.DigestSubscription, error) {
+	var subscriptions = make([]model.DigestSubscription, 0)
+
+	err := repo.DB.Preload("User").
+		Where("user_id IN (SELECT id FROM users WHERE email <> '' AND deleted_at IS NULL)").
+		Where("(last_sent_at IS NULL OR last_sent_at <= ?)", before).
+		Order("last_sent_at NULLS FIRST, user_id").
+		Limit(limit).
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// GetDigestRatings rating ใหม่จากผู้อื่นในสูตรของผู้รับ รวมต่อสูตร สูตรที่ได้มากสุดก่อน
+func (repo Repository) GetDigestRatings(userID string, since time.Time) ([]model.DigestRecipeRatings, error) {
+	var ratings = make([]model.DigestRecipeRatings, 0)
+
+	err := repo.DB.Table("ratings").
+		Select("food_recipes.id AS food_recipe_id, food_recipes.name, COUNT(*) AS count, SUM(ratings.score) AS score_sum").
+		Joins("JOIN food_recipes ON food_recipes.id = ratings.food_recipe_id").
+		Where("food_recipes.user_id = ? AND ratings.user_id <> ?", userID, userID).
+		Where("ratings.created_at > ?", since).
+		Where("ratings.deleted_at IS NULL AND food_recipes.deleted_at IS NULL").
+		Group("food_recipes.id, food_recipes.name").
+		Order("count DESC, food_recipes.id").
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ratings, nil
+}
+
+// GetDigestRecipes สูตรใหม่จากคนที่ผู้รับติดตาม ล่าสุดก่อน
+func (repo Repository) GetDigestRecipes(userID string, since time.Time, limit int) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	err := repo.DB.Preload("User").
+		Where("user_id IN (SELECT followee_id FROM follows WHERE follower_id = ?)", userID).
+		Where("created_at > ?", since).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&recipes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
+func (repo Repository) SaveDigest(userID string, sentAt time.Time, outbox *model.OutboxEmail) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if outbox != nil {
+			if err := tx.Create(outbox).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.DigestSubscription{}).Where("user_id = ?", userID).Update("last_sent_at", sentAt).Error
+	})
+}
+
+func (repo Repository) ClaimDue(now time.Time, limit int, leaseUntil time.Time) ([]model.OutboxEmail, error) {
+	var outboxes = make([]model.OutboxEmail, 0)
+
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL AND failed_at IS NULL AND next_attempt_at <= ?", now).
+			Order("next_attempt_at, id").
+			Limit(limit).
+			Find(&outboxes).Error
+		if err != nil || len(outboxes) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(outboxes))
+		for _, outbox := range outboxes {
+			ids = append(ids, outbox.ID)
+		}
+
+		return tx.Model(&model.OutboxEmail{}).Where("id IN ?", ids).Update("next_attempt_at", leaseUntil).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outboxes, nil
+}
+
+func (repo Repository) MarkSent(id uint, sentAt time.Time) error {
+	return repo.DB.Model(&model.OutboxEmail{}).Where("id = ?", id).Update("sent_at", sentAt).Error
+}
+
+func (repo Repository) MarkFailed(outbox model.OutboxEmail) error {
+	return repo.DB.Model(&model.OutboxEmail{ID: outbox.ID}).
+		Select("attempts", "next_attempt_at", "last_error", "failed_at").
+		Updates(&outbox).Error
+}
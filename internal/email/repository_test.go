@@ -0,0 +1,181 @@
+package email_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/email"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := email.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository email.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &email.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM outbox_emails")
+	suite.db.Exec("DELETE FROM digest_subscriptions")
+	suite.db.Exec("DELETE FROM follows")
+	suite.db.Exec("UPDATE users SET email = ''")
+
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+type RepositoryDigestTestSuite struct {
+	RepositoryTestSuite
+}
+
+// cook เป็นเจ้าของ recipe 1 ซึ่ง reader ให้ rating ไว้ (rating id 2)
+const (
+	cook   = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	reader = "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+)
+
+func (suite *RepositoryDigestTestSuite) TestGetDueSubscriptions() {
+	now := time.Now()
+	lastSentAt := now.Add(-time.Hour)
+
+	suite.NoError(suite.db.Exec("UPDATE users SET email = 'cook@example.com' WHERE id = ?", cook).Error)
+	suite.NoError(suite.db.Exec("INSERT INTO digest_subscriptions (user_id, locale, created_at) VALUES (?, 'th', NOW())", cook).Error)
+	// ไม่มีอีเมลจึงส่งไม่ได้
+	suite.NoError(suite.db.Exec("INSERT INTO digest_subscriptions (user_id, locale, created_at) VALUES (?, 'en', NOW())", reader).Error)
+
+	subscriptions, err := suite.repository.GetDueSubscriptions(now.Add(-24*time.Hour), 10)
+	suite.NoError(err)
+	suite.Len(subscriptions, 1)
+	suite.Equal(cook, subscriptions[0].UserID)
+	suite.Equal("cook@example.com", subscriptions[0].User.Email)
+
+	suite.NoError(suite.repository.SaveDigest(cook, lastSentAt, nil))
+
+	subscriptions, err = suite.repository.GetDueSubscriptions(now.Add(-24*time.Hour), 10)
+	suite.NoError(err)
+	suite.Empty(subscriptions)
+}
+
+func (suite *RepositoryDigestTestSuite) TestGetDigestContent() {
+	since := time.Now().Add(-time.Hour)
+	suite.NoError(suite.db.Exec("UPDATE ratings SET created_at = NOW()").Error)
+	suite.NoError(suite.db.Exec("UPDATE food_recipes SET created_at = NOW()").Error)
+	suite.NoError(suite.db.Exec("INSERT INTO follows (follower_id, followee_id, created_at) VALUES (?, ?, NOW())", reader, cook).Error)
+
+	// rating ของ cook เองในสูตรตัวเองไม่นับ
+	ratings, err := suite.repository.GetDigestRatings(cook, since)
+	suite.NoError(err)
+	suite.Equal([]model.DigestRecipeRatings{{FoodRecipeID: 1, Name: "Omlet", Count: 1, ScoreSum: 3}}, ratings)
+
+	recipes, err := suite.repository.GetDigestRecipes(reader, since, 10)
+	suite.NoError(err)
+	suite.Len(recipes, 1)
+	suite.Equal(cook, recipes[0].User.ID)
+
+	none, err := suite.repository.GetDigestRecipes(cook, since, 10)
+	suite.NoError(err)
+	suite.Empty(none)
+}
+
+func (suite *RepositoryDigestTestSuite) TestOutboxLifecycle() {
+	now := time.Now().Truncate(time.Second)
+	suite.NoError(suite.db.Exec("INSERT INTO digest_subscriptions (user_id, locale, created_at) VALUES (?, 'th', NOW())", cook).Error)
+
+	outbox := model.OutboxEmail{}.FromMessage(cook, model.EmailMessage{To: "cook@example.com", Subject: "Subject", Text: "Text", HTML: "HTML"}, now)
+	suite.NoError(suite.repository.SaveDigest(cook, now, &outbox))
+
+	claimed, err := suite.repository.ClaimDue(now, 10, now.Add(time.Minute))
+	suite.NoError(err)
+	suite.Len(claimed, 1)
+
+	// จองไว้แล้ว รอบถัดไปก่อนหมดเวลาจองไม่หยิบซ้ำ
+	again, err := suite.repository.ClaimDue(now, 10, now.Add(time.Minute))
+	suite.NoError(err)
+	suite.Empty(again)
+
+	failed := claimed[0].Retry(assert.AnError, now, time.Minute, 5)
+	suite.NoError(suite.repository.MarkFailed(failed))
+
+	retried, err := suite.repository.ClaimDue(now.Add(time.Minute), 10, now.Add(2*time.Minute))
+	suite.NoError(err)
+	suite.Len(retried, 1)
+	suite.Equal(1, retried[0].Attempts)
+	suite.Equal(assert.AnError.Error(), retried[0].LastError)
+
+	suite.NoError(suite.repository.MarkSent(retried[0].ID, now))
+
+	sent, err := suite.repository.ClaimDue(now.Add(time.Hour), 10, now.Add(2*time.Hour))
+	suite.NoError(err)
+	suite.Empty(sent)
+}
+
+func TestRepositoryDigest(t *testing.T) {
+	suite.Run(t, new(RepositoryDigestTestSuite))
+}
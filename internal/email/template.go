@@ -0,0 +1,66 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"wongnok/internal/model"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// template ของแต่ละภาษา ไฟล์ .txt มี block subject เป็นหัวเรื่อง
+type digestTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var digestTemplates = map[string]digestTemplate{
+	model.LocaleThai:    parseDigestTemplate(model.LocaleThai),
+	model.LocaleEnglish: parseDigestTemplate(model.LocaleEnglish),
+}
+
+func parseDigestTemplate(locale string) digestTemplate {
+	name := "digest." + locale
+
+	return digestTemplate{
+		text: texttemplate.Must(texttemplate.ParseFS(templates, "templates/"+name+".txt")),
+		html: htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/"+name+".html")),
+	}
+}
+
+type digestData struct {
+	model.Digest
+	AppURL string
+}
+
+// RenderDigest สร้างอีเมลตามภาษาของผู้รับ ภาษาที่ไม่รู้จักใช้ภาษาไทย
+func RenderDigest(digest model.Digest, appURL string) (model.EmailMessage, error) {
+	tmpl, ok := digestTemplates[digest.Locale]
+	if !ok {
+		tmpl = digestTemplates[model.LocaleThai]
+	}
+
+	data := digestData{Digest: digest, AppURL: strings.TrimRight(appURL, "/")}
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return model.EmailMessage{}, err
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return model.EmailMessage{}, err
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return model.EmailMessage{}, err
+	}
+
+	return model.EmailMessage{
+		To:      digest.User.Email,
+		Subject: subject.String(),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
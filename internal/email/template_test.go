@@ -0,0 +1,58 @@
+package email_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/email"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRenderDigest(t *testing.T) {
+	digest := model.Digest{
+		User:    model.User{FirstName: "Demo", Email: "demo@example.com"},
+		Locale:  model.LocaleThai,
+		Since:   time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC),
+		Ratings: []model.DigestRecipeRatings{{FoodRecipeID: 1, Name: "ต้มยำ <กุ้ง>", Count: 2, ScoreSum: 9}},
+		Recipes: model.FoodRecipes{{Model: gorm.Model{ID: 2}, Name: "แกงเขียวหวาน", User: model.User{FirstName: "Cook"}}},
+	}
+
+	t.Run("ShouldRenderThai", func(t *testing.T) {
+		message, err := email.RenderDigest(digest, "http://localhost:3000/")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "demo@example.com", message.To)
+		assert.Equal(t, "สรุปความเคลื่อนไหวจาก Wongnok", message.Subject)
+		assert.Contains(t, message.Text, "สวัสดีคุณ Demo")
+		assert.Contains(t, message.Text, "- ต้มยำ <กุ้ง>: 2 คะแนน เฉลี่ย 4.5 ดาว")
+		assert.Contains(t, message.Text, "http://localhost:3000/food-recipes/2")
+		assert.NotContains(t, message.Text, "เมนูยอดนิยม")
+		// ชื่อสูตรมาจากผู้ใช้ ต้อง escape ใน HTML
+		assert.Contains(t, message.HTML, "ต้มยำ &lt;กุ้ง&gt;")
+		assert.Contains(t, message.HTML, `<a href="http://localhost:3000/food-recipes/2">แกงเขียวหวาน</a> โดย Cook`)
+	})
+
+	t.Run("ShouldRenderEnglish", func(t *testing.T) {
+		english := digest
+		english.Locale = model.LocaleEnglish
+
+		message, err := email.RenderDigest(english, "http://localhost:3000")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Your Wongnok digest", message.Subject)
+		assert.Contains(t, message.Text, "2 new, average 4.5 stars")
+		assert.Contains(t, message.HTML, `<html lang="en">`)
+	})
+
+	t.Run("ShouldFallbackToThai", func(t *testing.T) {
+		unknown := digest
+		unknown.Locale = "fr"
+
+		message, err := email.RenderDigest(unknown, "http://localhost:3000")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "สรุปความเคลื่อนไหวจาก Wongnok", message.Subject)
+	})
+}
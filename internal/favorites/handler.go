@@ -1,63 +1,3 @@
-/*
-package favorite
-
-import (
-    "net/http"
-
-    "github.com/gin-gonic/gin"
-    "gorm.io/gorm"
-)
-
-type Handler struct {
-    db *gorm.DB
-}
-
-func NewHandler(db *gorm.DB) *Handler {
-    return &Handler{db: db}
-}
-
-func (h *Handler) Add(c *gin.Context) {
-    userID := c.Param("id")
-    var req struct {
-        RecipeID string `json:"recipeId"`
-    }
-    if err := c.ShouldBindJSON(&req); err != nil {
-        c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
-        return
-    }
-
-    fav := Favorite{UserID: userID, RecipeID: req.RecipeID}
-    if err := h.db.Create(&fav).Error; err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add favorite"})
-        return
-    }
-
-    c.JSON(http.StatusCreated, gin.H{"message": "Added to favorites"})
-}
-
-func (h *Handler) Remove(c *gin.Context) {
-    userID := c.Param("id")
-    recipeID := c.Param("recipeId")
-
-    if err := h.db.Where("user_id = ? AND recipe_id = ?", userID, recipeID).Delete(&Favorite{}).Error; err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove favorite"})
-        return
-    }
-
-    c.JSON(http.StatusOK, gin.H{"message": "Removed from favorites"})
-}
-
-func (h *Handler) Get(c *gin.Context) {
-    userID := c.Param("id")
-    var favorites []Favorite
-    if err := h.db.Where("user_id = ?", userID).Find(&favorites).Error; err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch favorites"})
-        return
-    }
-
-    c.JSON(http.StatusOK, favorites)
-}
-*/
 package favorite
 
 import (
@@ -69,16 +9,13 @@ import (
 	"wongnok/internal/model"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 	"gorm.io/gorm"
 )
 
 type IHandler interface {
-	Get(ctx *gin.Context)
-	GetByUser(ctx *gin.Context)
-	Create(ctx *gin.Context)
-	Delete(ctx *gin.Context)
-	Update(ctx *gin.Context)
+	GetMine(ctx *gin.Context)
+	PutMine(ctx *gin.Context)
+	DeleteMine(ctx *gin.Context)
 }
 
 type Handler struct {
@@ -91,29 +28,21 @@ func NewHandler(db *gorm.DB) *Handler {
 	}
 }
 
-func (handler Handler) Get(ctx *gin.Context) {
-	var id string
-
-	pathParam := ctx.Param("id")
-	if pathParam != "" {
-		id = pathParam
-	}
-	favorite, err := handler.Service.Get(id)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "favorite not found"})
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
-		return
-	}
-
-	ctx.JSON(http.StatusOK, favorite.ToResponse())
-}
-
-
-func (handler Handler) GetByUser(ctx *gin.Context) {
-
+// GetMine godoc
+// @Summary Get my favorite recipes
+// @Description Get the caller's favorite food recipes with pagination
+// @Tags favorites
+// @Produce json
+// @Param page query int true "Page number"
+// @Param limit query int true "Items per page"
+// @Param search query string false "Search term"
+// @Success 200 {object} dto.FoodRecipesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/favorites [get]
+func (handler Handler) GetMine(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
@@ -125,76 +54,84 @@ func (handler Handler) GetByUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
 		return
 	}
-	foodRecipes, total, err := handler.Service.GetByUser(foodRecipeQuery, claims)
+
+	recipes, total, err := handler.Service.GetByUser(foodRecipeQuery, claims)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "favorite not found"})
-			return
-		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, foodRecipes.ToResponse(total))
+	ctx.JSON(http.StatusOK, recipes.ToResponse(total))
 }
 
-
-func (handler Handler) Create(ctx *gin.Context) {
-	var id int
-	pathParam := ctx.Param("id")
-	if pathParam != "" {
-		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
-			id = parsed
-		}
-	}
-
+// PutMine godoc
+// @Summary Favorite a recipe
+// @Description Add a food recipe to the caller's favorites. Repeating the request changes nothing
+// @Tags favorites
+// @Param recipeId path int true "Food Recipe ID"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/favorites/{recipeId} [put]
+func (handler Handler) PutMine(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
 		return
 	}
-	favorite, err := handler.Service.Create(id, claims)
 
-	if err != nil {
+	if err := handler.Service.Create(pathParamID(ctx, "recipeId"), claims); err != nil {
 		statusCode := http.StatusInternalServerError
-		if errors.As(err, &validator.ValidationErrors{}) {
-			statusCode = http.StatusBadRequest
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, global.ErrBlocked) {
+			statusCode = http.StatusForbidden
 		}
 
 		ctx.JSON(statusCode, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, favorite.ToResponse())
+	ctx.Status(http.StatusNoContent)
 }
 
-
-func (handler Handler) Delete(ctx *gin.Context) {
+// DeleteMine godoc
+// @Summary Unfavorite a recipe
+// @Description Remove a food recipe from the caller's favorites. Succeeds even when it is not a favorite
+// @Tags favorites
+// @Param recipeId path int true "Food Recipe ID"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/favorites/{recipeId} [delete]
+func (handler Handler) DeleteMine(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
 		return
 	}
 
+	if err := handler.Service.Delete(pathParamID(ctx, "recipeId"), claims); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func pathParamID(ctx *gin.Context, name string) int {
 	var id int
 
-	pathParam := ctx.Param("id")
+	pathParam := ctx.Param(name)
 	if pathParam != "" {
 		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
 			id = parsed
 		}
 	}
 
-	if err := handler.Service.Delete(id, claims); err != nil {
-		statusCode := http.StatusInternalServerError
-
-		if errors.Is(err, global.ErrForbidden) {
-			statusCode = http.StatusForbidden
-		}
-
-		ctx.JSON(statusCode, gin.H{"message": err.Error()})
-		return
-	}
-
-	ctx.JSON(http.StatusOK, gin.H{"message": "Recipe deleted successfully"})
+	return id
 }
@@ -0,0 +1,159 @@
+package favorite_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	favorite "wongnok/internal/favorites"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := favorite.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerFavoriteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler favorite.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceGetByUser model.FoodRecipes
+	errServiceGetByUser  error
+	errServiceCreate     error
+	errServiceDelete     error
+
+	// Helper
+	server func(method string, url string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerFavoriteTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerFavoriteTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = favorite.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+		router.GET("/api/v1/users/self/favorites", suite.handler.GetMine)
+		router.PUT("/api/v1/users/self/favorites/:recipeId", suite.handler.PutMine)
+		router.DELETE("/api/v1/users/self/favorites/:recipeId", suite.handler.DeleteMine)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceGetByUser = model.FoodRecipes{{Model: gorm.Model{ID: 1}, Name: "Omelet"}}.
+		WithFavoriteStates([]model.FavoriteState{{FoodRecipeID: 1, FavoriteCount: 2, IsFavorited: true}})
+	suite.errServiceGetByUser = nil
+	suite.errServiceCreate = nil
+	suite.errServiceDelete = nil
+
+	suite.service.On("GetByUser", mock.Anything, mock.Anything).Return(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, error) {
+		return suite.respServiceGetByUser, int64(len(suite.respServiceGetByUser)), suite.errServiceGetByUser
+	})
+	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceCreate
+	})
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerFavoriteTestSuite) TestGetMineWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/favorites?page=1&limit=10", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Contains(response.Body.String(), `"isFavorited":true,"favoriteCount":2`)
+	suite.service.AssertCalled(suite.T(), "GetByUser", model.FoodRecipeQuery{Page: 1, Limit: 10}, claims)
+}
+
+func (suite *HandlerFavoriteTestSuite) TestGetMineStatusCode400WhenQueryInvalid() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/favorites", &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetByUser", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerFavoriteTestSuite) TestPutMineWithStatusCode204() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/favorites/1", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.Empty(response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", 1, claims)
+}
+
+func (suite *HandlerFavoriteTestSuite) TestPutMineStatusCode404WhenRecipeNotFound() {
+	suite.errServiceCreate = errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/favorites/99", &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerFavoriteTestSuite) TestDeleteMineWithStatusCode204() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/favorites/1", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "Delete", 1, claims)
+}
+
+func (suite *HandlerFavoriteTestSuite) TestDeleteMineStatusCode500WhenServiceError() {
+	suite.errServiceDelete = assert.AnError
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/favorites/1", &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerFavoriteTestSuite) TestStatusCode401WhenNoClaims() {
+	suite.Equal(http.StatusUnauthorized, suite.server(http.MethodGet, "/api/v1/users/self/favorites?page=1&limit=10", nil).Code)
+	suite.Equal(http.StatusUnauthorized, suite.server(http.MethodPut, "/api/v1/users/self/favorites/1", nil).Code)
+	suite.Equal(http.StatusUnauthorized, suite.server(http.MethodDelete, "/api/v1/users/self/favorites/1", nil).Code)
+	suite.service.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func TestHandlerFavorite(t *testing.T) {
+	suite.Run(t, new(HandlerFavoriteTestSuite))
+}
@@ -2,21 +2,17 @@ package favorite
 
 import (
 	"wongnok/internal/model"
+	"wongnok/internal/notification"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type IRepository interface {
-	Get(userID string) (model.Favorites, error)
 	GetByUser(foodRecipeQuery model.FoodRecipeQuery, userID string) (model.FoodRecipes, error)
-	Create(favorite *model.Favorite) error
-	Delete(id int) error
-	GetByID(id int, claimsID string) (model.Favorite, error)
-	GetDeleteByID(id int, claimsID string) (model.Favorite, error)
-	Update(id int) error
-
-	Count(UserID string, search string) (int64, error)
+	Count(userID string, search string) (int64, error)
+	Create(favorite *model.Favorite, notice *model.Notification) error
+	Delete(recipeID int, userID string) error
 }
 
 type Repository struct {
@@ -29,97 +25,65 @@ func NewRepository(db *gorm.DB) IRepository {
 	}
 }
 
-func (repo Repository) Get(userID string) (model.Favorites, error) {
-	var favorites model.Favorites
-
-	if err := repo.DB.Where("user_id = ?", userID).Find(&favorites).Error; err != nil {
-		return nil, err
-	}
-
-	return favorites, nil
-}
 func (repo Repository) GetByUser(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, error) {
 	var recipes = make(model.FoodRecipes, 0)
-	offset := (query.Page - 1) * query.Limit
-	db := repo.DB.
-		Model(&model.FoodRecipe{}).
-		Joins("JOIN favorites fav ON food_recipes.id = fav.food_recipe_id").
-		Where("fav.user_id = ?", userID).
-		Preload(clause.Associations).
-		Find(&recipes)
 
-	if query.Search != "" {
-		db = db.Where("name LIKE ?", "%"+query.Search+"%").Or("description LIKE ?", "%"+query.Search+"%")
-	}
+	offset := (query.Page - 1) * query.Limit
 
-	if err := db.Order("name asc").Limit(query.Limit).Offset(offset).Find(&recipes).Error; err != nil {
+	err := repo.byUser(userID, query.Search).
+		Preload(clause.Associations).
+		Order("food_recipes.name asc").
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&recipes).Error
+	if err != nil {
 		return nil, err
 	}
-	return recipes, nil
-
-}
-
-func (repo Repository) Create(favorite *model.Favorite) error {
-	if err := repo.DB.Create(favorite).Error; err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (repo Repository) GetByID(id int, claimsID string) (model.Favorite, error) {
-
-	var favorite model.Favorite
-	if err := repo.DB.Where("food_recipe_id = ? AND user_id = ?", id, claimsID).First(&favorite).Error; err != nil {
-		return model.Favorite{}, err
-	}
-	return favorite, nil
-}
-
-func (repo Repository) GetDeleteByID(id int, claimsID string) (model.Favorite, error) {
-
-	var favorite model.Favorite
-	if err := repo.DB.Unscoped().Where("food_recipe_id = ? AND user_id = ?", id, claimsID).First(&favorite).Error; err != nil {
-		return model.Favorite{}, err
-	}
-	return favorite, nil
-
-}
 
-func (repo Repository) Delete(id int) error {
-	return repo.DB.Delete(&model.Favorite{}, id).Error
+	return recipes, nil
 }
 
-func (repo Repository) Update(id int) error {
-
-	var favorite model.Favorite
-	// ดึงข้อมูลเดิมมาก่อน (optional)
-	if err := repo.DB.Unscoped().First(&favorite, id).Error; err != nil {
-		return err
-	}
+func (repo Repository) Count(userID string, search string) (int64, error) {
+	var count int64
 
-	// อัปเดต deleted_at ให้เป็น nil
-	if err := repo.DB.Unscoped().Model(&favorite).Where("id = ?", id).Update("deleted_at", nil).Error; err != nil {
-		return err
+	if err := repo.byUser(userID, search).Count(&count).Error; err != nil {
+		return 0, err
 	}
 
-	return repo.DB.First(&favorite, favorite.ID).Error
+	return count, nil
 }
 
-func (repo Repository) Count(UserID string, search string) (int64, error) {
-	var count int64
-
+// byUser recipe ที่ผู้ใช้ favorite ไว้ ไม่นับ favorite ที่ถูกลบแล้ว
+func (repo Repository) byUser(userID string, search string) *gorm.DB {
 	db := repo.DB.Model(&model.FoodRecipe{}).
-		Joins("JOIN favorites fav ON food_recipes.id = fav.food_recipe_id").
-		Where("fav.user_id = ?", UserID)
+		Joins("JOIN favorites fav ON food_recipes.id = fav.food_recipe_id AND fav.deleted_at IS NULL").
+		Where("fav.user_id = ?", userID)
 
 	if search != "" {
 		db = db.Where("food_recipes.name LIKE ? OR food_recipes.description LIKE ?", "%"+search+"%", "%"+search+"%")
 	}
 
-	if err := db.Count(&count).Error; err != nil {
-		return 0, err
-	}
+	return db
+}
 
-	return count, nil
-}
\ No newline at end of file
+// Create favorite ซ้ำไม่ error ไม่สร้างแถวใหม่ และไม่แจ้งเตือนซ้ำ
+// ใช้ unique index ที่ไม่รวมแถวที่ถูกลบ จึงต้องระบุเงื่อนไข deleted_at ให้ตรงกับ index
+func (repo Repository) Create(favorite *model.Favorite, notice *model.Notification) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "user_id"}, {Name: "food_recipe_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+			DoNothing:   true,
+		}).Create(favorite)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return notification.Notify(tx, notice)
+	})
+}
+
+// Delete ไม่มี favorite อยู่ก็ถือว่าสำเร็จ
+func (repo Repository) Delete(recipeID int, userID string) error {
+	return repo.DB.Where("food_recipe_id = ? AND user_id = ?", recipeID, userID).Delete(&model.Favorite{}).Error
+}
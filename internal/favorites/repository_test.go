@@ -0,0 +1,142 @@
+package favorite_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	favorite "wongnok/internal/favorites"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := favorite.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository favorite.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &favorite.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+type RepositoryFavoriteTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryFavoriteTestSuite) count(userID string) int64 {
+	var count int64
+	suite.NoError(suite.db.Model(&model.Favorite{}).Where("user_id = ? AND food_recipe_id = 1", userID).Count(&count).Error)
+
+	return count
+}
+
+func (suite *RepositoryFavoriteTestSuite) TestCreateAndDeleteAreIdempotent() {
+	userID := "favorite-user"
+
+	suite.NoError(suite.repository.Create(&model.Favorite{FoodRecipeID: 1, UserID: userID}, nil))
+	suite.NoError(suite.repository.Create(&model.Favorite{FoodRecipeID: 1, UserID: userID}, nil))
+	suite.Equal(int64(1), suite.count(userID))
+
+	suite.NoError(suite.repository.Delete(1, userID))
+	suite.NoError(suite.repository.Delete(1, userID))
+	suite.Equal(int64(0), suite.count(userID))
+
+	// favorite ใหม่หลังลบได้แถวใหม่ ไม่ต้องคืนชีพแถวเดิม
+	suite.NoError(suite.repository.Create(&model.Favorite{FoodRecipeID: 1, UserID: userID}, nil))
+	suite.Equal(int64(1), suite.count(userID))
+}
+
+func (suite *RepositoryFavoriteTestSuite) TestGetByUserSkipDeleted() {
+	userID := "list-user"
+
+	// init-db มี recipe 1 อันเดียว
+	suite.NoError(suite.repository.Create(&model.Favorite{FoodRecipeID: 1, UserID: userID}, nil))
+	suite.NoError(suite.repository.Delete(1, userID))
+
+	recipes, err := suite.repository.GetByUser(model.FoodRecipeQuery{Page: 1, Limit: 10}, userID)
+	suite.NoError(err)
+	suite.Empty(recipes)
+
+	suite.NoError(suite.repository.Create(&model.Favorite{FoodRecipeID: 1, UserID: userID}, nil))
+
+	recipes, err = suite.repository.GetByUser(model.FoodRecipeQuery{Page: 1, Limit: 10}, userID)
+	suite.NoError(err)
+
+	total, err := suite.repository.Count(userID, "")
+	suite.NoError(err)
+
+	suite.Len(recipes, 1)
+	suite.Equal(uint(1), recipes[0].ID)
+	suite.Equal(int64(1), total)
+}
+
+func TestRepositoryFavorite(t *testing.T) {
+	suite.Run(t, new(RepositoryFavoriteTestSuite))
+}
@@ -1,7 +1,7 @@
 package favorite
 
 import (
-	"wongnok/internal/global"
+	"wongnok/internal/foodrecipe"
 	"wongnok/internal/model"
 	"wongnok/internal/users"
 
@@ -9,89 +9,83 @@ import (
 	"gorm.io/gorm"
 )
 
+type IFoodRecipeService foodrecipe.IService
+
 type IUserService user.IService
 
 type IService interface {
-	Get(userID string) (model.Favorites, error)
 	GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error)
-	Create(id int, claims model.Claims) (model.Favorite, error)
-	Delete(id int, claims model.Claims) error
+	// Create และ Delete เรียกซ้ำได้ผลเหมือนเดิม
+	Create(recipeID int, claims model.Claims) error
+	Delete(recipeID int, claims model.Claims) error
 }
 
 type Service struct {
-	Repository  IRepository
-	UserService IUserService
+	Repository        IRepository
+	FoodRecipeService IFoodRecipeService
+	UserService       IUserService
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
-		Repository:  NewRepository(db),
-		UserService: user.NewService(db),
+		Repository:        NewRepository(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+		UserService:       user.NewService(db),
 	}
 }
 
 func (service Service) GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error) {
 	total, err := service.Repository.Count(claims.ID, foodRecipeQuery.Search)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, errors.Wrap(err, "count favorites")
 	}
+
 	recipes, err := service.Repository.GetByUser(foodRecipeQuery, claims.ID)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, errors.Wrap(err, "find favorites")
 	}
-	recipes = recipes.CalculateAverageRatings()
-	return recipes, total, nil
-}
 
-func (service Service) Get(userID string) (model.Favorites, error) {
-	favorites, err := service.Repository.Get(userID)
+	recipes, err = service.FoodRecipeService.WithFavorites(recipes.CalculateAverageRatings(), claims)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return favorites, nil
+	return recipes, total, nil
 }
 
-func (service Service) Create(id int, claims model.Claims) (model.Favorite, error) {
-
-	userID, err := service.UserService.GetByID(claims)
+func (service Service) Create(recipeID int, claims model.Claims) error {
+	// ตรวจก่อนเพื่อตอบ 404 แทน foreign key error
+	recipe, err := service.FoodRecipeService.GetByID(recipeID)
 	if err != nil {
-		return model.Favorite{}, errors.Wrap(err, "create Favorite")
+		return errors.Wrap(err, "find recipe")
 	}
 
-	favoriteFromGet, err := service.Repository.GetDeleteByID(id, claims.ID)
-	if err != nil {
-		var favorite model.Favorite
-		favorite.FoodRecipeID = uint(id)
-		favorite.UserID = userID.ID
-
-		if err := service.Repository.Create(&favorite); err != nil {
-			return model.Favorite{}, errors.Wrap(err, "create favorite")
-		}
-
-		return favorite, nil
+	if err := service.UserService.CheckBlocked(claims.ID, recipe.UserID); err != nil {
+		return err
 	}
 
-	if err := service.Repository.Update(int(favoriteFromGet.ID)); err != nil {
-		return model.Favorite{}, errors.Wrap(err, "update Favorite")
+	favorite := model.Favorite{
+		FoodRecipeID: uint(recipeID),
+		UserID:       claims.ID,
+	}
+	notice := model.Notification{
+		RecipientID:  recipe.UserID,
+		ActorID:      claims.ID,
+		Type:         model.NotificationFavorite,
+		FoodRecipeID: &favorite.FoodRecipeID,
 	}
 
-	return favoriteFromGet, err
+	if err := service.Repository.Create(&favorite, &notice); err != nil {
+		return errors.Wrap(err, "create favorite")
+	}
 
+	return nil
 }
 
-func (service Service) Delete(id int, claims model.Claims) error {
-	favorite, err := service.Repository.GetByID(id, claims.ID)
-	if err != nil {
-		// กรณีไม่พบ id ที่ต้องการ update
-		return errors.Wrap(err, "find recipe")
-
+func (service Service) Delete(recipeID int, claims model.Claims) error {
+	if err := service.Repository.Delete(recipeID, claims.ID); err != nil {
+		return errors.Wrap(err, "delete favorite")
 	}
 
-	if favorite.UserID != claims.ID {
-		// กรณี user ที่ login ไม่ตรงกับ user ที่สร้าง recipe
-		return global.ErrForbidden
-	}
-
-	return service.Repository.Delete(int(favorite.ID))
-}
\ No newline at end of file
+	return nil
+}
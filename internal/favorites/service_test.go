@@ -0,0 +1,172 @@
+package favorite_test
+
+import (
+	"reflect"
+	"testing"
+	favorite "wongnok/internal/favorites"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := favorite.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServiceFavoriteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           favorite.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+	userService       *MockIUserService
+
+	// Mock data
+	claims               model.Claims
+	errBlocked           error
+	respGetByUser        model.FoodRecipes
+	errGetRecipeByID     error
+	errRepositoryCreate  error
+	errRepositoryDelete  error
+	errRepositoryCount   error
+	errRepositoryGetUser error
+}
+
+func (suite *ServiceFavoriteTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.userService = new(MockIUserService)
+	suite.service = &favorite.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+		UserService:       suite.userService,
+	}
+
+	suite.claims = model.Claims{ID: "UID"}
+	suite.respGetByUser = model.FoodRecipes{
+		{Model: gorm.Model{ID: 1}, RatingSummary: model.RatingSummary{RatingCount: 2, RatingSum: 8}},
+	}
+	suite.errGetRecipeByID = nil
+	suite.errRepositoryCreate = nil
+	suite.errRepositoryDelete = nil
+	suite.errRepositoryCount = nil
+	suite.errRepositoryGetUser = nil
+	suite.errBlocked = nil
+
+	suite.userService.On("CheckBlocked", mock.Anything, mock.Anything).Return(func(string, string) error {
+		return suite.errBlocked
+	})
+	suite.foodRecipeService.On("GetByID", mock.Anything).Return(func(int) (model.FoodRecipe, error) {
+		return model.FoodRecipe{UserID: "AUTHOR"}, suite.errGetRecipeByID
+	})
+	suite.foodRecipeService.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes.WithFavoriteStates([]model.FavoriteState{{FoodRecipeID: 1, FavoriteCount: 1, IsFavorited: true}}), nil
+	})
+	suite.repo.On("Create", mock.Anything, mock.Anything).Return(func(*model.Favorite, *model.Notification) error {
+		return suite.errRepositoryCreate
+	})
+	suite.repo.On("Delete", mock.Anything, mock.Anything).Return(func(int, string) error {
+		return suite.errRepositoryDelete
+	})
+	suite.repo.On("Count", mock.Anything, mock.Anything).Return(func(string, string) (int64, error) {
+		return int64(len(suite.respGetByUser)), suite.errRepositoryCount
+	})
+	suite.repo.On("GetByUser", mock.Anything, mock.Anything).Return(func(model.FoodRecipeQuery, string) (model.FoodRecipes, error) {
+		return suite.respGetByUser, suite.errRepositoryGetUser
+	})
+}
+
+func (suite *ServiceFavoriteTestSuite) TestCreateFavoriteOfCaller() {
+	err := suite.service.Create(1, suite.claims)
+
+	suite.NoError(err)
+	suite.foodRecipeService.AssertCalled(suite.T(), "GetByID", 1)
+
+	recipeID := uint(1)
+	suite.repo.AssertCalled(suite.T(), "Create", &model.Favorite{FoodRecipeID: 1, UserID: "UID"}, &model.Notification{
+		RecipientID:  "AUTHOR",
+		ActorID:      "UID",
+		Type:         model.NotificationFavorite,
+		FoodRecipeID: &recipeID,
+	})
+}
+
+func (suite *ServiceFavoriteTestSuite) TestReturnNotFoundWhenRecipeNotExist() {
+	suite.errGetRecipeByID = gorm.ErrRecordNotFound
+
+	err := suite.service.Create(99, suite.claims)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceFavoriteTestSuite) TestReturnErrorWhenBlocked() {
+	suite.errBlocked = global.ErrBlocked
+
+	err := suite.service.Create(1, suite.claims)
+
+	suite.ErrorIs(err, global.ErrBlocked)
+	suite.userService.AssertCalled(suite.T(), "CheckBlocked", "UID", "AUTHOR")
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceFavoriteTestSuite) TestReturnErrorWhenRepositoryCreate() {
+	suite.errRepositoryCreate = assert.AnError
+
+	err := suite.service.Create(1, suite.claims)
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceFavoriteTestSuite) TestDeleteFavoriteOfCaller() {
+	err := suite.service.Delete(1, suite.claims)
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Delete", 1, "UID")
+}
+
+func (suite *ServiceFavoriteTestSuite) TestReturnErrorWhenRepositoryDelete() {
+	suite.errRepositoryDelete = assert.AnError
+
+	err := suite.service.Delete(1, suite.claims)
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceFavoriteTestSuite) TestGetByUserWithFavoriteState() {
+	query := model.FoodRecipeQuery{Page: 1, Limit: 10}
+
+	recipes, total, err := suite.service.GetByUser(query, suite.claims)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Equal(float64(4), recipes[0].AverageRating)
+	suite.True(recipes[0].Favorite.IsFavorited)
+	suite.repo.AssertCalled(suite.T(), "GetByUser", query, "UID")
+}
+
+func (suite *ServiceFavoriteTestSuite) TestReturnErrorWhenRepositoryCount() {
+	suite.errRepositoryCount = assert.AnError
+
+	_, _, err := suite.service.GetByUser(model.FoodRecipeQuery{Page: 1, Limit: 10}, suite.claims)
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceFavorite(t *testing.T) {
+	suite.Run(t, new(ServiceFavoriteTestSuite))
+}
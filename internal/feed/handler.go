@@ -0,0 +1,67 @@
+package feed
+
+import (
+	"net/http"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// Get godoc
+// @Summary Get my activity feed
+// @Description Get recipes and reviews published by users the caller follows, newest first. Pass nextCursor from the previous page as cursor to get the next page
+// @Tags feed
+// @Produce json
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Items per page"
+// @Success 200 {object} dto.FeedResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/feed [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	query := model.FeedQuery{Limit: 20}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	feed, err := handler.Service.Get(query, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, global.ErrInvalidCursor) {
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Header("Cache-Control", "private, no-store")
+	ctx.JSON(http.StatusOK, feed.ToResponse())
+}
@@ -0,0 +1,153 @@
+package feed_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/feed"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := feed.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler feed.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceGet model.Feed
+	errServiceGet  error
+
+	// Helper
+	server func(url string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerGetTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerGetTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = feed.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(url string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/feed", suite.handler.Get)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	next := model.FeedCursor{Type: model.FeedItemRecipe, ID: 1, CreatedAt: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)}
+	suite.respServiceGet = model.Feed{
+		Items: []model.FeedItem{
+			{FeedEntry: model.FeedEntry(next), Recipe: &model.FoodRecipe{Model: gorm.Model{ID: 1}}},
+		},
+		NextCursor: &next,
+	}
+	suite.errServiceGet = nil
+
+	suite.service.On("Get", mock.Anything, mock.Anything).Return(func(model.FeedQuery, model.Claims) (model.Feed, error) {
+		return suite.respServiceGet, suite.errServiceGet
+	})
+}
+
+func (suite *HandlerGetTestSuite) TestResponseFeed() {
+	claims := model.Claims{ID: "Reader"}
+
+	response := suite.server("/api/v1/feed?cursor=abc&limit=5", &claims)
+
+	expectedJson, _ := json.Marshal(suite.respServiceGet.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.Equal("private, no-store", response.Header().Get("Cache-Control"))
+	suite.service.AssertCalled(suite.T(), "Get", model.FeedQuery{Cursor: "abc", Limit: 5}, claims)
+}
+
+func (suite *HandlerGetTestSuite) TestDefaultLimit() {
+	claims := model.Claims{ID: "Reader"}
+
+	response := suite.server("/api/v1/feed", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", model.FeedQuery{Limit: 20}, claims)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatusCode400WhenLimitInvalid() {
+	claims := model.Claims{ID: "Reader"}
+
+	response := suite.server("/api/v1/feed?limit=0", &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatusCode400WhenCursorInvalid() {
+	suite.errServiceGet = global.ErrInvalidCursor
+	claims := model.Claims{ID: "Reader"}
+
+	response := suite.server("/api/v1/feed?cursor=abc", &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid cursor"}`, response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestResponseErrorWhenGet() {
+	suite.errServiceGet = assert.AnError
+	claims := model.Claims{ID: "Reader"}
+
+	response := suite.server("/api/v1/feed", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatusCode401() {
+	response := suite.server("/api/v1/feed", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
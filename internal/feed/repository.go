@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"database/sql"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	GetEntries(userID string, cursor *model.FeedCursor, limit int) ([]model.FeedEntry, error)
+	GetRecipes(ids []uint) (model.FoodRecipes, error)
+	GetReviews(ids []uint) (model.Ratings, error)
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// GetEntries รวมรายการของคนที่ userID ติดตามตอนอ่าน (fan-out-on-read)
+// แต่ละชนิดตัดด้วย cursor และ limit ก่อนรวม จึงอ่านแค่ limit แถวล่าสุดจาก index ของแต่ละตาราง
+// รีวิวคือ rating ที่มีหัวข้อหรือเนื้อหา ให้คะแนนอย่างเดียวไม่นับ
+// คนที่ mute หรือ block กันไม่อยู่ใน feed แม้จะยังมีแถวใน follows
+func (repo Repository) GetEntries(userID string, cursor *model.FeedCursor, limit int) ([]model.FeedEntry, error) {
+	var entries = make([]model.FeedEntry, 0)
+
+	recipeAfter, reviewAfter := "", ""
+	args := []any{sql.Named("user", userID), sql.Named("viewer", userID), sql.Named("limit", limit)}
+	if cursor != nil {
+		recipeAfter = "AND (food_recipes.created_at, 'recipe', food_recipes.id) < (@at, @type, @id)"
+		reviewAfter = "AND (ratings.created_at, 'review', ratings.id) < (@at, @type, @id)"
+		args = append(args, sql.Named("at", cursor.CreatedAt), sql.Named("type", cursor.Type), sql.Named("id", cursor.ID))
+	}
+
+	err := repo.DB.Raw(`
+		SELECT type, id, created_at FROM (
+			(
+				SELECT 'recipe' AS type, food_recipes.id, food_recipes.created_at
+				FROM food_recipes
+				JOIN follows ON follows.followee_id = food_recipes.user_id AND follows.follower_id = @user
+				WHERE food_recipes.deleted_at IS NULL
+					AND food_recipes.user_id NOT IN (`+helper.HiddenUsersSQL+`) `+recipeAfter+`
+				ORDER BY food_recipes.created_at DESC, food_recipes.id DESC
+				LIMIT @limit
+			)
+			UNION ALL
+			(
+				SELECT 'review' AS type, ratings.id, ratings.created_at
+				FROM ratings
+				JOIN follows ON follows.followee_id = ratings.user_id AND follows.follower_id = @user
+				WHERE ratings.deleted_at IS NULL AND (ratings.title <> '' OR ratings.body <> '')
+					AND ratings.user_id NOT IN (`+helper.HiddenUsersSQL+`) `+reviewAfter+`
+				ORDER BY ratings.created_at DESC, ratings.id DESC
+				LIMIT @limit
+			)
+		) AS feed
+		ORDER BY created_at DESC, type DESC, id DESC
+		LIMIT @limit`, args...).Scan(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (repo Repository) GetRecipes(ids []uint) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if len(ids) == 0 {
+		return recipes, nil
+	}
+
+	if err := repo.DB.Preload(clause.Associations).Find(&recipes, ids).Error; err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
+func (repo Repository) GetReviews(ids []uint) (model.Ratings, error) {
+	var ratings = make(model.Ratings, 0)
+
+	if len(ids) == 0 {
+		return ratings, nil
+	}
+
+	if err := repo.DB.Preload("User").Find(&ratings, ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ratings, nil
+}
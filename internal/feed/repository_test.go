@@ -0,0 +1,188 @@
+package feed_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/feed"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := feed.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository feed.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &feed.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+type RepositoryGetEntriesTestSuite struct {
+	RepositoryTestSuite
+}
+
+const (
+	feedReader = "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+	feedCook   = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+)
+
+// recipe 1 และรีวิว 1 ของ feedCook ห่างกัน 1 ชั่วโมง รีวิวใหม่กว่า
+func (suite *RepositoryGetEntriesTestSuite) SetupTest() {
+	suite.RepositoryTestSuite.SetupTest()
+
+	recipeAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	suite.NoError(suite.db.Exec("UPDATE food_recipes SET created_at = ? WHERE id = 1", recipeAt).Error)
+	suite.NoError(suite.db.Exec("UPDATE ratings SET title = 'Great', created_at = ? WHERE id = 1", recipeAt.Add(time.Hour)).Error)
+	suite.NoError(suite.db.Exec("INSERT INTO follows (follower_id, followee_id, created_at) VALUES (?, ?, NOW())", feedReader, feedCook).Error)
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM follows")
+	suite.db.Exec("DELETE FROM user_mutes")
+	suite.db.Exec("UPDATE ratings SET title = '' WHERE id = 1")
+	suite.RepositoryTestSuite.TearDownTest()
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TestReturnNewestFirst() {
+	entries, err := suite.repository.GetEntries(feedReader, nil, 10)
+	suite.NoError(err)
+
+	suite.Len(entries, 2)
+	suite.Equal(model.FeedItemReview, entries[0].Type)
+	suite.Equal(uint(1), entries[0].ID)
+	suite.Equal(model.FeedItemRecipe, entries[1].Type)
+	suite.Equal(uint(1), entries[1].ID)
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TestContinueAfterCursor() {
+	entries, err := suite.repository.GetEntries(feedReader, nil, 1)
+	suite.NoError(err)
+	suite.Len(entries, 1)
+
+	cursor := model.FeedCursor(entries[0])
+	next, err := suite.repository.GetEntries(feedReader, &cursor, 1)
+	suite.NoError(err)
+
+	suite.Len(next, 1)
+	suite.Equal(model.FeedItemRecipe, next[0].Type)
+
+	last := model.FeedCursor(next[0])
+	rest, err := suite.repository.GetEntries(feedReader, &last, 1)
+	suite.NoError(err)
+	suite.Empty(rest)
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TestSkipRatingWithoutReview() {
+	suite.NoError(suite.db.Exec("UPDATE ratings SET title = '' WHERE id = 1").Error)
+
+	entries, err := suite.repository.GetEntries(feedReader, nil, 10)
+	suite.NoError(err)
+
+	suite.Len(entries, 1)
+	suite.Equal(model.FeedItemRecipe, entries[0].Type)
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TestSkipMutedFollowee() {
+	suite.NoError(suite.db.Exec("INSERT INTO user_mutes (muter_id, muted_id, created_at) VALUES (?, ?, NOW())", feedReader, feedCook).Error)
+
+	entries, err := suite.repository.GetEntries(feedReader, nil, 10)
+	suite.NoError(err)
+
+	suite.Empty(entries)
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TestEmptyWhenFollowingNobody() {
+	entries, err := suite.repository.GetEntries(feedCook, nil, 10)
+	suite.NoError(err)
+
+	suite.Empty(entries)
+}
+
+func (suite *RepositoryGetEntriesTestSuite) TestGetRecipesAndReviews() {
+	recipes, err := suite.repository.GetRecipes([]uint{1})
+	suite.NoError(err)
+	suite.Len(recipes, 1)
+	suite.Equal(feedCook, recipes[0].User.ID)
+
+	reviews, err := suite.repository.GetReviews([]uint{1})
+	suite.NoError(err)
+	suite.Len(reviews, 1)
+	suite.Equal(feedCook, reviews[0].User.ID)
+
+	empty, err := suite.repository.GetReviews(nil)
+	suite.NoError(err)
+	suite.Empty(empty)
+}
+
+func TestRepositoryGetEntries(t *testing.T) {
+	suite.Run(t, new(RepositoryGetEntriesTestSuite))
+}
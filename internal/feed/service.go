@@ -0,0 +1,103 @@
+package feed
+
+import (
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/model"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IFoodRecipeService foodrecipe.IService
+
+type IService interface {
+	Get(query model.FeedQuery, claims model.Claims) (model.Feed, error)
+}
+
+type Service struct {
+	Repository        IRepository
+	FoodRecipeService IFoodRecipeService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+	}
+}
+
+// Get รายการใหม่สุดก่อน ขอเกิน limit ไป 1 รายการเพื่อรู้ว่ามีหน้าถัดไปหรือไม่
+func (service Service) Get(query model.FeedQuery, claims model.Claims) (model.Feed, error) {
+	var cursor *model.FeedCursor
+	if query.Cursor != "" {
+		decoded, err := model.DecodeFeedCursor(query.Cursor)
+		if err != nil {
+			return model.Feed{}, err
+		}
+		cursor = &decoded
+	}
+
+	entries, err := service.Repository.GetEntries(claims.ID, cursor, query.Limit+1)
+	if err != nil {
+		return model.Feed{}, errors.Wrap(err, "find feed")
+	}
+
+	var feed model.Feed
+	if len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+		next := model.FeedCursor(entries[len(entries)-1])
+		feed.NextCursor = &next
+	}
+
+	var recipeIDs, reviewIDs []uint
+	for _, entry := range entries {
+		switch entry.Type {
+		case model.FeedItemRecipe:
+			recipeIDs = append(recipeIDs, entry.ID)
+		case model.FeedItemReview:
+			reviewIDs = append(reviewIDs, entry.ID)
+		}
+	}
+
+	recipes, err := service.Repository.GetRecipes(recipeIDs)
+	if err != nil {
+		return model.Feed{}, errors.Wrap(err, "find recipes")
+	}
+
+	recipes, err = service.FoodRecipeService.WithFavorites(recipes.CalculateAverageRatings(), claims)
+	if err != nil {
+		return model.Feed{}, err
+	}
+
+	reviews, err := service.Repository.GetReviews(reviewIDs)
+	if err != nil {
+		return model.Feed{}, errors.Wrap(err, "find reviews")
+	}
+
+	recipeByID := make(map[uint]model.FoodRecipe, len(recipes))
+	for _, recipe := range recipes {
+		recipeByID[recipe.ID] = recipe
+	}
+	reviewByID := make(map[uint]model.Rating, len(reviews))
+	for _, review := range reviews {
+		reviewByID[review.ID] = review
+	}
+
+	// รายการที่ถูกลบระหว่างสอง query จะไม่มีใน map จึงข้ามไป
+	feed.Items = make([]model.FeedItem, 0, len(entries))
+	for _, entry := range entries {
+		item := model.FeedItem{FeedEntry: entry}
+
+		if recipe, ok := recipeByID[entry.ID]; ok && entry.Type == model.FeedItemRecipe {
+			item.Recipe = &recipe
+		} else if review, ok := reviewByID[entry.ID]; ok && entry.Type == model.FeedItemReview {
+			item.Review = &review
+		} else {
+			continue
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+
+	return feed, nil
+}
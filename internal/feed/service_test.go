@@ -0,0 +1,182 @@
+package feed_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/feed"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := feed.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           feed.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	mockTime         time.Time
+	claims           model.Claims
+	respGetEntries   []model.FeedEntry
+	errGetEntries    error
+	respGetRecipes   model.FoodRecipes
+	errGetRecipes    error
+	respGetReviews   model.Ratings
+	errGetReviews    error
+	errWithFavorites error
+}
+
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &feed.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.mockTime = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	suite.claims = model.Claims{ID: "Reader"}
+	suite.respGetEntries = []model.FeedEntry{
+		{Type: model.FeedItemReview, ID: 2, CreatedAt: suite.mockTime.Add(time.Hour)},
+		{Type: model.FeedItemRecipe, ID: 1, CreatedAt: suite.mockTime},
+	}
+	suite.errGetEntries = nil
+	suite.respGetRecipes = model.FoodRecipes{
+		{Model: gorm.Model{ID: 1}, RatingSummary: model.RatingSummary{RatingCount: 2, RatingSum: 8}},
+	}
+	suite.errGetRecipes = nil
+	suite.respGetReviews = model.Ratings{{Model: gorm.Model{ID: 2}, Body: "Body"}}
+	suite.errGetReviews = nil
+	suite.errWithFavorites = nil
+
+	suite.repo.On("GetEntries", mock.Anything, mock.Anything, mock.Anything).Return(func(string, *model.FeedCursor, int) ([]model.FeedEntry, error) {
+		return suite.respGetEntries, suite.errGetEntries
+	})
+	suite.repo.On("GetRecipes", mock.Anything).Return(func([]uint) (model.FoodRecipes, error) {
+		return suite.respGetRecipes, suite.errGetRecipes
+	})
+	suite.repo.On("GetReviews", mock.Anything).Return(func([]uint) (model.Ratings, error) {
+		return suite.respGetReviews, suite.errGetReviews
+	})
+	suite.foodRecipeService.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes, suite.errWithFavorites
+	})
+}
+
+func (suite *ServiceGetTestSuite) TestReturnItemsInEntryOrder() {
+	result, err := suite.service.Get(model.FeedQuery{Limit: 20}, suite.claims)
+	suite.NoError(err)
+
+	suite.Len(result.Items, 2)
+	suite.Equal("Body", result.Items[0].Review.Body)
+	suite.Nil(result.Items[0].Recipe)
+	suite.Equal(uint(1), result.Items[1].Recipe.ID)
+	suite.Equal(float64(4), result.Items[1].Recipe.AverageRating)
+	suite.Nil(result.NextCursor)
+
+	var nilCursor *model.FeedCursor
+	suite.repo.AssertCalled(suite.T(), "GetEntries", "Reader", nilCursor, 21)
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", []uint{1})
+	suite.repo.AssertCalled(suite.T(), "GetReviews", []uint{2})
+	suite.foodRecipeService.AssertCalled(suite.T(), "WithFavorites", mock.Anything, suite.claims)
+}
+
+func (suite *ServiceGetTestSuite) TestSetNextCursorWhenMoreEntries() {
+	result, err := suite.service.Get(model.FeedQuery{Limit: 1}, suite.claims)
+	suite.NoError(err)
+
+	suite.Len(result.Items, 1)
+	suite.Equal(model.FeedCursor(suite.respGetEntries[0]), *result.NextCursor)
+}
+
+func (suite *ServiceGetTestSuite) TestPassDecodedCursor() {
+	cursor := model.FeedCursor{Type: model.FeedItemRecipe, ID: 9, CreatedAt: suite.mockTime}
+
+	_, err := suite.service.Get(model.FeedQuery{Cursor: cursor.Encode(), Limit: 20}, suite.claims)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "GetEntries", "Reader", &cursor, 21)
+}
+
+func (suite *ServiceGetTestSuite) TestSkipItemDeletedBetweenQueries() {
+	suite.respGetReviews = model.Ratings{}
+
+	result, err := suite.service.Get(model.FeedQuery{Limit: 20}, suite.claims)
+	suite.NoError(err)
+
+	suite.Len(result.Items, 1)
+	suite.Equal(model.FeedItemRecipe, result.Items[0].Type)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenCursorInvalid() {
+	result, err := suite.service.Get(model.FeedQuery{Cursor: "invalid", Limit: 20}, suite.claims)
+	suite.ErrorIs(err, global.ErrInvalidCursor)
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "GetEntries", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenGetEntries() {
+	suite.errGetEntries = assert.AnError
+
+	result, err := suite.service.Get(model.FeedQuery{Limit: 20}, suite.claims)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "find feed"))
+
+	suite.Empty(result)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenGetRecipes() {
+	suite.errGetRecipes = assert.AnError
+
+	result, err := suite.service.Get(model.FeedQuery{Limit: 20}, suite.claims)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "find recipes"))
+
+	suite.Empty(result)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenWithFavorites() {
+	suite.errWithFavorites = assert.AnError
+
+	result, err := suite.service.Get(model.FeedQuery{Limit: 20}, suite.claims)
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.Empty(result)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenGetReviews() {
+	suite.errGetReviews = assert.AnError
+
+	result, err := suite.service.Get(model.FeedQuery{Limit: 20}, suite.claims)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "find reviews"))
+
+	suite.Empty(result)
+}
+
+func TestServiceGet(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
@@ -3,10 +3,13 @@ package foodrecipe
 import (
 	"net/http"
 	"strconv"
+	"time"
+	"wongnok/internal/config"
 	"wongnok/internal/global"
 	"wongnok/internal/helper"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
+	"wongnok/internal/view"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -18,21 +21,47 @@ type IHandler interface {
 	Create(ctx *gin.Context)
 	Get(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
+	GetTrending(ctx *gin.Context)
 	Update(ctx *gin.Context)
+	Patch(ctx *gin.Context)
 	Delete(ctx *gin.Context)
 }
 
+type IViewRecorder view.IRecorder
+
 type Handler struct {
-	Service IService
+	Service      IService
+	Config       config.Concurrency
+	Trending     config.Trending
+	Rating       config.Rating
+	ViewRecorder IViewRecorder
 }
 
-func NewHandler(db *gorm.DB) *Handler {
+func NewHandler(db *gorm.DB, conf config.Concurrency, trending config.Trending, rating config.Rating, recorder IViewRecorder) *Handler {
 	return &Handler{
-		Service: NewService(db),
+		Service:      NewService(db),
+		Config:       conf,
+		Trending:     trending,
+		Rating:       rating,
+		ViewRecorder: recorder,
 	}
 }
 
-
+// Create godoc
+// @Summary Create a food recipe
+// @Description Create a new food recipe. The ETag header carries the recipe version for later If-Match requests
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param recipe body dto.FoodRecipeRequest true "Recipe data"
+// @Success 201 {object} dto.FoodRecipeResponse
+// @Header 201 {string} ETag "Recipe version"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes [post]
 func (handler Handler) Create(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
@@ -50,7 +79,7 @@ func (handler Handler) Create(ctx *gin.Context) {
 	recipe, err := handler.Service.Create(request, claims)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if errors.As(err, &validator.ValidationErrors{}) {
+		if errors.As(err, &validator.ValidationErrors{}) || errors.Is(err, global.ErrInvalidReference) {
 			statusCode = http.StatusBadRequest
 		}
 
@@ -62,26 +91,87 @@ func (handler Handler) Create(ctx *gin.Context) {
 		return
 	}
 
+	recipe, err = handler.withFavorite(recipe, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	helper.SetCacheValidators(ctx, recipe.CacheValidator())
 	ctx.JSON(http.StatusCreated, recipe.ToResponse())
 }
 
-
+// Get godoc
+// @Summary Get food recipes
+// @Description Get a list of food recipes with pagination. Signed-in callers get isFavorited, their preferences fill filters they didn't send, and recipes of users they muted or share a block with are hidden. Anonymous callers can revalidate with If-None-Match or If-Modified-Since
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Param page query int true "Page number"
+// @Param limit query int true "Items per page"
+// @Param search query string false "Search term"
+// @Param maxTotalMinutes query int false "Maximum total minutes"
+// @Param excludeAllergens query []string false "Allergens to exclude" collectionFormat(multi) Enums(peanut, tree_nut, shellfish, fish, gluten, dairy, egg, soy, sesame)
+// @Param diet query []string false "Diets every recipe must match" collectionFormat(multi) Enums(vegan, vegetarian, halal, gluten_free)
+// @Param excludeIngredients query []string false "Exclude recipes whose ingredients contain any of these words" collectionFormat(multi)
+// @Param ignorePreferences query bool false "Don't apply the caller's preferences"
+// @Param sort query string false "Sort order, rating uses the weighted rating" Enums(name, rating)
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Success 200 {object} dto.FoodRecipesResponse
+// @Header 200 {string} ETag "Anonymous callers only"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes [get]
 func (handler Handler) Get(ctx *gin.Context) {
 	var foodRecipeQuery model.FoodRecipeQuery
 	if err := ctx.ShouldBindQuery(&foodRecipeQuery); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
 		return
 	}
+	foodRecipeQuery.RatingPriorWeight = handler.Rating.PriorWeight
+
+	claims, authenticated := viewerClaims(ctx)
+	foodRecipeQuery.ViewerID = claims.ID
+
+	// ตอบ 304 ได้เลยถ้า client มีข้อมูลล่าสุดอยู่แล้ว โดยไม่ต้อง query รายการทั้งหมด
+	if validator, err := handler.Service.GetCacheValidator(); err == nil && !authenticated {
+		helper.SetCacheValidators(ctx, validator)
+		if helper.IsNotModified(ctx, validator) {
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+	}
+
 	recipes, total, err := handler.Service.Get(foodRecipeQuery)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
 
+	if authenticated {
+		if recipes, err = handler.Service.WithFavorites(recipes, claims); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
 	ctx.JSON(http.StatusOK, recipes.ToResponse(total))
 }
 
-
+// GetByID godoc
+// @Summary Get food recipe by ID
+// @Description Get a single food recipe by ID with its weighted rating. Every 200 response carries an ETag to send back in If-Match when editing. Only anonymous callers get 304 for a matching If-None-Match
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Param id path int true "Recipe ID"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Success 200 {object} dto.FoodRecipeResponse
+// @Header 200 {string} ETag "Recipe version"
+// @Success 304 "Not Modified"
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id} [get]
 func (handler Handler) GetByID(ctx *gin.Context) {
 	var id int
 
@@ -92,7 +182,18 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		}
 	}
 
-	recipe, err := handler.Service.GetByID(id)
+	claims, authenticated := viewerClaims(ctx)
+
+	if validator, err := handler.Service.GetCacheValidatorByID(id); err == nil && !authenticated {
+		if helper.IsNotModified(ctx, validator) {
+			helper.SetCacheValidators(ctx, validator)
+			handler.recordView(ctx, id)
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	recipe, err := handler.Service.GetByIDWithRating(id, handler.Rating.PriorWeight)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Recipe not found"})
@@ -102,10 +203,117 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	if authenticated {
+		if recipe, err = handler.withFavorite(recipe, claims); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	// ผู้ที่ login ก็ต้องได้ ETag ไว้ส่ง If-Match ตอนแก้ไข แม้จะไม่ได้ 304
+	helper.SetCacheValidators(ctx, recipe.CacheValidator())
+
+	handler.recordView(ctx, id)
 	ctx.JSON(http.StatusOK, recipe.ToResponse())
 }
 
+// viewerClaims สำหรับ route ที่ใช้ OptionalAuthorize
+// response ของผู้ที่ login มีข้อมูลเฉพาะตัว จึงห้าม cache ร่วมกับผู้อื่น และไม่ใช้ 304 เพราะ validator ไม่รวม favorite
+func viewerClaims(ctx *gin.Context) (model.Claims, bool) {
+	ctx.Header("Vary", "Authorization")
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		return model.Claims{}, false
+	}
+
+	ctx.Header("Cache-Control", "private, no-store")
+
+	return claims, true
+}
+
+func (handler Handler) withFavorite(recipe model.FoodRecipe, claims model.Claims) (model.FoodRecipe, error) {
+	recipes, err := handler.Service.WithFavorites(model.FoodRecipes{recipe}, claims)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	return recipes[0], nil
+}
+
+// recordView นับวิวทั้งตอนตอบ 200 และ 304 เพราะ client ที่ใช้ cache ก็เปิดดูสูตรเหมือนกัน
+func (handler Handler) recordView(ctx *gin.Context, id int) {
+	handler.ViewRecorder.Record(model.RecipeView{
+		FoodRecipeID: uint(id),
+		Viewer:       helper.DecodeViewer(ctx),
+		ViewedAt:     time.Now(),
+	})
+}
+
+// GetTrending godoc
+// @Summary Get trending food recipes
+// @Description Recipes ranked by recent views, favorites and ratings, each decaying over time
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Param limit query int false "Number of recipes" default(10) minimum(1) maximum(50)
+// @Success 200 {object} dto.FoodRecipesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/trending [get]
+func (handler Handler) GetTrending(ctx *gin.Context) {
+	var query model.TrendingQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
 
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	query.HalfLife = handler.Trending.HalfLife
+	query.Window = handler.Trending.Window
+	query.FavoriteWeight = handler.Trending.FavoriteWeight
+	query.RatingWeight = handler.Trending.RatingWeight
+	query.RatingPriorWeight = handler.Rating.PriorWeight
+
+	claims, authenticated := viewerClaims(ctx)
+
+	recipes, err := handler.Service.GetTrending(query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	if authenticated {
+		if recipes, err = handler.Service.WithFavorites(recipes, claims); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, recipes.ToResponse(int64(len(recipes))))
+}
+
+// Update godoc
+// @Summary Update food recipe
+// @Description Replace a food recipe. Send the ETag from GET in If-Match to avoid overwriting someone else's change. A stale If-Match gets 412 with the current recipe and ETag. When REQUIRE_IF_MATCH is on, requests without If-Match get 428
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Recipe ID"
+// @Param If-Match header string false "ETag of the recipe being edited"
+// @Param recipe body dto.FoodRecipeRequest true "Recipe data"
+// @Success 200 {object} dto.FoodRecipeResponse
+// @Header 200 {string} ETag "New recipe version"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 412 {object} dto.FoodRecipeResponse "Current recipe"
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id} [put]
 func (handler Handler) Update(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
@@ -128,10 +336,22 @@ func (handler Handler) Update(ctx *gin.Context) {
 		}
 	}
 
-	recipe, err := handler.Service.Update(request, id, claims)
+	ifMatch := helper.DecodeIfMatch(ctx)
+	if handler.Config.RequireIfMatch && !ifMatch.Present {
+		ctx.JSON(http.StatusPreconditionRequired, gin.H{"message": global.ErrPreconditionRequired.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.Update(request, id, ifMatch, claims)
 	if err != nil {
+		if errors.Is(err, global.ErrPreconditionFailed) {
+			helper.SetCacheValidators(ctx, recipe.CacheValidator())
+			ctx.JSON(http.StatusPreconditionFailed, recipe.ToResponse())
+			return
+		}
+
 		statusCode := http.StatusInternalServerError
-		if errors.As(err, &validator.ValidationErrors{}) {
+		if errors.As(err, &validator.ValidationErrors{}) || errors.Is(err, global.ErrInvalidReference) {
 			statusCode = http.StatusBadRequest
 		}
 
@@ -143,10 +363,110 @@ func (handler Handler) Update(ctx *gin.Context) {
 		return
 	}
 
+	recipe, err = handler.withFavorite(recipe, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	helper.SetCacheValidators(ctx, recipe.CacheValidator())
 	ctx.JSON(http.StatusOK, recipe.ToResponse())
 }
 
+// Patch godoc
+// @Summary Patch food recipe
+// @Description Update some fields of a food recipe with a JSON merge patch. If-Match works the same as PUT
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Recipe ID"
+// @Param If-Match header string false "ETag of the recipe being edited"
+// @Param patch body dto.FoodRecipeRequest true "Fields to change"
+// @Success 200 {object} dto.FoodRecipeResponse
+// @Header 200 {string} ETag "New recipe version"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 412 {object} dto.FoodRecipeResponse "Current recipe"
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id} [patch]
+func (handler Handler) Patch(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var id int
+
+	patch, err := ctx.GetRawData()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	pathParam := ctx.Param("id")
+	if pathParam != "" {
+		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
+			id = parsed
+		}
+	}
+
+	ifMatch := helper.DecodeIfMatch(ctx)
+	if handler.Config.RequireIfMatch && !ifMatch.Present {
+		ctx.JSON(http.StatusPreconditionRequired, gin.H{"message": global.ErrPreconditionRequired.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.Patch(patch, id, ifMatch, claims)
+	if err != nil {
+		if errors.Is(err, global.ErrPreconditionFailed) {
+			helper.SetCacheValidators(ctx, recipe.CacheValidator())
+			ctx.JSON(http.StatusPreconditionFailed, recipe.ToResponse())
+			return
+		}
+
+		statusCode := http.StatusInternalServerError
+		if errors.As(err, &validator.ValidationErrors{}) || errors.Is(err, global.ErrInvalidPatch) || errors.Is(err, global.ErrInvalidReference) {
+			statusCode = http.StatusBadRequest
+		}
+
+		if errors.Is(err, global.ErrForbidden) {
+			statusCode = http.StatusForbidden
+		}
 
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipe, err = handler.withFavorite(recipe, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	helper.SetCacheValidators(ctx, recipe.CacheValidator())
+	ctx.JSON(http.StatusOK, recipe.ToResponse())
+}
+
+// Delete godoc
+// @Summary Delete food recipe
+// @Description Delete a food recipe by ID. If-Match works the same as PUT
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Recipe ID"
+// @Param If-Match header string false "ETag of the recipe being deleted"
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 412 {object} dto.FoodRecipeResponse "Current recipe"
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id} [delete]
 func (handler Handler) Delete(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
@@ -163,7 +483,19 @@ func (handler Handler) Delete(ctx *gin.Context) {
 		}
 	}
 
-	if err := handler.Service.Delete(id, claims); err != nil {
+	ifMatch := helper.DecodeIfMatch(ctx)
+	if handler.Config.RequireIfMatch && !ifMatch.Present {
+		ctx.JSON(http.StatusPreconditionRequired, gin.H{"message": global.ErrPreconditionRequired.Error()})
+		return
+	}
+
+	if recipe, err := handler.Service.Delete(id, ifMatch, claims); err != nil {
+		if errors.Is(err, global.ErrPreconditionFailed) {
+			helper.SetCacheValidators(ctx, recipe.CacheValidator())
+			ctx.JSON(http.StatusPreconditionFailed, recipe.ToResponse())
+			return
+		}
+
 		statusCode := http.StatusInternalServerError
 
 		if errors.Is(err, global.ErrForbidden) {
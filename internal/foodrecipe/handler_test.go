@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
+	"wongnok/internal/config"
 	"wongnok/internal/foodrecipe"
 	"wongnok/internal/global"
 	"wongnok/internal/model"
@@ -24,7 +26,13 @@ import (
 func TestNewHandler(t *testing.T) {
 
 	t.Run("ShouldFillProperties", func(t *testing.T) {
-		handler := foodrecipe.NewHandler(&gorm.DB{})
+		handler := foodrecipe.NewHandler(
+			&gorm.DB{},
+			config.Concurrency{RequireIfMatch: true},
+			config.Trending{HalfLife: time.Hour},
+			config.Rating{PriorWeight: 10},
+			new(MockIViewRecorder),
+		)
 
 		value := reflect.Indirect(reflect.ValueOf(handler))
 
@@ -102,6 +110,9 @@ func (suite *HandlerCreateTestSuite) SetupTest() {
 	}
 	suite.errServiceCreate = nil
 
+	suite.service.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes, nil
+	})
 	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error) {
 		return suite.respServiceCreate, suite.errServiceCreate
 	})
@@ -180,6 +191,22 @@ func (suite *HandlerCreateTestSuite) TestValidationErrorsErrorWhenServiceCreateR
 	suite.Equal(`{"message":""}`, response.Body.String())
 }
 
+func (suite *HandlerCreateTestSuite) TestErrorWhenReferenceInvalid() {
+	suite.errServiceCreate = global.ErrInvalidReference
+
+	payload := strings.NewReader(`{"name":"Name"}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid reference data"}`, response.Body.String())
+}
+
 func (suite *HandlerCreateTestSuite) TestErrorForbidden() {
 	suite.errServiceCreate = global.ErrForbidden
 
@@ -223,6 +250,14 @@ type HandlerGetTestSuite struct {
 	respRecipesInServiceGet model.FoodRecipes
 	respTotalInServiceGet   int64
 	errServiceGet           error
+	respCacheValidator      model.CacheValidator
+	errCacheValidator       error
+
+	// Request
+	query           string
+	ifNoneMatch     string
+	ifModifiedSince string
+	claims          *model.Claims
 
 	// Helper
 	server func(payload io.Reader) *httptest.ResponseRecorder
@@ -238,11 +273,17 @@ func (suite *HandlerGetTestSuite) SetupTest() {
 	suite.service = new(MockIService)
 	suite.handler = foodrecipe.Handler{
 		Service: suite.service,
+		Rating:  config.Rating{PriorWeight: 10},
 	}
 
 	suite.server = func(payload io.Reader) *httptest.ResponseRecorder {
 		// Create router
 		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if suite.claims != nil {
+				ctx.Set("claims", *suite.claims)
+			}
+		})
 		router.GET("/api/v1/food-recipes", suite.handler.Get)
 
 		// Recoder
@@ -251,12 +292,19 @@ func (suite *HandlerGetTestSuite) SetupTest() {
 		// Create request
 		request, err := http.NewRequest(
 			http.MethodGet,
-			"/api/v1/food-recipes?search=name&page=1&limit=10",
+			"/api/v1/food-recipes?"+suite.query,
 			payload,
 		)
 
 		suite.NoError(err)
 
+		if suite.ifNoneMatch != "" {
+			request.Header.Set("If-None-Match", suite.ifNoneMatch)
+		}
+		if suite.ifModifiedSince != "" {
+			request.Header.Set("If-Modified-Since", suite.ifModifiedSince)
+		}
+
 		// Start testing server
 		router.ServeHTTP(recorder, request)
 
@@ -281,10 +329,25 @@ func (suite *HandlerGetTestSuite) SetupTest() {
 	}
 	suite.respTotalInServiceGet = 10
 	suite.errServiceGet = nil
-
+	suite.respCacheValidator = model.CacheValidator{
+		Count:        10,
+		LastModified: time.Date(2025, 7, 9, 10, 10, 10, 0, time.UTC),
+	}
+	suite.errCacheValidator = nil
+	suite.query = "search=name&page=1&limit=10"
+	suite.ifNoneMatch = ""
+	suite.ifModifiedSince = ""
+	suite.claims = nil
+
+	suite.service.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes.WithFavoriteStates([]model.FavoriteState{{FoodRecipeID: recipes[0].ID, FavoriteCount: 3, IsFavorited: true}}), nil
+	})
 	suite.service.On("Get", mock.AnythingOfType("model.FoodRecipeQuery")).Return(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
 		return suite.respRecipesInServiceGet, suite.respTotalInServiceGet, suite.errServiceGet
 	})
+	suite.service.On("GetCacheValidator").Return(func() (model.CacheValidator, error) {
+		return suite.respCacheValidator, suite.errCacheValidator
+	})
 }
 
 func (suite *HandlerGetTestSuite) TestResponseRecipesWithStatus200() {
@@ -316,9 +379,124 @@ func (suite *HandlerGetTestSuite) TestResponseRecipesWithStatus200() {
 	expectedJson, _ := json.Marshal(expectedResponse)
 
 	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(suite.respCacheValidator.ETag(), response.Header().Get("ETag"))
+	suite.Equal("Wed, 09 Jul 2025 10:10:10 GMT", response.Header().Get("Last-Modified"))
 	suite.Equal(string(expectedJson), response.Body.String())
 }
 
+func (suite *HandlerGetTestSuite) TestBindLabelFilters() {
+	suite.query = "page=1&limit=10&excludeAllergens=peanut&excludeAllergens=egg&diet=vegan&diet=halal"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", model.FoodRecipeQuery{
+		Page:              1,
+		Limit:             10,
+		ExcludeAllergens:  []string{"peanut", "egg"},
+		Diet:              []string{"vegan", "halal"},
+		RatingPriorWeight: 10,
+	})
+}
+
+func (suite *HandlerGetTestSuite) TestBindSortWithPriorWeightFromConfig() {
+	suite.query = "page=1&limit=10&sort=rating"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", model.FoodRecipeQuery{
+		Page:              1,
+		Limit:             10,
+		Sort:              model.FoodRecipeSortRating,
+		RatingPriorWeight: 10,
+	})
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatus400WhenSortUnknown() {
+	suite.query = "page=1&limit=10&sort=average"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatus400WhenLabelUnknown() {
+	suite.query = "page=1&limit=10&excludeAllergens=peanut&excludeAllergens=unknown"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatus304WhenETagMatches() {
+	suite.ifNoneMatch = suite.respCacheValidator.ETag()
+
+	response := suite.server(nil)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotModified, response.Code)
+	suite.Empty(response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatus304WhenNotModifiedSince() {
+	suite.ifModifiedSince = "Wed, 09 Jul 2025 10:10:10 GMT"
+
+	response := suite.server(nil)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotModified, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatus200WhenModifiedSince() {
+	suite.ifModifiedSince = "Wed, 09 Jul 2025 10:10:09 GMT"
+
+	response := suite.server(nil)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseStatus200WhenCacheValidatorFailed() {
+	suite.errCacheValidator = assert.AnError
+	suite.ifNoneMatch = "*"
+
+	response := suite.server(nil)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Empty(response.Header().Get("ETag"))
+}
+
 func (suite *HandlerGetTestSuite) TestErrorWhenGetRecipes() {
 	suite.errServiceGet = assert.AnError
 
@@ -332,6 +510,28 @@ func (suite *HandlerGetTestSuite) TestErrorWhenGetRecipes() {
 	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
 }
 
+func (suite *HandlerGetTestSuite) TestResponseFavoritesWhenAuthenticated() {
+	suite.claims = &model.Claims{ID: "UID"}
+	suite.ifNoneMatch = suite.respCacheValidator.ETag()
+
+	response := suite.server(nil)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Contains(response.Body.String(), `"isFavorited":true,"favoriteCount":3`)
+	suite.Equal("private, no-store", response.Header().Get("Cache-Control"))
+	suite.Empty(response.Header().Get("ETag"))
+	suite.service.AssertCalled(suite.T(), "WithFavorites", mock.Anything, *suite.claims)
+}
+
+func (suite *HandlerGetTestSuite) TestOmitFavoritesWhenAnonymous() {
+	response := suite.server(nil)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.NotContains(response.Body.String(), "isFavorited")
+	suite.Equal("Authorization", response.Header().Get("Vary"))
+	suite.service.AssertNotCalled(suite.T(), "WithFavorites", mock.Anything, mock.Anything)
+}
+
 func TestHandlerGet(t *testing.T) {
 	suite.Run(t, new(HandlerGetTestSuite))
 }
@@ -340,12 +540,18 @@ type HandlerGetByIDTestSuite struct {
 	suite.Suite
 
 	// Dependencies
-	handler foodrecipe.IHandler
-	service *MockIService
+	handler  foodrecipe.IHandler
+	service  *MockIService
+	recorder *MockIViewRecorder
 
 	// Mock data
 	respRecipeInServiceGetByID model.FoodRecipe
 	errServiceGetByID          error
+	errCacheValidator          error
+
+	// Request header
+	ifNoneMatch string
+	claims      *model.Claims
 
 	// Helper
 	server func(payload io.Reader) *httptest.ResponseRecorder
@@ -357,13 +563,21 @@ func (suite *HandlerGetByIDTestSuite) SetupSuite() {
 
 func (suite *HandlerGetByIDTestSuite) SetupTest() {
 	suite.service = new(MockIService)
+	suite.recorder = new(MockIViewRecorder)
 	suite.handler = foodrecipe.Handler{
-		Service: suite.service,
+		Service:      suite.service,
+		Rating:       config.Rating{PriorWeight: 10},
+		ViewRecorder: suite.recorder,
 	}
 
 	suite.server = func(payload io.Reader) *httptest.ResponseRecorder {
 		// Create router
 		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if suite.claims != nil {
+				ctx.Set("claims", *suite.claims)
+			}
+		})
 		router.GET("/api/v1/food-recipes/:id", suite.handler.GetByID)
 
 		// Recoder
@@ -377,6 +591,10 @@ func (suite *HandlerGetByIDTestSuite) SetupTest() {
 		)
 		suite.NoError(err)
 
+		if suite.ifNoneMatch != "" {
+			request.Header.Set("If-None-Match", suite.ifNoneMatch)
+		}
+
 		// Start testing server
 		router.ServeHTTP(recorder, request)
 
@@ -397,15 +615,32 @@ func (suite *HandlerGetByIDTestSuite) SetupTest() {
 			Model: gorm.Model{ID: 1},
 			Name:  "DifficultyName",
 		},
+		Version: 3,
 	}
 
 	suite.errServiceGetByID = nil
-	suite.service.On("GetByID", mock.AnythingOfType("int")).Return(func(id int) (model.FoodRecipe, error) {
+	suite.errCacheValidator = nil
+	suite.ifNoneMatch = ""
+	suite.claims = nil
+
+	suite.service.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes.WithFavoriteStates([]model.FavoriteState{{FoodRecipeID: recipes[0].ID, FavoriteCount: 3, IsFavorited: true}}), nil
+	})
+	suite.service.On("GetByIDWithRating", mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(func(id int, _ float64) (model.FoodRecipe, error) {
 		if id == 1 {
 			return suite.respRecipeInServiceGetByID, suite.errServiceGetByID
 		}
 		return model.FoodRecipe{}, gorm.ErrRecordNotFound
 	})
+	suite.service.On("GetCacheValidatorByID", mock.AnythingOfType("int")).Return(func(id int) (model.CacheValidator, error) {
+		return suite.respRecipeInServiceGetByID.CacheValidator(), suite.errCacheValidator
+	})
+	suite.recorder.On("Record", mock.Anything).Return(true)
+}
+
+// recordedView ตรวจว่าบันทึกวิวของ recipe 1 ด้วยผู้ชมแบบไม่ได้ login
+func (suite *HandlerGetByIDTestSuite) recordedView(view model.RecipeView) bool {
+	return view.FoodRecipeID == 1 && strings.HasPrefix(view.Viewer, "a:") && !view.ViewedAt.IsZero()
 }
 
 func (suite *HandlerGetByIDTestSuite) TestResponseRecipeWithStatus200() {
@@ -432,7 +667,36 @@ func (suite *HandlerGetByIDTestSuite) TestResponseRecipeWithStatus200() {
 	expectedJson, _ := json.Marshal(expectedResponse)
 
 	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(suite.respRecipeInServiceGetByID.CacheValidator().ETag(), response.Header().Get("ETag"))
 	suite.Equal(string(expectedJson), response.Body.String())
+	suite.recorder.AssertCalled(suite.T(), "Record", mock.MatchedBy(suite.recordedView))
+}
+
+func (suite *HandlerGetByIDTestSuite) TestResponseStatus304WhenETagMatches() {
+	suite.ifNoneMatch = `W/"1-abc", ` + suite.respRecipeInServiceGetByID.CacheValidator().ETag()
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotModified, response.Code)
+	suite.Equal(suite.respRecipeInServiceGetByID.CacheValidator().ETag(), response.Header().Get("ETag"))
+	suite.Empty(response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "GetByIDWithRating", mock.Anything, mock.Anything)
+	suite.recorder.AssertCalled(suite.T(), "Record", mock.MatchedBy(suite.recordedView))
+}
+
+func (suite *HandlerGetByIDTestSuite) TestResponseStatus200WhenETagChanged() {
+	suite.ifNoneMatch = `"2-abc"`
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "GetByIDWithRating", 1, 10.0)
 }
 
 func (suite *HandlerGetByIDTestSuite) TestErrorWhenRecipeNotFound() {
@@ -445,6 +709,7 @@ func (suite *HandlerGetByIDTestSuite) TestErrorWhenRecipeNotFound() {
 
 	suite.Equal(http.StatusInternalServerError, response.Code)
 	suite.Equal(`{"message":"Recipe not found"}`, response.Body.String())
+	suite.recorder.AssertNotCalled(suite.T(), "Record", mock.Anything)
 }
 
 func (suite *HandlerGetByIDTestSuite) TestErrorWhenCallGetRecipe() {
@@ -459,10 +724,167 @@ func (suite *HandlerGetByIDTestSuite) TestErrorWhenCallGetRecipe() {
 	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
 }
 
+func (suite *HandlerGetByIDTestSuite) TestResponseFavoritesWhenAuthenticated() {
+	suite.claims = &model.Claims{ID: "UID"}
+	suite.ifNoneMatch = suite.respRecipeInServiceGetByID.CacheValidator().ETag()
+
+	response := suite.server(nil)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Contains(response.Body.String(), `"isFavorited":true,"favoriteCount":3`)
+	suite.Equal("private, no-store", response.Header().Get("Cache-Control"))
+	suite.service.AssertCalled(suite.T(), "WithFavorites", mock.Anything, *suite.claims)
+}
+
+func (suite *HandlerGetByIDTestSuite) TestResponseETagWhenAuthenticated() {
+	suite.claims = &model.Claims{ID: "UID"}
+
+	response := suite.server(nil)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(suite.respRecipeInServiceGetByID.CacheValidator().ETag(), response.Header().Get("ETag"))
+	suite.Contains(response.Header().Get("Cache-Control"), "private")
+}
+
 func TestHandlerGetByID(t *testing.T) {
 	suite.Run(t, new(HandlerGetByIDTestSuite))
 }
 
+type HandlerGetTrendingTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler foodrecipe.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceGetTrending model.FoodRecipes
+	errServiceGetTrending  error
+	claims                 *model.Claims
+
+	// Helper
+	server func(url string) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerGetTrendingTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerGetTrendingTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = foodrecipe.Handler{
+		Service: suite.service,
+		Trending: config.Trending{
+			HalfLife:       24 * time.Hour,
+			Window:         168 * time.Hour,
+			FavoriteWeight: 5,
+			RatingWeight:   3,
+		},
+		Rating: config.Rating{PriorWeight: 10},
+	}
+
+	suite.server = func(url string) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if suite.claims != nil {
+				ctx.Set("claims", *suite.claims)
+			}
+		})
+		router.GET("/api/v1/food-recipes/trending", suite.handler.GetTrending)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceGetTrending = model.FoodRecipes{
+		{Model: gorm.Model{ID: 2}, Name: "Trending"},
+		{Model: gorm.Model{ID: 1}, Name: "Second"},
+	}
+	suite.errServiceGetTrending = nil
+	suite.claims = nil
+
+	suite.service.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes.WithFavoriteStates([]model.FavoriteState{{FoodRecipeID: recipes[0].ID, FavoriteCount: 3, IsFavorited: true}}), nil
+	})
+	suite.service.On("GetTrending", mock.Anything).Return(func(model.TrendingQuery) (model.FoodRecipes, error) {
+		return suite.respServiceGetTrending, suite.errServiceGetTrending
+	})
+}
+
+func (suite *HandlerGetTrendingTestSuite) TestResponseRecipesWithStatus200() {
+	response := suite.server("/api/v1/food-recipes/trending?limit=5")
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(suite.respServiceGetTrending.ToResponse(2))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "GetTrending", model.TrendingQuery{
+		Limit:             5,
+		HalfLife:          24 * time.Hour,
+		Window:            168 * time.Hour,
+		FavoriteWeight:    5,
+		RatingWeight:      3,
+		RatingPriorWeight: 10,
+	})
+}
+
+func (suite *HandlerGetTrendingTestSuite) TestUseDefaultLimit() {
+	response := suite.server("/api/v1/food-recipes/trending")
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "GetTrending", mock.MatchedBy(func(query model.TrendingQuery) bool {
+		return query.Limit == 10
+	}))
+}
+
+func (suite *HandlerGetTrendingTestSuite) TestResponseStatus400WhenLimitTooLarge() {
+	response := suite.server("/api/v1/food-recipes/trending?limit=51")
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetTrending", mock.Anything)
+}
+
+func (suite *HandlerGetTrendingTestSuite) TestResponseErrorWhenServiceGetTrending() {
+	suite.errServiceGetTrending = assert.AnError
+
+	response := suite.server("/api/v1/food-recipes/trending")
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
+func (suite *HandlerGetTrendingTestSuite) TestResponseFavoritesWhenAuthenticated() {
+	suite.claims = &model.Claims{ID: "UID"}
+
+	response := suite.server("/api/v1/food-recipes/trending")
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Contains(response.Body.String(), `"isFavorited":true,"favoriteCount":3`)
+	suite.service.AssertCalled(suite.T(), "WithFavorites", mock.Anything, *suite.claims)
+}
+
+func TestHandlerGetTrending(t *testing.T) {
+	suite.Run(t, new(HandlerGetTrendingTestSuite))
+}
+
 type HandlerUpdateTestSuite struct {
 	suite.Suite
 
@@ -470,6 +892,9 @@ type HandlerUpdateTestSuite struct {
 	handler foodrecipe.IHandler
 	service *MockIService
 
+	// Request header
+	ifMatch string
+
 	// Mock data
 	claims            model.Claims
 	respServiceUpdate model.FoodRecipe
@@ -488,6 +913,7 @@ func (suite *HandlerUpdateTestSuite) SetupTest() {
 	suite.handler = foodrecipe.Handler{
 		Service: suite.service,
 	}
+	suite.ifMatch = ""
 
 	suite.server = func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
 		// Create router
@@ -514,6 +940,10 @@ func (suite *HandlerUpdateTestSuite) SetupTest() {
 		)
 		suite.NoError(err)
 
+		if suite.ifMatch != "" {
+			request.Header.Set("If-Match", suite.ifMatch)
+		}
+
 		// Start testing server
 		router.ServeHTTP(recorder, request)
 
@@ -525,7 +955,10 @@ func (suite *HandlerUpdateTestSuite) SetupTest() {
 	}
 
 	suite.errServiceUpdate = nil
-	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(func(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error) {
+	suite.service.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes, nil
+	})
+	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
 		return suite.respServiceUpdate, suite.errServiceUpdate
 	})
 }
@@ -547,7 +980,7 @@ func (suite *HandlerUpdateTestSuite) TestResponseRecipeWithStatus200() {
 	suite.Equal(http.StatusOK, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
 
-	suite.service.AssertCalled(suite.T(), "Update", dto.FoodRecipeRequest{Name: "Name"}, 1, model.Claims{ID: "UID"})
+	suite.service.AssertCalled(suite.T(), "Update", dto.FoodRecipeRequest{Name: "Name"}, 1, model.IfMatch{}, model.Claims{ID: "UID"})
 }
 
 func (suite *HandlerUpdateTestSuite) TestErrorWhenRequestInvalid() {
@@ -562,7 +995,7 @@ func (suite *HandlerUpdateTestSuite) TestErrorWhenRequestInvalid() {
 	suite.Equal(http.StatusBadRequest, response.Code)
 	suite.Equal(`{"message":"EOF"}`, response.Body.String())
 
-	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *HandlerUpdateTestSuite) TestErrorWhenCallServiceUpdateRecipe() {
@@ -625,10 +1058,229 @@ func (suite *HandlerUpdateTestSuite) TestResponseStatusCode400() {
 	suite.service.AssertNotCalled(suite.T(), "GetRecipes")
 }
 
+func (suite *HandlerUpdateTestSuite) TestPassIfMatchToService() {
+	suite.ifMatch = `"2-a1b2", W/"3", "4"`
+	suite.respServiceUpdate = model.FoodRecipe{Name: "Name", Version: 5}
+
+	payload := strings.NewReader(`{"name":"Name"}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(suite.respServiceUpdate.CacheValidator().ETag(), response.Header().Get("ETag"))
+
+	suite.service.AssertCalled(suite.T(), "Update", dto.FoodRecipeRequest{Name: "Name"}, 1, model.IfMatch{Present: true, Versions: []uint{2, 4}}, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerUpdateTestSuite) TestResponseCurrentRecipeWithStatus412() {
+	suite.ifMatch = `"1"`
+	suite.respServiceUpdate = model.FoodRecipe{Name: "Current", Version: 2}
+	suite.errServiceUpdate = global.ErrPreconditionFailed
+
+	payload := strings.NewReader(`{"name":"Name"}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(dto.FoodRecipeResponse{Name: "Current"})
+
+	suite.Equal(http.StatusPreconditionFailed, response.Code)
+	suite.Equal(suite.respServiceUpdate.CacheValidator().ETag(), response.Header().Get("ETag"))
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerUpdateTestSuite) TestResponseStatusCode428WhenIfMatchRequired() {
+	suite.handler = foodrecipe.Handler{
+		Service: suite.service,
+		Config:  config.Concurrency{RequireIfMatch: true},
+	}
+
+	payload := strings.NewReader(`{"name":"Name"}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusPreconditionRequired, response.Code)
+	suite.Equal(`{"message":"precondition required"}`, response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestHandlerUpdate(t *testing.T) {
 	suite.Run(t, new(HandlerUpdateTestSuite))
 }
 
+type HandlerPatchTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler foodrecipe.IHandler
+	service *MockIService
+
+	// Mock data
+	respServicePatch model.FoodRecipe
+	errServicePatch  error
+
+	// Helper
+	server func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerPatchTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerPatchTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = foodrecipe.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		// Register route
+		router.PATCH("/api/v1/food-recipes/:id", suite.handler.Patch)
+
+		// Recoder
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(
+			http.MethodPatch,
+			"/api/v1/food-recipes/1",
+			payload,
+		)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServicePatch = model.FoodRecipe{
+		Name:    "Name",
+		Version: 2,
+	}
+
+	suite.errServicePatch = nil
+	suite.service.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes, nil
+	})
+	suite.service.On("Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+		return suite.respServicePatch, suite.errServicePatch
+	})
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseRecipeWithStatus200() {
+	payload := strings.NewReader(`{"imageUrl":null}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(dto.FoodRecipeResponse{Name: "Name"})
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(suite.respServicePatch.CacheValidator().ETag(), response.Header().Get("ETag"))
+	suite.Equal(string(expectedJson), response.Body.String())
+
+	suite.service.AssertCalled(suite.T(), "Patch", []byte(`{"imageUrl":null}`), 1, model.IfMatch{}, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerPatchTestSuite) TestErrorWhenPatchInvalid() {
+	suite.errServicePatch = global.ErrInvalidPatch
+
+	payload := strings.NewReader(`[]`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid merge patch"}`, response.Body.String())
+}
+
+func (suite *HandlerPatchTestSuite) TestValidationErrorsWhenServicePatchRecipe() {
+	suite.errServicePatch = make(validator.ValidationErrors, 0)
+
+	payload := strings.NewReader(`{"name":null}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (suite *HandlerPatchTestSuite) TestErrorFoebidden() {
+	suite.errServicePatch = global.ErrForbidden
+
+	payload := strings.NewReader(`{}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.Equal(`{"message":"forbidden"}`, response.Body.String())
+}
+
+func (suite *HandlerPatchTestSuite) TestErrorWhenCallServicePatchRecipe() {
+	suite.errServicePatch = assert.AnError
+
+	payload := strings.NewReader(`{}`)
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(payload, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseStatusCode401() {
+	response := suite.server(nil, nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.Equal(`{"message":"Unauthorized"}`, response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlerPatch(t *testing.T) {
+	suite.Run(t, new(HandlerPatchTestSuite))
+}
+
 type HandlerDeleteTestSuite struct {
 	suite.Suite
 
@@ -636,9 +1288,13 @@ type HandlerDeleteTestSuite struct {
 	handler foodrecipe.IHandler
 	service *MockIService
 
+	// Request header
+	ifMatch string
+
 	// Mock data
-	claims           model.Claims
-	errServiceDelete error
+	claims            model.Claims
+	respServiceDelete model.FoodRecipe
+	errServiceDelete  error
 
 	// Helper
 	server func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
@@ -653,6 +1309,7 @@ func (suite *HandlerDeleteTestSuite) SetupTest() {
 	suite.handler = foodrecipe.Handler{
 		Service: suite.service,
 	}
+	suite.ifMatch = ""
 
 	suite.server = func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
 		// Create router
@@ -679,16 +1336,21 @@ func (suite *HandlerDeleteTestSuite) SetupTest() {
 		)
 		suite.NoError(err)
 
+		if suite.ifMatch != "" {
+			request.Header.Set("If-Match", suite.ifMatch)
+		}
+
 		// Start testing server
 		router.ServeHTTP(recorder, request)
 
 		return recorder
 	}
 
+	suite.respServiceDelete = model.FoodRecipe{}
 	suite.errServiceDelete = nil
 
-	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(id int, claims model.Claims) error {
-		return suite.errServiceDelete
+	suite.service.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(func(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+		return suite.respServiceDelete, suite.errServiceDelete
 	})
 }
 
@@ -703,7 +1365,7 @@ func (suite *HandlerDeleteTestSuite) TestResponseWithStatus200() {
 	suite.Equal(http.StatusOK, response.Code)
 	suite.Equal(`{"message":"Recipe deleted successfully"}`, response.Body.String())
 
-	suite.service.AssertCalled(suite.T(), "Delete", 1, model.Claims{ID: "UID"})
+	suite.service.AssertCalled(suite.T(), "Delete", 1, model.IfMatch{}, model.Claims{ID: "UID"})
 }
 
 func (suite *HandlerDeleteTestSuite) TestErrorWhenCallServiceDeleteRecipe() {
@@ -746,6 +1408,44 @@ func (suite *HandlerDeleteTestSuite) TestResponseStatusCode400() {
 	suite.service.AssertNotCalled(suite.T(), "GetRecipes")
 }
 
+func (suite *HandlerDeleteTestSuite) TestResponseCurrentRecipeWithStatus412() {
+	suite.ifMatch = `"1"`
+	suite.respServiceDelete = model.FoodRecipe{Name: "Current", Version: 2}
+	suite.errServiceDelete = global.ErrPreconditionFailed
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(nil, &claims)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(dto.FoodRecipeResponse{Name: "Current"})
+
+	suite.Equal(http.StatusPreconditionFailed, response.Code)
+	suite.Equal(suite.respServiceDelete.CacheValidator().ETag(), response.Header().Get("ETag"))
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", 1, model.IfMatch{Present: true, Versions: []uint{1}}, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseStatusCode428WhenIfMatchRequired() {
+	suite.handler = foodrecipe.Handler{
+		Service: suite.service,
+		Config:  config.Concurrency{RequireIfMatch: true},
+	}
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(nil, &claims)
+
+	// Ensure close reader when terminated
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusPreconditionRequired, response.Code)
+	suite.Equal(`{"message":"precondition required"}`, response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestHandlerDelete(t *testing.T) {
 	suite.Run(t, new(HandlerDeleteTestSuite))
 }
@@ -199,6 +199,86 @@ func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *M
 	return _c
 }
 
+// GetTrending provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetTrending(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetTrending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrending'
+type MockIHandler_GetTrending_Call struct {
+	*mock.Call
+}
+
+// GetTrending is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetTrending(ctx interface{}) *MockIHandler_GetTrending_Call {
+	return &MockIHandler_GetTrending_Call{Call: _e.mock.On("GetTrending", ctx)}
+}
+
+func (_c *MockIHandler_GetTrending_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetTrending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetTrending_Call) Return() *MockIHandler_GetTrending_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetTrending_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetTrending_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Patch(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIHandler_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Patch(ctx interface{}) *MockIHandler_Patch_Call {
+	return &MockIHandler_Patch_Call{Call: _e.mock.On("Patch", ctx)}
+}
+
+func (_c *MockIHandler_Patch_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Patch_Call) Return() *MockIHandler_Patch_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Patch_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Patch_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) Update(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -239,6 +319,117 @@ func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// NewMockIViewRecorder creates a new instance of MockIViewRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIViewRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIViewRecorder {
+	mock := &MockIViewRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIViewRecorder is an autogenerated mock type for the IViewRecorder type
+type MockIViewRecorder struct {
+	mock.Mock
+}
+
+type MockIViewRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIViewRecorder) EXPECT() *MockIViewRecorder_Expecter {
+	return &MockIViewRecorder_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockIViewRecorder
+func (_mock *MockIViewRecorder) Close() {
+	_mock.Called()
+	return
+}
+
+// MockIViewRecorder_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockIViewRecorder_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockIViewRecorder_Expecter) Close() *MockIViewRecorder_Close_Call {
+	return &MockIViewRecorder_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockIViewRecorder_Close_Call) Run(run func()) *MockIViewRecorder_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIViewRecorder_Close_Call) Return() *MockIViewRecorder_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIViewRecorder_Close_Call) RunAndReturn(run func()) *MockIViewRecorder_Close_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Record provides a mock function for the type MockIViewRecorder
+func (_mock *MockIViewRecorder) Record(view model.RecipeView) bool {
+	ret := _mock.Called(view)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeView) bool); ok {
+		r0 = returnFunc(view)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockIViewRecorder_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockIViewRecorder_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - view model.RecipeView
+func (_e *MockIViewRecorder_Expecter) Record(view interface{}) *MockIViewRecorder_Record_Call {
+	return &MockIViewRecorder_Record_Call{Call: _e.mock.On("Record", view)}
+}
+
+func (_c *MockIViewRecorder_Record_Call) Run(run func(view model.RecipeView)) *MockIViewRecorder_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeView
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeView)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIViewRecorder_Record_Call) Return(b bool) *MockIViewRecorder_Record_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockIViewRecorder_Record_Call) RunAndReturn(run func(view model.RecipeView) bool) *MockIViewRecorder_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIRepository(t interface {
@@ -267,8 +458,8 @@ func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
 }
 
 // Count provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Count() (int64, error) {
-	ret := _mock.Called()
+func (_mock *MockIRepository) Count(foodRecipeQuery model.FoodRecipeQuery) (int64, error) {
+	ret := _mock.Called(foodRecipeQuery)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -276,16 +467,16 @@ func (_mock *MockIRepository) Count() (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (int64, error)); ok {
+		return returnFunc(foodRecipeQuery)
 	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) int64); ok {
+		r0 = returnFunc(foodRecipeQuery)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(foodRecipeQuery)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,13 +489,20 @@ type MockIRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *MockIRepository_Expecter) Count() *MockIRepository_Count_Call {
-	return &MockIRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIRepository_Expecter) Count(foodRecipeQuery interface{}) *MockIRepository_Count_Call {
+	return &MockIRepository_Count_Call{Call: _e.mock.On("Count", foodRecipeQuery)}
 }
 
-func (_c *MockIRepository_Count_Call) Run(run func()) *MockIRepository_Count_Call {
+func (_c *MockIRepository_Count_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -314,7 +512,7 @@ func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepositor
 	return _c
 }
 
-func (_c *MockIRepository_Count_Call) RunAndReturn(run func() (int64, error)) *MockIRepository_Count_Call {
+func (_c *MockIRepository_Count_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (int64, error)) *MockIRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -371,16 +569,16 @@ func (_c *MockIRepository_Create_Call) RunAndReturn(run func(recipe *model.FoodR
 }
 
 // Delete provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Delete(id int) error {
-	ret := _mock.Called(id)
+func (_mock *MockIRepository) Delete(id int, version uint, override *model.ModerationLog) error {
+	ret := _mock.Called(id, version, override)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, uint, *model.ModerationLog) error); ok {
+		r0 = returnFunc(id, version, override)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -394,18 +592,30 @@ type MockIRepository_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - id int
-func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
-	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+//   - version uint
+//   - override *model.ModerationLog
+func (_e *MockIRepository_Expecter) Delete(id interface{}, version interface{}, override interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id, version, override)}
 }
 
-func (_c *MockIRepository_Delete_Call) Run(run func(id int)) *MockIRepository_Delete_Call {
+func (_c *MockIRepository_Delete_Call) Run(run func(id int, version uint, override *model.ModerationLog)) *MockIRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 *model.ModerationLog
+		if args[2] != nil {
+			arg2 = args[2].(*model.ModerationLog)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -416,7 +626,7 @@ func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete
 	return _c
 }
 
-func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id int) error) *MockIRepository_Delete_Call {
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id int, version uint, override *model.ModerationLog) error) *MockIRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -543,142 +753,1460 @@ func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.Foo
 	return _c
 }
 
-// Update provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Update(recipe *model.FoodRecipe) error {
-	ret := _mock.Called(recipe)
+// GetByIDWithRating provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ratingPriorWeight)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetByIDWithRating")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
-		r0 = returnFunc(recipe)
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, float64) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ratingPriorWeight)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, float64) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ratingPriorWeight)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, float64) error); ok {
+		r1 = returnFunc(id, ratingPriorWeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockIRepository_Update_Call struct {
+// MockIRepository_GetByIDWithRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithRating'
+type MockIRepository_GetByIDWithRating_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - recipe *model.FoodRecipe
-func (_e *MockIRepository_Expecter) Update(recipe interface{}) *MockIRepository_Update_Call {
-	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", recipe)}
+// GetByIDWithRating is a helper method to define mock.On call
+//   - id int
+//   - ratingPriorWeight float64
+func (_e *MockIRepository_Expecter) GetByIDWithRating(id interface{}, ratingPriorWeight interface{}) *MockIRepository_GetByIDWithRating_Call {
+	return &MockIRepository_GetByIDWithRating_Call{Call: _e.mock.On("GetByIDWithRating", id, ratingPriorWeight)}
 }
 
-func (_c *MockIRepository_Update_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_Update_Call {
+func (_c *MockIRepository_GetByIDWithRating_Call) Run(run func(id int, ratingPriorWeight float64)) *MockIRepository_GetByIDWithRating_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.FoodRecipe
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(*model.FoodRecipe)
+			arg0 = args[0].(int)
+		}
+		var arg1 float64
+		if args[1] != nil {
+			arg1 = args[1].(float64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *MockIRepository_GetByIDWithRating_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIRepository_GetByIDWithRating_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIRepository_Update_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockIRepository_Update_Call {
+func (_c *MockIRepository_GetByIDWithRating_Call) RunAndReturn(run func(id int, ratingPriorWeight float64) (model.FoodRecipe, error)) *MockIRepository_GetByIDWithRating_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIService {
-	mock := &MockIService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIService is an autogenerated mock type for the IService type
-type MockIService struct {
-	mock.Mock
-}
-
-type MockIService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIService) EXPECT() *MockIService_Expecter {
-	return &MockIService_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockIService
-func (_mock *MockIService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
-	ret := _mock.Called(request, claims)
+// GetCacheValidator provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetCacheValidator() (model.CacheValidator, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for GetCacheValidator")
 	}
 
-	var r0 model.FoodRecipe
+	var r0 model.CacheValidator
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
-		return returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(0).(func() (model.CacheValidator, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
-		r0 = returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(0).(func() model.CacheValidator); ok {
+		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(model.FoodRecipe)
+		r0 = ret.Get(0).(model.CacheValidator)
 	}
-	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
-		r1 = returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIService_Create_Call struct {
+// MockIRepository_GetCacheValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidator'
+type MockIRepository_GetCacheValidator_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - request dto.FoodRecipeRequest
-//   - claims model.Claims
-func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
-	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+// GetCacheValidator is a helper method to define mock.On call
+func (_e *MockIRepository_Expecter) GetCacheValidator() *MockIRepository_GetCacheValidator_Call {
+	return &MockIRepository_GetCacheValidator_Call{Call: _e.mock.On("GetCacheValidator")}
 }
 
-func (_c *MockIService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIService_Create_Call {
+func (_c *MockIRepository_GetCacheValidator_Call) Run(run func()) *MockIRepository_GetCacheValidator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 dto.FoodRecipeRequest
-		if args[0] != nil {
-			arg0 = args[0].(dto.FoodRecipeRequest)
-		}
-		var arg1 model.Claims
-		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockIService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Create_Call {
-	_c.Call.Return(foodRecipe, err)
+func (_c *MockIRepository_GetCacheValidator_Call) Return(cacheValidator model.CacheValidator, err error) *MockIRepository_GetCacheValidator_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetCacheValidator_Call) RunAndReturn(run func() (model.CacheValidator, error)) *MockIRepository_GetCacheValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCacheValidatorByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetCacheValidatorByID(id int) (model.CacheValidator, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheValidatorByID")
+	}
+
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CacheValidator, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CacheValidator); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CacheValidator)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetCacheValidatorByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidatorByID'
+type MockIRepository_GetCacheValidatorByID_Call struct {
+	*mock.Call
+}
+
+// GetCacheValidatorByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) GetCacheValidatorByID(id interface{}) *MockIRepository_GetCacheValidatorByID_Call {
+	return &MockIRepository_GetCacheValidatorByID_Call{Call: _e.mock.On("GetCacheValidatorByID", id)}
+}
+
+func (_c *MockIRepository_GetCacheValidatorByID_Call) Run(run func(id int)) *MockIRepository_GetCacheValidatorByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetCacheValidatorByID_Call) Return(cacheValidator model.CacheValidator, err error) *MockIRepository_GetCacheValidatorByID_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetCacheValidatorByID_Call) RunAndReturn(run func(id int) (model.CacheValidator, error)) *MockIRepository_GetCacheValidatorByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavoriteStates provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetFavoriteStates(recipeIDs []uint, userID string) ([]model.FavoriteState, error) {
+	ret := _mock.Called(recipeIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavoriteStates")
+	}
+
+	var r0 []model.FavoriteState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint, string) ([]model.FavoriteState, error)); ok {
+		return returnFunc(recipeIDs, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint, string) []model.FavoriteState); ok {
+		r0 = returnFunc(recipeIDs, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FavoriteState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint, string) error); ok {
+		r1 = returnFunc(recipeIDs, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetFavoriteStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavoriteStates'
+type MockIRepository_GetFavoriteStates_Call struct {
+	*mock.Call
+}
+
+// GetFavoriteStates is a helper method to define mock.On call
+//   - recipeIDs []uint
+//   - userID string
+func (_e *MockIRepository_Expecter) GetFavoriteStates(recipeIDs interface{}, userID interface{}) *MockIRepository_GetFavoriteStates_Call {
+	return &MockIRepository_GetFavoriteStates_Call{Call: _e.mock.On("GetFavoriteStates", recipeIDs, userID)}
+}
+
+func (_c *MockIRepository_GetFavoriteStates_Call) Run(run func(recipeIDs []uint, userID string)) *MockIRepository_GetFavoriteStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetFavoriteStates_Call) Return(favoriteStates []model.FavoriteState, err error) *MockIRepository_GetFavoriteStates_Call {
+	_c.Call.Return(favoriteStates, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetFavoriteStates_Call) RunAndReturn(run func(recipeIDs []uint, userID string) ([]model.FavoriteState, error)) *MockIRepository_GetFavoriteStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrending provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetTrending(query model.TrendingQuery) (model.FoodRecipes, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrending")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) (model.FoodRecipes, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.TrendingQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetTrending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrending'
+type MockIRepository_GetTrending_Call struct {
+	*mock.Call
+}
+
+// GetTrending is a helper method to define mock.On call
+//   - query model.TrendingQuery
+func (_e *MockIRepository_Expecter) GetTrending(query interface{}) *MockIRepository_GetTrending_Call {
+	return &MockIRepository_GetTrending_Call{Call: _e.mock.On("GetTrending", query)}
+}
+
+func (_c *MockIRepository_GetTrending_Call) Run(run func(query model.TrendingQuery)) *MockIRepository_GetTrending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.TrendingQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.TrendingQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetTrending_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetTrending_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetTrending_Call) RunAndReturn(run func(query model.TrendingQuery) (model.FoodRecipes, error)) *MockIRepository_GetTrending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(recipe *model.FoodRecipe, override *model.ModerationLog) error {
+	ret := _mock.Called(recipe, override)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe, *model.ModerationLog) error); ok {
+		r0 = returnFunc(recipe, override)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+//   - override *model.ModerationLog
+func (_e *MockIRepository_Expecter) Update(recipe interface{}, override interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", recipe, override)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(recipe *model.FoodRecipe, override *model.ModerationLog)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		var arg1 *model.ModerationLog
+		if args[1] != nil {
+			arg1 = args[1].(*model.ModerationLog)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(recipe *model.FoodRecipe, override *model.ModerationLog) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIDifficultyService creates a new instance of MockIDifficultyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIDifficultyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIDifficultyService {
+	mock := &MockIDifficultyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIDifficultyService is an autogenerated mock type for the IDifficultyService type
+type MockIDifficultyService struct {
+	mock.Mock
+}
+
+type MockIDifficultyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIDifficultyService) EXPECT() *MockIDifficultyService_Expecter {
+	return &MockIDifficultyService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIDifficultyService
+func (_mock *MockIDifficultyService) Create(request dto.DifficultyRequest) (model.Difficulty, error) {
+	ret := _mock.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.DifficultyRequest) (model.Difficulty, error)); ok {
+		return returnFunc(request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.DifficultyRequest) model.Difficulty); ok {
+		r0 = returnFunc(request)
+	} else {
+		r0 = ret.Get(0).(model.Difficulty)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.DifficultyRequest) error); ok {
+		r1 = returnFunc(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDifficultyService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIDifficultyService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.DifficultyRequest
+func (_e *MockIDifficultyService_Expecter) Create(request interface{}) *MockIDifficultyService_Create_Call {
+	return &MockIDifficultyService_Create_Call{Call: _e.mock.On("Create", request)}
+}
+
+func (_c *MockIDifficultyService_Create_Call) Run(run func(request dto.DifficultyRequest)) *MockIDifficultyService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.DifficultyRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.DifficultyRequest)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDifficultyService_Create_Call) Return(difficulty model.Difficulty, err error) *MockIDifficultyService_Create_Call {
+	_c.Call.Return(difficulty, err)
+	return _c
+}
+
+func (_c *MockIDifficultyService_Create_Call) RunAndReturn(run func(request dto.DifficultyRequest) (model.Difficulty, error)) *MockIDifficultyService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIDifficultyService
+func (_mock *MockIDifficultyService) Get(query model.ReferenceDataQuery) (model.Difficulties, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Difficulties
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.ReferenceDataQuery) (model.Difficulties, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.ReferenceDataQuery) model.Difficulties); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Difficulties)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.ReferenceDataQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDifficultyService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIDifficultyService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - query model.ReferenceDataQuery
+func (_e *MockIDifficultyService_Expecter) Get(query interface{}) *MockIDifficultyService_Get_Call {
+	return &MockIDifficultyService_Get_Call{Call: _e.mock.On("Get", query)}
+}
+
+func (_c *MockIDifficultyService_Get_Call) Run(run func(query model.ReferenceDataQuery)) *MockIDifficultyService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.ReferenceDataQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.ReferenceDataQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDifficultyService_Get_Call) Return(difficulties model.Difficulties, err error) *MockIDifficultyService_Get_Call {
+	_c.Call.Return(difficulties, err)
+	return _c
+}
+
+func (_c *MockIDifficultyService_Get_Call) RunAndReturn(run func(query model.ReferenceDataQuery) (model.Difficulties, error)) *MockIDifficultyService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIDifficultyService
+func (_mock *MockIDifficultyService) GetByID(id int) (model.Difficulty, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.Difficulty, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.Difficulty); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.Difficulty)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDifficultyService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIDifficultyService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIDifficultyService_Expecter) GetByID(id interface{}) *MockIDifficultyService_GetByID_Call {
+	return &MockIDifficultyService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIDifficultyService_GetByID_Call) Run(run func(id int)) *MockIDifficultyService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDifficultyService_GetByID_Call) Return(difficulty model.Difficulty, err error) *MockIDifficultyService_GetByID_Call {
+	_c.Call.Return(difficulty, err)
+	return _c
+}
+
+func (_c *MockIDifficultyService_GetByID_Call) RunAndReturn(run func(id int) (model.Difficulty, error)) *MockIDifficultyService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockIDifficultyService
+func (_mock *MockIDifficultyService) Restore(id int) (model.Difficulty, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.Difficulty, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.Difficulty); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.Difficulty)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDifficultyService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockIDifficultyService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id int
+func (_e *MockIDifficultyService_Expecter) Restore(id interface{}) *MockIDifficultyService_Restore_Call {
+	return &MockIDifficultyService_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockIDifficultyService_Restore_Call) Run(run func(id int)) *MockIDifficultyService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDifficultyService_Restore_Call) Return(difficulty model.Difficulty, err error) *MockIDifficultyService_Restore_Call {
+	_c.Call.Return(difficulty, err)
+	return _c
+}
+
+func (_c *MockIDifficultyService_Restore_Call) RunAndReturn(run func(id int) (model.Difficulty, error)) *MockIDifficultyService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retire provides a mock function for the type MockIDifficultyService
+func (_mock *MockIDifficultyService) Retire(id int) (model.Difficulty, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retire")
+	}
+
+	var r0 model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.Difficulty, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.Difficulty); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.Difficulty)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDifficultyService_Retire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retire'
+type MockIDifficultyService_Retire_Call struct {
+	*mock.Call
+}
+
+// Retire is a helper method to define mock.On call
+//   - id int
+func (_e *MockIDifficultyService_Expecter) Retire(id interface{}) *MockIDifficultyService_Retire_Call {
+	return &MockIDifficultyService_Retire_Call{Call: _e.mock.On("Retire", id)}
+}
+
+func (_c *MockIDifficultyService_Retire_Call) Run(run func(id int)) *MockIDifficultyService_Retire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDifficultyService_Retire_Call) Return(difficulty model.Difficulty, err error) *MockIDifficultyService_Retire_Call {
+	_c.Call.Return(difficulty, err)
+	return _c
+}
+
+func (_c *MockIDifficultyService_Retire_Call) RunAndReturn(run func(id int) (model.Difficulty, error)) *MockIDifficultyService_Retire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIDifficultyService
+func (_mock *MockIDifficultyService) Update(request dto.DifficultyRequest, id int) (model.Difficulty, error) {
+	ret := _mock.Called(request, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.DifficultyRequest, int) (model.Difficulty, error)); ok {
+		return returnFunc(request, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.DifficultyRequest, int) model.Difficulty); ok {
+		r0 = returnFunc(request, id)
+	} else {
+		r0 = ret.Get(0).(model.Difficulty)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.DifficultyRequest, int) error); ok {
+		r1 = returnFunc(request, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDifficultyService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIDifficultyService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.DifficultyRequest
+//   - id int
+func (_e *MockIDifficultyService_Expecter) Update(request interface{}, id interface{}) *MockIDifficultyService_Update_Call {
+	return &MockIDifficultyService_Update_Call{Call: _e.mock.On("Update", request, id)}
+}
+
+func (_c *MockIDifficultyService_Update_Call) Run(run func(request dto.DifficultyRequest, id int)) *MockIDifficultyService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.DifficultyRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.DifficultyRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDifficultyService_Update_Call) Return(difficulty model.Difficulty, err error) *MockIDifficultyService_Update_Call {
+	_c.Call.Return(difficulty, err)
+	return _c
+}
+
+func (_c *MockIDifficultyService_Update_Call) RunAndReturn(run func(request dto.DifficultyRequest, id int) (model.Difficulty, error)) *MockIDifficultyService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockICookingDurationService creates a new instance of MockICookingDurationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICookingDurationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICookingDurationService {
+	mock := &MockICookingDurationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockICookingDurationService is an autogenerated mock type for the ICookingDurationService type
+type MockICookingDurationService struct {
+	mock.Mock
+}
+
+type MockICookingDurationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICookingDurationService) EXPECT() *MockICookingDurationService_Expecter {
+	return &MockICookingDurationService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) Create(request dto.CookingDurationRequest) (model.CookingDuration, error) {
+	ret := _mock.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CookingDurationRequest) (model.CookingDuration, error)); ok {
+		return returnFunc(request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CookingDurationRequest) model.CookingDuration); ok {
+		r0 = returnFunc(request)
+	} else {
+		r0 = ret.Get(0).(model.CookingDuration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CookingDurationRequest) error); ok {
+		r1 = returnFunc(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockICookingDurationService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.CookingDurationRequest
+func (_e *MockICookingDurationService_Expecter) Create(request interface{}) *MockICookingDurationService_Create_Call {
+	return &MockICookingDurationService_Create_Call{Call: _e.mock.On("Create", request)}
+}
+
+func (_c *MockICookingDurationService_Create_Call) Run(run func(request dto.CookingDurationRequest)) *MockICookingDurationService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CookingDurationRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CookingDurationRequest)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_Create_Call) Return(cookingDuration model.CookingDuration, err error) *MockICookingDurationService_Create_Call {
+	_c.Call.Return(cookingDuration, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_Create_Call) RunAndReturn(run func(request dto.CookingDurationRequest) (model.CookingDuration, error)) *MockICookingDurationService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) Get(query model.ReferenceDataQuery) (model.CookingDurations, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.CookingDurations
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.ReferenceDataQuery) (model.CookingDurations, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.ReferenceDataQuery) model.CookingDurations); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.CookingDurations)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.ReferenceDataQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockICookingDurationService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - query model.ReferenceDataQuery
+func (_e *MockICookingDurationService_Expecter) Get(query interface{}) *MockICookingDurationService_Get_Call {
+	return &MockICookingDurationService_Get_Call{Call: _e.mock.On("Get", query)}
+}
+
+func (_c *MockICookingDurationService_Get_Call) Run(run func(query model.ReferenceDataQuery)) *MockICookingDurationService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.ReferenceDataQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.ReferenceDataQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_Get_Call) Return(cookingDurations model.CookingDurations, err error) *MockICookingDurationService_Get_Call {
+	_c.Call.Return(cookingDurations, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_Get_Call) RunAndReturn(run func(query model.ReferenceDataQuery) (model.CookingDurations, error)) *MockICookingDurationService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) GetByID(id int) (model.CookingDuration, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CookingDuration, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CookingDuration); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CookingDuration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockICookingDurationService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockICookingDurationService_Expecter) GetByID(id interface{}) *MockICookingDurationService_GetByID_Call {
+	return &MockICookingDurationService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockICookingDurationService_GetByID_Call) Run(run func(id int)) *MockICookingDurationService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_GetByID_Call) Return(cookingDuration model.CookingDuration, err error) *MockICookingDurationService_GetByID_Call {
+	_c.Call.Return(cookingDuration, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_GetByID_Call) RunAndReturn(run func(id int) (model.CookingDuration, error)) *MockICookingDurationService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByMinutes provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) GetByMinutes(minutes int) (model.CookingDuration, error) {
+	ret := _mock.Called(minutes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByMinutes")
+	}
+
+	var r0 model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CookingDuration, error)); ok {
+		return returnFunc(minutes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CookingDuration); ok {
+		r0 = returnFunc(minutes)
+	} else {
+		r0 = ret.Get(0).(model.CookingDuration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(minutes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_GetByMinutes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByMinutes'
+type MockICookingDurationService_GetByMinutes_Call struct {
+	*mock.Call
+}
+
+// GetByMinutes is a helper method to define mock.On call
+//   - minutes int
+func (_e *MockICookingDurationService_Expecter) GetByMinutes(minutes interface{}) *MockICookingDurationService_GetByMinutes_Call {
+	return &MockICookingDurationService_GetByMinutes_Call{Call: _e.mock.On("GetByMinutes", minutes)}
+}
+
+func (_c *MockICookingDurationService_GetByMinutes_Call) Run(run func(minutes int)) *MockICookingDurationService_GetByMinutes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_GetByMinutes_Call) Return(cookingDuration model.CookingDuration, err error) *MockICookingDurationService_GetByMinutes_Call {
+	_c.Call.Return(cookingDuration, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_GetByMinutes_Call) RunAndReturn(run func(minutes int) (model.CookingDuration, error)) *MockICookingDurationService_GetByMinutes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) Restore(id int) (model.CookingDuration, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CookingDuration, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CookingDuration); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CookingDuration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockICookingDurationService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id int
+func (_e *MockICookingDurationService_Expecter) Restore(id interface{}) *MockICookingDurationService_Restore_Call {
+	return &MockICookingDurationService_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockICookingDurationService_Restore_Call) Run(run func(id int)) *MockICookingDurationService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_Restore_Call) Return(cookingDuration model.CookingDuration, err error) *MockICookingDurationService_Restore_Call {
+	_c.Call.Return(cookingDuration, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_Restore_Call) RunAndReturn(run func(id int) (model.CookingDuration, error)) *MockICookingDurationService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retire provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) Retire(id int) (model.CookingDuration, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retire")
+	}
+
+	var r0 model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CookingDuration, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CookingDuration); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CookingDuration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_Retire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retire'
+type MockICookingDurationService_Retire_Call struct {
+	*mock.Call
+}
+
+// Retire is a helper method to define mock.On call
+//   - id int
+func (_e *MockICookingDurationService_Expecter) Retire(id interface{}) *MockICookingDurationService_Retire_Call {
+	return &MockICookingDurationService_Retire_Call{Call: _e.mock.On("Retire", id)}
+}
+
+func (_c *MockICookingDurationService_Retire_Call) Run(run func(id int)) *MockICookingDurationService_Retire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_Retire_Call) Return(cookingDuration model.CookingDuration, err error) *MockICookingDurationService_Retire_Call {
+	_c.Call.Return(cookingDuration, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_Retire_Call) RunAndReturn(run func(id int) (model.CookingDuration, error)) *MockICookingDurationService_Retire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockICookingDurationService
+func (_mock *MockICookingDurationService) Update(request dto.CookingDurationRequest, id int) (model.CookingDuration, error) {
+	ret := _mock.Called(request, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CookingDurationRequest, int) (model.CookingDuration, error)); ok {
+		return returnFunc(request, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CookingDurationRequest, int) model.CookingDuration); ok {
+		r0 = returnFunc(request, id)
+	} else {
+		r0 = ret.Get(0).(model.CookingDuration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CookingDurationRequest, int) error); ok {
+		r1 = returnFunc(request, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICookingDurationService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockICookingDurationService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.CookingDurationRequest
+//   - id int
+func (_e *MockICookingDurationService_Expecter) Update(request interface{}, id interface{}) *MockICookingDurationService_Update_Call {
+	return &MockICookingDurationService_Update_Call{Call: _e.mock.On("Update", request, id)}
+}
+
+func (_c *MockICookingDurationService_Update_Call) Run(run func(request dto.CookingDurationRequest, id int)) *MockICookingDurationService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CookingDurationRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CookingDurationRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICookingDurationService_Update_Call) Return(cookingDuration model.CookingDuration, err error) *MockICookingDurationService_Update_Call {
+	_c.Call.Return(cookingDuration, err)
+	return _c
+}
+
+func (_c *MockICookingDurationService_Update_Call) RunAndReturn(run func(request dto.CookingDurationRequest, id int) (model.CookingDuration, error)) *MockICookingDurationService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIPreferenceService creates a new instance of MockIPreferenceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPreferenceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPreferenceService {
+	mock := &MockIPreferenceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPreferenceService is an autogenerated mock type for the IPreferenceService type
+type MockIPreferenceService struct {
+	mock.Mock
+}
+
+type MockIPreferenceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPreferenceService) EXPECT() *MockIPreferenceService_Expecter {
+	return &MockIPreferenceService_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockIPreferenceService
+func (_mock *MockIPreferenceService) Get(userID string) (model.UserPreferences, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.UserPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.UserPreferences, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.UserPreferences); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(model.UserPreferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPreferenceService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIPreferenceService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIPreferenceService_Expecter) Get(userID interface{}) *MockIPreferenceService_Get_Call {
+	return &MockIPreferenceService_Get_Call{Call: _e.mock.On("Get", userID)}
+}
+
+func (_c *MockIPreferenceService_Get_Call) Run(run func(userID string)) *MockIPreferenceService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPreferenceService_Get_Call) Return(userPreferences model.UserPreferences, err error) *MockIPreferenceService_Get_Call {
+	_c.Call.Return(userPreferences, err)
+	return _c
+}
+
+func (_c *MockIPreferenceService_Get_Call) RunAndReturn(run func(userID string) (model.UserPreferences, error)) *MockIPreferenceService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIPreferenceService
+func (_mock *MockIPreferenceService) Update(request dto.PreferencesRequest, claims model.Claims) (model.UserPreferences, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.UserPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.PreferencesRequest, model.Claims) (model.UserPreferences, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.PreferencesRequest, model.Claims) model.UserPreferences); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.UserPreferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.PreferencesRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPreferenceService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIPreferenceService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.PreferencesRequest
+//   - claims model.Claims
+func (_e *MockIPreferenceService_Expecter) Update(request interface{}, claims interface{}) *MockIPreferenceService_Update_Call {
+	return &MockIPreferenceService_Update_Call{Call: _e.mock.On("Update", request, claims)}
+}
+
+func (_c *MockIPreferenceService_Update_Call) Run(run func(request dto.PreferencesRequest, claims model.Claims)) *MockIPreferenceService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.PreferencesRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.PreferencesRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPreferenceService_Update_Call) Return(userPreferences model.UserPreferences, err error) *MockIPreferenceService_Update_Call {
+	_c.Call.Return(userPreferences, err)
+	return _c
+}
+
+func (_c *MockIPreferenceService_Update_Call) RunAndReturn(run func(request dto.PreferencesRequest, claims model.Claims) (model.UserPreferences, error)) *MockIPreferenceService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Create_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
@@ -687,44 +2215,253 @@ func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.FoodRecipe
 	return _c
 }
 
-// Delete provides a mock function for the type MockIService
-func (_mock *MockIService) Delete(id int, claims model.Claims) error {
-	ret := _mock.Called(id, claims)
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ifMatch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ifMatch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ifMatch, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(id, ifMatch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - ifMatch model.IfMatch
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(id interface{}, ifMatch interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, ifMatch, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(id int, ifMatch model.IfMatch, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.IfMatch
+		if args[1] != nil {
+			arg1 = args[1].(model.IfMatch)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Delete_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIService
+func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) int64); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
+		r2 = returnFunc(foodRecipeQuery)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIService_Expecter) Get(foodRecipeQuery interface{}) *MockIService_Get_Call {
+	return &MockIService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery)}
+}
+
+func (_c *MockIService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, err error) *MockIService_Get_Call {
+	_c.Call.Return(foodRecipes, n, err)
+	return _c
+}
+
+func (_c *MockIService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)) *MockIService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(id int) (model.FoodRecipe, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.FoodRecipe, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.FoodRecipe); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIService_Expecter) GetByID(id interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIService_GetByID_Call) Run(run func(id int)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int) (model.FoodRecipe, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDWithRating provides a mock function for the type MockIService
+func (_mock *MockIService) GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ratingPriorWeight)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for GetByIDWithRating")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
-		r0 = returnFunc(id, claims)
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, float64) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ratingPriorWeight)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, float64) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ratingPriorWeight)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, float64) error); ok {
+		r1 = returnFunc(id, ratingPriorWeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockIService_Delete_Call struct {
+// MockIService_GetByIDWithRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithRating'
+type MockIService_GetByIDWithRating_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// GetByIDWithRating is a helper method to define mock.On call
 //   - id int
-//   - claims model.Claims
-func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
-	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+//   - ratingPriorWeight float64
+func (_e *MockIService_Expecter) GetByIDWithRating(id interface{}, ratingPriorWeight interface{}) *MockIService_GetByIDWithRating_Call {
+	return &MockIService_GetByIDWithRating_Call{Call: _e.mock.On("GetByIDWithRating", id, ratingPriorWeight)}
 }
 
-func (_c *MockIService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIService_Delete_Call {
+func (_c *MockIService_GetByIDWithRating_Call) Run(run func(id int, ratingPriorWeight float64)) *MockIService_GetByIDWithRating_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
-		var arg1 model.Claims
+		var arg1 float64
 		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
+			arg1 = args[1].(float64)
 		}
 		run(
 			arg0,
@@ -734,66 +2471,173 @@ func (_c *MockIService_Delete_Call) Run(run func(id int, claims model.Claims)) *
 	return _c
 }
 
-func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockIService_GetByIDWithRating_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_GetByIDWithRating_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIService_Delete_Call {
+func (_c *MockIService_GetByIDWithRating_Call) RunAndReturn(run func(id int, ratingPriorWeight float64) (model.FoodRecipe, error)) *MockIService_GetByIDWithRating_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockIService
-func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
-	ret := _mock.Called(foodRecipeQuery)
+// GetCacheValidator provides a mock function for the type MockIService
+func (_mock *MockIService) GetCacheValidator() (model.CacheValidator, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GetCacheValidator")
+	}
+
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (model.CacheValidator, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() model.CacheValidator); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(model.CacheValidator)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetCacheValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidator'
+type MockIService_GetCacheValidator_Call struct {
+	*mock.Call
+}
+
+// GetCacheValidator is a helper method to define mock.On call
+func (_e *MockIService_Expecter) GetCacheValidator() *MockIService_GetCacheValidator_Call {
+	return &MockIService_GetCacheValidator_Call{Call: _e.mock.On("GetCacheValidator")}
+}
+
+func (_c *MockIService_GetCacheValidator_Call) Run(run func()) *MockIService_GetCacheValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIService_GetCacheValidator_Call) Return(cacheValidator model.CacheValidator, err error) *MockIService_GetCacheValidator_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIService_GetCacheValidator_Call) RunAndReturn(run func() (model.CacheValidator, error)) *MockIService_GetCacheValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCacheValidatorByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetCacheValidatorByID(id int) (model.CacheValidator, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheValidatorByID")
+	}
+
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CacheValidator, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CacheValidator); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CacheValidator)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetCacheValidatorByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidatorByID'
+type MockIService_GetCacheValidatorByID_Call struct {
+	*mock.Call
+}
+
+// GetCacheValidatorByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIService_Expecter) GetCacheValidatorByID(id interface{}) *MockIService_GetCacheValidatorByID_Call {
+	return &MockIService_GetCacheValidatorByID_Call{Call: _e.mock.On("GetCacheValidatorByID", id)}
+}
+
+func (_c *MockIService_GetCacheValidatorByID_Call) Run(run func(id int)) *MockIService_GetCacheValidatorByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetCacheValidatorByID_Call) Return(cacheValidator model.CacheValidator, err error) *MockIService_GetCacheValidatorByID_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIService_GetCacheValidatorByID_Call) RunAndReturn(run func(id int) (model.CacheValidator, error)) *MockIService_GetCacheValidatorByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrending provides a mock function for the type MockIService
+func (_mock *MockIService) GetTrending(query model.TrendingQuery) (model.FoodRecipes, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrending")
 	}
 
 	var r0 model.FoodRecipes
-	var r1 int64
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error)); ok {
-		return returnFunc(foodRecipeQuery)
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) (model.FoodRecipes, error)); ok {
+		return returnFunc(query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
-		r0 = returnFunc(foodRecipeQuery)
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.FoodRecipes)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) int64); ok {
-		r1 = returnFunc(foodRecipeQuery)
-	} else {
-		r1 = ret.Get(1).(int64)
-	}
-	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
-		r2 = returnFunc(foodRecipeQuery)
+	if returnFunc, ok := ret.Get(1).(func(model.TrendingQuery) error); ok {
+		r1 = returnFunc(query)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockIService_Get_Call struct {
+// MockIService_GetTrending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrending'
+type MockIService_GetTrending_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - foodRecipeQuery model.FoodRecipeQuery
-func (_e *MockIService_Expecter) Get(foodRecipeQuery interface{}) *MockIService_Get_Call {
-	return &MockIService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery)}
+// GetTrending is a helper method to define mock.On call
+//   - query model.TrendingQuery
+func (_e *MockIService_Expecter) GetTrending(query interface{}) *MockIService_GetTrending_Call {
+	return &MockIService_GetTrending_Call{Call: _e.mock.On("GetTrending", query)}
 }
 
-func (_c *MockIService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIService_Get_Call {
+func (_c *MockIService_GetTrending_Call) Run(run func(query model.TrendingQuery)) *MockIService_GetTrending_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.FoodRecipeQuery
+		var arg0 model.TrendingQuery
 		if args[0] != nil {
-			arg0 = args[0].(model.FoodRecipeQuery)
+			arg0 = args[0].(model.TrendingQuery)
 		}
 		run(
 			arg0,
@@ -802,79 +2646,97 @@ func (_c *MockIService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQu
 	return _c
 }
 
-func (_c *MockIService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, err error) *MockIService_Get_Call {
-	_c.Call.Return(foodRecipes, n, err)
+func (_c *MockIService_GetTrending_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetTrending_Call {
+	_c.Call.Return(foodRecipes, err)
 	return _c
 }
 
-func (_c *MockIService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)) *MockIService_Get_Call {
+func (_c *MockIService_GetTrending_Call) RunAndReturn(run func(query model.TrendingQuery) (model.FoodRecipes, error)) *MockIService_GetTrending_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockIService
-func (_mock *MockIService) GetByID(id int) (model.FoodRecipe, error) {
-	ret := _mock.Called(id)
+// Patch provides a mock function for the type MockIService
+func (_mock *MockIService) Patch(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(patch, id, ifMatch, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Patch")
 	}
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.FoodRecipe, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func([]byte, int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(patch, id, ifMatch, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.FoodRecipe); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func([]byte, int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(patch, id, ifMatch, claims)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func([]byte, int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(patch, id, ifMatch, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIService_GetByID_Call struct {
+// MockIService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIService_Patch_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Patch is a helper method to define mock.On call
+//   - patch []byte
 //   - id int
-func (_e *MockIService_Expecter) GetByID(id interface{}) *MockIService_GetByID_Call {
-	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - ifMatch model.IfMatch
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Patch(patch interface{}, id interface{}, ifMatch interface{}, claims interface{}) *MockIService_Patch_Call {
+	return &MockIService_Patch_Call{Call: _e.mock.On("Patch", patch, id, ifMatch, claims)}
 }
 
-func (_c *MockIService_GetByID_Call) Run(run func(id int)) *MockIService_GetByID_Call {
+func (_c *MockIService_Patch_Call) Run(run func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims)) *MockIService_Patch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 []byte
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].([]byte)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.IfMatch
+		if args[2] != nil {
+			arg2 = args[2].(model.IfMatch)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_GetByID_Call {
+func (_c *MockIService_Patch_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Patch_Call {
 	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int) (model.FoodRecipe, error)) *MockIService_GetByID_Call {
+func (_c *MockIService_Patch_Call) RunAndReturn(run func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Patch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Update provides a mock function for the type MockIService
-func (_mock *MockIService) Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error) {
-	ret := _mock.Called(request, id, claims)
+func (_mock *MockIService) Update(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, id, ifMatch, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -882,16 +2744,16 @@ func (_mock *MockIService) Update(request dto.FoodRecipeRequest, id int, claims
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.Claims) (model.FoodRecipe, error)); ok {
-		return returnFunc(request, id, claims)
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, id, ifMatch, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.Claims) model.FoodRecipe); ok {
-		r0 = returnFunc(request, id, claims)
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, id, ifMatch, claims)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, int, model.Claims) error); ok {
-		r1 = returnFunc(request, id, claims)
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(request, id, ifMatch, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -906,12 +2768,13 @@ type MockIService_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - request dto.FoodRecipeRequest
 //   - id int
+//   - ifMatch model.IfMatch
 //   - claims model.Claims
-func (_e *MockIService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockIService_Update_Call {
-	return &MockIService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
+func (_e *MockIService_Expecter) Update(request interface{}, id interface{}, ifMatch interface{}, claims interface{}) *MockIService_Update_Call {
+	return &MockIService_Update_Call{Call: _e.mock.On("Update", request, id, ifMatch, claims)}
 }
 
-func (_c *MockIService_Update_Call) Run(run func(request dto.FoodRecipeRequest, id int, claims model.Claims)) *MockIService_Update_Call {
+func (_c *MockIService_Update_Call) Run(run func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims)) *MockIService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 dto.FoodRecipeRequest
 		if args[0] != nil {
@@ -921,14 +2784,19 @@ func (_c *MockIService_Update_Call) Run(run func(request dto.FoodRecipeRequest,
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
-		var arg2 model.Claims
+		var arg2 model.IfMatch
 		if args[2] != nil {
-			arg2 = args[2].(model.Claims)
+			arg2 = args[2].(model.IfMatch)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -939,7 +2807,75 @@ func (_c *MockIService_Update_Call) Return(foodRecipe model.FoodRecipe, err erro
 	return _c
 }
 
-func (_c *MockIService_Update_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Update_Call {
+func (_c *MockIService_Update_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithFavorites provides a mock function for the type MockIService
+func (_mock *MockIService) WithFavorites(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(recipes, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipes, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(recipes, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipes, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(recipes, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipes, model.Claims) error); ok {
+		r1 = returnFunc(recipes, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_WithFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithFavorites'
+type MockIService_WithFavorites_Call struct {
+	*mock.Call
+}
+
+// WithFavorites is a helper method to define mock.On call
+//   - recipes model.FoodRecipes
+//   - claims model.Claims
+func (_e *MockIService_Expecter) WithFavorites(recipes interface{}, claims interface{}) *MockIService_WithFavorites_Call {
+	return &MockIService_WithFavorites_Call{Call: _e.mock.On("WithFavorites", recipes, claims)}
+}
+
+func (_c *MockIService_WithFavorites_Call) Run(run func(recipes model.FoodRecipes, claims model.Claims)) *MockIService_WithFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipes
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipes)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_WithFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_WithFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIService_WithFavorites_Call) RunAndReturn(run func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error)) *MockIService_WithFavorites_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,6 +1,10 @@
 package foodrecipe
 
 import (
+	"strings"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
 	"wongnok/internal/model"
 
 	"gorm.io/gorm"
@@ -10,10 +14,16 @@ import (
 type IRepository interface {
 	Create(recipe *model.FoodRecipe) error
 	Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, error)
-	Count() (int64, error)
+	Count(foodRecipeQuery model.FoodRecipeQuery) (int64, error)
 	GetByID(id int) (model.FoodRecipe, error)
-	Update(recipe *model.FoodRecipe) error
-	Delete(id int) error
+	GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error)
+	GetCacheValidator() (model.CacheValidator, error)
+	GetCacheValidatorByID(id int) (model.CacheValidator, error)
+	GetTrending(query model.TrendingQuery) (model.FoodRecipes, error)
+	GetFavoriteStates(recipeIDs []uint, userID string) ([]model.FavoriteState, error)
+	// Update และ Delete บันทึก override ใน transaction เดียวกัน ถ้าเป็น nil คือเจ้าของแก้เอง
+	Update(recipe *model.FoodRecipe, override *model.ModerationLog) error
+	Delete(id int, version uint, override *model.ModerationLog) error
 }
 
 type Repository struct {
@@ -34,10 +44,10 @@ func (repo Repository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, erro
 	var recipes = make(model.FoodRecipes, 0)
 
 	offset := (query.Page - 1) * query.Limit
-	db := repo.DB.Preload(clause.Associations)
+	db := filter(repo.DB.Preload(clause.Associations).Scopes(withWeightedRating(query.RatingPriorWeight)), query)
 
-	if query.Search != "" {
-		db = db.Where("name LIKE ?", "%"+query.Search+"%").Or("description LIKE ?", "%"+query.Search+"%")
+	if query.Sort == model.FoodRecipeSortRating {
+		db = db.Order("weighted_rating desc").Order("rating_count desc")
 	}
 
 	if err := db.Order("name asc").Limit(query.Limit).Offset(offset).Find(&recipes).Error; err != nil {
@@ -47,16 +57,90 @@ func (repo Repository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, erro
 	return recipes, nil
 }
 
-func (repo Repository) Count() (int64, error) {
+// weightedRatingSQL ค่าเฉลี่ยแบบ Bayesian เหมือนมี rating เพิ่มอีก prior weight อันที่ได้คะแนนเท่าค่าเฉลี่ยรวม
+// สูตรที่มี rating น้อยจึงอยู่ใกล้ค่าเฉลี่ยรวม และต้องมี rating มากพอจึงจะขึ้นไปได้สูง
+const weightedRatingSQL = `COALESCE(
+	(food_recipes.rating_sum + CAST(? AS float8) * rating_prior.mean) / NULLIF(food_recipes.rating_count + CAST(? AS float8), 0),
+	0
+) AS weighted_rating`
+
+// ratingPriorSQL ผลรวม rating ของทุกสูตรใช้ใน ETag ของหน้ารายละเอียดด้วย
+const ratingPriorSQL = `CROSS JOIN (
+	SELECT
+		COALESCE(SUM(rating_sum) / NULLIF(SUM(rating_count), 0), 0) AS mean,
+		CAST(COALESCE(SUM(rating_count), 0) AS bigint) AS total_count,
+		CAST(COALESCE(SUM(rating_sum), 0) AS float8) AS total_sum
+	FROM food_recipes
+	WHERE deleted_at IS NULL
+) AS rating_prior`
+
+const ratingPriorColumnsSQL = `rating_prior.total_count AS prior_rating_count, rating_prior.total_sum AS prior_rating_sum`
+
+func withWeightedRating(priorWeight float64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Select("food_recipes.*, "+weightedRatingSQL+", "+ratingPriorColumnsSQL, priorWeight, priorWeight).Joins(ratingPriorSQL)
+	}
+}
+
+func (repo Repository) Count(query model.FoodRecipeQuery) (int64, error) {
 	var count int64
 
-	if err := repo.DB.Model(&model.FoodRecipes{}).Count(&count).Error; err != nil {
+	if err := filter(repo.DB.Model(&model.FoodRecipes{}), query).Count(&count).Error; err != nil {
 		return 0, err
 	}
 
 	return count, nil
 }
 
+// filter เงื่อนไขที่ใช้ร่วมกันระหว่าง Get กับ Count เพื่อให้ total ตรงกับรายการ
+func filter(db *gorm.DB, query model.FoodRecipeQuery) *gorm.DB {
+	if query.Search != "" {
+		db = db.Where("(name LIKE ? OR description LIKE ?)", "%"+query.Search+"%", "%"+query.Search+"%")
+	}
+
+	if query.MaxTotalMinutes > 0 {
+		db = db.Where("total_minutes <= ?", query.MaxTotalMinutes)
+	}
+
+	// ตัดทั้ง recipe ที่ผู้เขียนระบุและที่เดาได้จากส่วนผสม
+	for _, allergen := range query.ExcludeAllergens {
+		db = db.Where("NOT (allergens @> ?::jsonb)", model.Labels{allergen})
+
+		if condition, args := inferredAllergenCondition(model.AllergenRules[allergen]); condition != "" {
+			db = db.Where("NOT ("+condition+")", args...)
+		}
+	}
+
+	if len(query.Diet) > 0 {
+		db = db.Where("diets @> ?::jsonb", model.Labels(query.Diet))
+	}
+
+	for _, ingredient := range query.ExcludeIngredients {
+		db = db.Where("strpos(lower(ingredient), lower(?)) = 0", ingredient)
+	}
+
+	return db.Scopes(helper.HideUsers(query.ViewerID, "food_recipes.user_id"))
+}
+
+// inferredAllergenCondition เงื่อนไขเดียวกับ AllergenRule.Matches แต่ตรวจใน database
+func inferredAllergenCondition(rule model.AllergenRule) (string, []any) {
+	text := "lower(ingredient)"
+	var exceptArgs []any
+	for _, except := range rule.Except {
+		text = "replace(" + text + ", ?, '')"
+		exceptArgs = append(exceptArgs, except)
+	}
+
+	var conditions []string
+	var args []any
+	for _, keyword := range rule.Keywords {
+		conditions = append(conditions, "strpos("+text+", ?) > 0")
+		args = append(append(args, exceptArgs...), keyword)
+	}
+
+	return strings.Join(conditions, " OR "), args
+}
+
 func (repo Repository) GetByID(id int) (model.FoodRecipe, error) {
 	var recipe model.FoodRecipe
 
@@ -67,15 +151,235 @@ func (repo Repository) GetByID(id int) (model.FoodRecipe, error) {
 	return recipe, nil
 }
 
-func (repo Repository) Update(recipe *model.FoodRecipe) error {
-	// update
-	if err := repo.DB.Model(&recipe).Updates(recipe).Error; err != nil {
+// GetByIDWithRating เหมือน GetByID แต่คำนวณ weighted rating แบบเดียวกับรายการ
+func (repo Repository) GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error) {
+	var recipe model.FoodRecipe
+
+	if err := repo.DB.Preload(clause.Associations).Scopes(withWeightedRating(ratingPriorWeight)).First(&recipe, id).Error; err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	return recipe, nil
+}
+
+// cacheValidatorRow ผลลัพธ์จาก query ที่ใช้สร้าง CacheValidator
+type cacheValidatorRow struct {
+	Version          uint
+	Count            int64
+	UpdatedAt        *time.Time
+	UserUpdatedAt    *time.Time
+	RatingCount      int64
+	RatingSum        float64
+	PriorRatingCount int64
+	PriorRatingSum   float64
+	RatingUpdatedAt  *time.Time
+}
+
+func (row cacheValidatorRow) toValidator() model.CacheValidator {
+	validator := model.CacheValidator{
+		Version:          row.Version,
+		Count:            row.Count,
+		RatingCount:      row.RatingCount,
+		RatingSum:        row.RatingSum,
+		PriorRatingCount: row.PriorRatingCount,
+		PriorRatingSum:   row.PriorRatingSum,
+	}
+
+	for _, modifiedAt := range []*time.Time{row.UpdatedAt, row.UserUpdatedAt, row.RatingUpdatedAt} {
+		if modifiedAt != nil {
+			validator.Observe(*modifiedAt)
+		}
+	}
+
+	return validator
+}
+
+func (repo Repository) GetCacheValidator() (model.CacheValidator, error) {
+	var row cacheValidatorRow
+
+	// ใช้ deleted_at ด้วย เพราะการลบ recipe ทำให้รายการเปลี่ยน
+	err := repo.DB.Raw(`SELECT
+		(SELECT COUNT(*) FROM food_recipes WHERE deleted_at IS NULL) AS count,
+		(SELECT MAX(GREATEST(updated_at, deleted_at)) FROM food_recipes) AS updated_at,
+		(SELECT MAX(updated_at) FROM users) AS user_updated_at,
+		(SELECT COUNT(*) FROM ratings WHERE deleted_at IS NULL) AS rating_count,
+		(SELECT COALESCE(SUM(score), 0) FROM ratings WHERE deleted_at IS NULL) AS rating_sum,
+		(SELECT MAX(GREATEST(updated_at, deleted_at)) FROM ratings) AS rating_updated_at`).Scan(&row).Error
+	if err != nil {
+		return model.CacheValidator{}, err
+	}
+
+	return row.toValidator(), nil
+}
+
+func (repo Repository) GetCacheValidatorByID(id int) (model.CacheValidator, error) {
+	var row cacheValidatorRow
+
+	// รวม rating ของทุกสูตรด้วย เพราะ weighted rating ในหน้ารายละเอียดเปลี่ยนตามค่าเฉลี่ยรวม
+	err := repo.DB.Model(&model.FoodRecipe{}).
+		Select(`food_recipes.version, food_recipes.updated_at, users.updated_at AS user_updated_at,
+			food_recipes.rating_count, food_recipes.rating_sum, food_recipes.rating_updated_at, `+ratingPriorColumnsSQL).
+		Joins("LEFT JOIN users ON users.id = food_recipes.user_id").
+		Joins(ratingPriorSQL).
+		Where("food_recipes.id = ?", id).
+		Take(&row).Error
+	if err != nil {
+		return model.CacheValidator{}, err
+	}
+
+	return row.toValidator(), nil
+}
+
+// trendingScoreSQL รวมคะแนนจากวิวรายชั่วโมง favorite และ rating
+// แต่ละรายการลดน้ำหนักลงครึ่งหนึ่งทุก half-life นับจากเวลาที่เกิด
+// favorites/ratings เก็บเวลาแบบไม่มี timezone จึงเทียบกับ LOCALTIMESTAMP
+const trendingScoreSQL = `
+SELECT activity.food_recipe_id, SUM(activity.weight * EXP(-LN(2) * activity.age_hours / CAST(@half_life AS float8))) AS score
+FROM (
+	SELECT food_recipe_id, views::float8 AS weight, EXTRACT(EPOCH FROM now() - hour) / 3600 AS age_hours
+	FROM recipe_view_hourly
+	WHERE hour >= now() - make_interval(secs => @window)
+	UNION ALL
+	SELECT food_recipe_id, CAST(@favorite_weight AS float8) AS weight, EXTRACT(EPOCH FROM LOCALTIMESTAMP - created_at) / 3600 AS age_hours
+	FROM favorites
+	WHERE deleted_at IS NULL AND created_at >= LOCALTIMESTAMP - make_interval(secs => @window)
+	UNION ALL
+	SELECT food_recipe_id, CAST(@rating_weight AS float8) * score / 5.0 AS weight, EXTRACT(EPOCH FROM LOCALTIMESTAMP - created_at) / 3600 AS age_hours
+	FROM ratings
+	WHERE deleted_at IS NULL AND created_at >= LOCALTIMESTAMP - make_interval(secs => @window)
+) AS activity
+JOIN food_recipes ON food_recipes.id = activity.food_recipe_id AND food_recipes.deleted_at IS NULL
+GROUP BY activity.food_recipe_id
+ORDER BY score DESC, activity.food_recipe_id DESC
+LIMIT @limit`
+
+// GetTrending คืน recipe เรียงตามคะแนน trending สูงไปต่ำ
+func (repo Repository) GetTrending(query model.TrendingQuery) (model.FoodRecipes, error) {
+	var scores []struct {
+		FoodRecipeID uint
+		Score        float64
+	}
+
+	err := repo.DB.Raw(trendingScoreSQL, map[string]any{
+		"half_life":       query.HalfLife.Hours(),
+		"window":          query.Window.Seconds(),
+		"favorite_weight": query.FavoriteWeight,
+		"rating_weight":   query.RatingWeight,
+		"limit":           query.Limit,
+	}).Scan(&scores).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var results = make(model.FoodRecipes, 0, len(scores))
+	if len(scores) == 0 {
+		return results, nil
+	}
+
+	ids := make([]uint, 0, len(scores))
+	for _, score := range scores {
+		ids = append(ids, score.FoodRecipeID)
+	}
+
+	var recipes model.FoodRecipes
+	err = repo.DB.Preload(clause.Associations).
+		Scopes(withWeightedRating(query.RatingPriorWeight)).
+		Find(&recipes, ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find ไม่รักษาลำดับของ id จึงเรียงตามคะแนนใหม่
+	byID := make(map[uint]model.FoodRecipe, len(recipes))
+	for _, recipe := range recipes {
+		byID[recipe.ID] = recipe
+	}
+	for _, id := range ids {
+		if recipe, ok := byID[id]; ok {
+			results = append(results, recipe)
+		}
+	}
+
+	return results, nil
+}
+
+func (repo Repository) Update(recipe *model.FoodRecipe, override *model.ModerationLog) error {
+	expected := recipe.Version
+	recipe.Version = expected + 1
+
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		// update เฉพาะเมื่อ version ใน database ยังเป็น version ที่อ่านมา
+		result := tx.Model(&recipe).Where("version = ?", expected).Updates(recipe)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return global.ErrPreconditionFailed
+		}
+
+		// Updates ข้าม field ที่เป็น zero value จึงต้องเขียน field ที่ล้างค่าได้ซ้ำอีกรอบ
+		err := tx.Model(&recipe).Updates(map[string]any{
+			"image_url":     recipe.ImageURL,
+			"prep_minutes":  recipe.PrepMinutes,
+			"cook_minutes":  recipe.CookMinutes,
+			"total_minutes": recipe.TotalMinutes,
+			"allergens":     recipe.Allergens,
+			"diets":         recipe.Diets,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return recordOverride(tx, override)
+	})
+	if err != nil {
+		recipe.Version = expected
 		return err
 	}
 
 	return repo.DB.Preload(clause.Associations).First(&recipe, recipe.ID).Error
 }
 
-func (repo Repository) Delete(id int) error {
-	return repo.DB.Delete(&model.FoodRecipes{}, id).Error
+func (repo Repository) Delete(id int, version uint, override *model.ModerationLog) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("version = ?", version).Delete(&model.FoodRecipes{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return global.ErrPreconditionFailed
+		}
+
+		return recordOverride(tx, override)
+	})
+}
+
+func recordOverride(tx *gorm.DB, override *model.ModerationLog) error {
+	if override == nil {
+		return nil
+	}
+
+	return tx.Create(override).Error
+}
+
+// GetFavoriteStates นับ favorite ของหลาย recipe ใน query เดียว recipe ที่ไม่มี favorite จะไม่อยู่ในผลลัพธ์
+func (repo Repository) GetFavoriteStates(recipeIDs []uint, userID string) ([]model.FavoriteState, error) {
+	var states []model.FavoriteState
+
+	if len(recipeIDs) == 0 {
+		return states, nil
+	}
+
+	err := repo.DB.Model(&model.Favorite{}).
+		Select("food_recipe_id, COUNT(*) AS favorite_count, BOOL_OR(user_id = ?) AS is_favorited", userID).
+		Where("food_recipe_id IN ?", recipeIDs).
+		Group("food_recipe_id").
+		Scan(&states).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 
 	"github.com/jackc/pgx/v5/pgconn"
@@ -112,6 +113,7 @@ func (suite *RepositoryCreateTestSuite) TestReturnRecipePointerWhenCreated() {
 	err := suite.repo.Create(&recipe)
 	suite.NoError(err)
 
+	maxMinutes := 10
 	expectedRecipe := model.FoodRecipe{
 		Model:             gorm.Model{ID: 2},
 		Name:              "Name",
@@ -120,21 +122,23 @@ func (suite *RepositoryCreateTestSuite) TestReturnRecipePointerWhenCreated() {
 		Instruction:       "Instruction",
 		CookingDurationID: 1,
 		CookingDuration: model.CookingDuration{
-			Model: gorm.Model{ID: 1},
-			Name:  "5 - 10",
+			Model:      gorm.Model{ID: 1},
+			Name:       "5 - 10",
+			MinMinutes: 5,
+			MaxMinutes: &maxMinutes,
 		},
 		DifficultyID: 1,
 		Difficulty: model.Difficulty{
 			Model: gorm.Model{ID: 1},
 			Name:  "Easy",
 		},
-		Ratings: model.Ratings{},
-		UserID:  "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+		UserID: "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
 		User: model.User{
 			ID:        "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
 			FirstName: "Demo",
 			LastName:  "Tester",
 		},
+		Version: 1,
 	}
 
 	recipe.CookingDuration.CreatedAt, recipe.CookingDuration.UpdatedAt = time.Time{}, time.Time{}
@@ -195,11 +199,12 @@ func (suite *RepositoryUpdateTestSuite) SetupTest() {
 
 func (suite *RepositoryUpdateTestSuite) TestUpdateRecipe() {
 	recipe := model.FoodRecipe{
-		Model: gorm.Model{ID: suite.recipe.ID},
-		Name:  "Update Name",
+		Model:   gorm.Model{ID: suite.recipe.ID},
+		Name:    "Update Name",
+		Version: suite.recipe.Version,
 	}
 
-	err := suite.repo.Update(&recipe)
+	err := suite.repo.Update(&recipe, nil)
 	suite.NoError(err)
 
 	var result model.FoodRecipe
@@ -207,11 +212,30 @@ func (suite *RepositoryUpdateTestSuite) TestUpdateRecipe() {
 	suite.NoError(err)
 
 	suite.Equal("Update Name", result.Name)
+	suite.Equal(suite.recipe.Version+1, result.Version)
+}
+
+func (suite *RepositoryUpdateTestSuite) TestErrorWhenVersionStale() {
+	recipe := model.FoodRecipe{
+		Model:   gorm.Model{ID: suite.recipe.ID},
+		Name:    "Update Name",
+		Version: suite.recipe.Version + 1,
+	}
+
+	err := suite.repo.Update(&recipe, nil)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+	suite.Equal(suite.recipe.Version+1, recipe.Version)
+
+	var result model.FoodRecipe
+	err = suite.db.First(&result, suite.recipe.ID).Error
+	suite.NoError(err)
+
+	suite.Equal("Name", result.Name)
 }
 
 func (suite *RepositoryUpdateTestSuite) TestErrorWhenUpdate() {
-	err := suite.repo.Update(&model.FoodRecipe{})
-	suite.ErrorIs(err, gorm.ErrMissingWhereClause)
+	err := suite.repo.Update(&model.FoodRecipe{}, nil)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
 }
 
 func TestRepositoryUpdate(t *testing.T) {
@@ -244,7 +268,7 @@ func (suite *RepositoryDeleteTestSuite) SetupTest() {
 }
 
 func (suite *RepositoryDeleteTestSuite) TestDeleteRecipe() {
-	err := suite.repo.Delete(int(suite.recipe.ID))
+	err := suite.repo.Delete(int(suite.recipe.ID), suite.recipe.Version, nil)
 	suite.NoError(err)
 
 	var result model.FoodRecipe
@@ -252,6 +276,49 @@ func (suite *RepositoryDeleteTestSuite) TestDeleteRecipe() {
 	suite.ErrorIs(err, gorm.ErrRecordNotFound)
 }
 
+func (suite *RepositoryDeleteTestSuite) TestErrorWhenVersionStale() {
+	err := suite.repo.Delete(int(suite.recipe.ID), suite.recipe.Version+1, nil)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+
+	var result model.FoodRecipe
+	err = suite.db.First(&result, suite.recipe.ID).Error
+	suite.NoError(err)
+}
+
+func (suite *RepositoryDeleteTestSuite) TestRecordOverride() {
+	override := &model.ModerationLog{
+		ActorID:      "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11",
+		Roles:        model.Labels{model.RoleModerator},
+		Action:       model.ModerationRecipeDelete,
+		FoodRecipeID: suite.recipe.ID,
+		OwnerID:      suite.recipe.UserID,
+	}
+
+	err := suite.repo.Delete(int(suite.recipe.ID), suite.recipe.Version, override)
+	suite.NoError(err)
+
+	var logs []model.ModerationLog
+	suite.NoError(suite.db.Find(&logs, "food_recipe_id = ?", suite.recipe.ID).Error)
+	suite.Len(logs, 1)
+	suite.Equal(model.Labels{model.RoleModerator}, logs[0].Roles)
+}
+
+func (suite *RepositoryDeleteTestSuite) TestNotRecordOverrideWhenVersionStale() {
+	override := &model.ModerationLog{
+		ActorID:      "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11",
+		Action:       model.ModerationRecipeDelete,
+		FoodRecipeID: suite.recipe.ID,
+		OwnerID:      suite.recipe.UserID,
+	}
+
+	err := suite.repo.Delete(int(suite.recipe.ID), suite.recipe.Version+1, override)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+
+	var count int64
+	suite.NoError(suite.db.Model(&model.ModerationLog{}).Where("food_recipe_id = ?", suite.recipe.ID).Count(&count).Error)
+	suite.Equal(int64(0), count)
+}
+
 func TestRepositoryDelete(t *testing.T) {
 	suite.Run(t, new(RepositoryDeleteTestSuite))
 }
@@ -330,10 +397,204 @@ func (suite *RepositoryGetTestSuite) TestGetRecipeSearch() {
 	suite.Contains(response[0].Name, foodRecipeQuery.Search)
 }
 
+func (suite *RepositoryGetTestSuite) TestGetRecipeMaxTotalMinutes() {
+	slow := model.FoodRecipe{
+		Name:              "Slow",
+		Description:       "Description",
+		Ingredient:        "Ingredient",
+		Instruction:       "Instruction",
+		CookMinutes:       45,
+		TotalMinutes:      45,
+		CookingDurationID: 3,
+		DifficultyID:      1,
+		UserID:            "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}
+	suite.NoError(suite.db.Create(&slow).Error)
+
+	response, err := suite.repo.Get(model.FoodRecipeQuery{Page: 1, Limit: 10, MaxTotalMinutes: 30})
+	suite.NoError(err)
+
+	suite.NotEmpty(response)
+	for _, recipe := range response {
+		suite.LessOrEqual(recipe.TotalMinutes, 30)
+		suite.NotEqual(slow.ID, recipe.ID)
+	}
+}
+
+func (suite *RepositoryGetTestSuite) TestGetRecipeLabelFilters() {
+	inferred := model.FoodRecipe{
+		Name:              "Satay",
+		Description:       "Description",
+		Ingredient:        "Pork, peanut sauce",
+		Instruction:       "Instruction",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		UserID:            "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}
+	suite.NoError(suite.db.Create(&inferred).Error)
+
+	declared := model.FoodRecipe{
+		Name:              "Salad",
+		Description:       "Description",
+		Ingredient:        "Lettuce",
+		Instruction:       "Instruction",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		Allergens:         model.Labels{model.AllergenPeanut},
+		Diets:             model.Labels{model.DietVegan},
+		UserID:            "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}
+	suite.NoError(suite.db.Create(&declared).Error)
+
+	response, err := suite.repo.Get(model.FoodRecipeQuery{Page: 1, Limit: 10, ExcludeAllergens: []string{model.AllergenPeanut}})
+	suite.NoError(err)
+
+	suite.NotEmpty(response)
+	for _, recipe := range response {
+		suite.NotEqual(inferred.ID, recipe.ID)
+		suite.NotEqual(declared.ID, recipe.ID)
+	}
+
+	response, err = suite.repo.Get(model.FoodRecipeQuery{Page: 1, Limit: 10, Diet: []string{model.DietVegan}})
+	suite.NoError(err)
+
+	suite.Equal(1, len(response))
+	suite.Equal(declared.ID, response[0].ID)
+	suite.Equal(model.Labels{model.DietVegan}, response[0].Diets)
+}
+
+func (suite *RepositoryGetTestSuite) TestGetRecipeExcludeIngredients() {
+	disliked := model.FoodRecipe{
+		Name:              "Tom Yum",
+		Description:       "Description",
+		Ingredient:        "Shrimp, CILANTRO, lime",
+		Instruction:       "Instruction",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		UserID:            "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}
+	suite.NoError(suite.db.Create(&disliked).Error)
+
+	query := model.FoodRecipeQuery{Page: 1, Limit: 10, ExcludeIngredients: []string{"Cilantro"}}
+
+	response, err := suite.repo.Get(query)
+	suite.NoError(err)
+
+	suite.NotEmpty(response)
+	for _, recipe := range response {
+		suite.NotEqual(disliked.ID, recipe.ID)
+	}
+
+	total, err := suite.repo.Count(query)
+	suite.NoError(err)
+
+	unfiltered, err := suite.repo.Count(model.FoodRecipeQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+	suite.Equal(unfiltered-1, total)
+}
+
 func TestRepositoryGet(t *testing.T) {
 	suite.Run(t, new(RepositoryGetTestSuite))
 }
 
+type RepositoryGetWeightedRatingTestSuite struct {
+	RepositoryTestSuite
+
+	single  model.FoodRecipe
+	popular model.FoodRecipe
+	poor    model.FoodRecipe
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) SetupSuite() {
+	suite.RepositoryTestSuite.SetupSuite()
+	suite.RepositoryTestSuite.SetupTest()
+	defer suite.RepositoryTestSuite.TearDownTest()
+
+	newRecipe := func(name string, count int64, sum float64) model.FoodRecipe {
+		recipe := model.FoodRecipe{
+			Name:              name,
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+			UserID:            "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+			RatingSummary:     model.RatingSummary{RatingCount: count, RatingSum: sum},
+		}
+		suite.NoError(suite.db.Create(&recipe).Error)
+
+		return recipe
+	}
+
+	// ค่าเฉลี่ยรวม (8 + 5 + 960 + 300) / 303 ≈ 4.2 รวมกับ Omlet ที่มี 2 rating ผลรวม 8
+	suite.single = newRecipe("Single", 1, 5)
+	suite.popular = newRecipe("Popular", 200, 960)
+	suite.poor = newRecipe("Poor", 100, 300)
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) query(priorWeight float64) model.FoodRecipeQuery {
+	return model.FoodRecipeQuery{Page: 1, Limit: 10, Sort: model.FoodRecipeSortRating, RatingPriorWeight: priorWeight}
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) ids(recipes model.FoodRecipes) []uint {
+	var ids []uint
+	for _, recipe := range recipes {
+		ids = append(ids, recipe.ID)
+	}
+
+	return ids
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) TestSortByWeightedRating() {
+	recipes, err := suite.repo.Get(suite.query(10))
+	suite.NoError(err)
+
+	// rating เดียว 5 ดาวไม่ชนะสูตรที่มี 200 rating เฉลี่ย 4.8
+	suite.Equal([]uint{suite.popular.ID, suite.single.ID, 1, suite.poor.ID}, suite.ids(recipes))
+	suite.InDelta((960+10*1273.0/303)/210, recipes[0].WeightedRating, 0.0001)
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) TestSortByAverageWhenNoPrior() {
+	recipes, err := suite.repo.Get(suite.query(0))
+	suite.NoError(err)
+
+	suite.Equal([]uint{suite.single.ID, suite.popular.ID, 1, suite.poor.ID}, suite.ids(recipes))
+	suite.Equal(5.0, recipes[0].WeightedRating)
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) TestSortByNameByDefault() {
+	query := suite.query(10)
+	query.Sort = ""
+
+	recipes, err := suite.repo.Get(query)
+	suite.NoError(err)
+
+	suite.Equal([]uint{1, suite.poor.ID, suite.popular.ID, suite.single.ID}, suite.ids(recipes))
+	suite.NotZero(recipes[0].WeightedRating)
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) TestGetByIDWithRating() {
+	recipe, err := suite.repo.GetByIDWithRating(int(suite.popular.ID), 10)
+	suite.NoError(err)
+
+	// ค่าเดียวกับที่รายการคำนวณได้
+	suite.Equal(suite.popular.ID, recipe.ID)
+	suite.Equal("Popular", recipe.Name)
+	suite.InDelta((960+10*1273.0/303)/210, recipe.WeightedRating, 0.0001)
+	suite.NotZero(recipe.Difficulty.ID)
+}
+
+func (suite *RepositoryGetWeightedRatingTestSuite) TestErrorGetByIDWithRating() {
+	recipe, err := suite.repo.GetByIDWithRating(99999, 10)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.Empty(recipe)
+}
+
+func TestRepositoryGetWeightedRating(t *testing.T) {
+	suite.Run(t, new(RepositoryGetWeightedRatingTestSuite))
+}
+
 type RepositoryCountTestSuite struct {
 	RepositoryTestSuite
 
@@ -360,12 +621,19 @@ func (suite *RepositoryCountTestSuite) SetupTest() {
 
 func (suite *RepositoryCountTestSuite) TestCount() {
 
-	count, err := suite.repo.Count()
+	count, err := suite.repo.Count(model.FoodRecipeQuery{})
 	suite.NoError(err)
 
 	suite.Equal(int64(2), count)
 }
 
+func (suite *RepositoryCountTestSuite) TestCountWithFilter() {
+	count, err := suite.repo.Count(model.FoodRecipeQuery{Search: "mle"})
+	suite.NoError(err)
+
+	suite.Equal(int64(1), count)
+}
+
 func TestRepositoryCount(t *testing.T) {
 	suite.Run(t, new(RepositoryCountTestSuite))
 }
@@ -410,6 +678,166 @@ func (suite *RepositoryGetByIDTestSuite) TestErrorGetByID() {
 	suite.ErrorIs(err, gorm.ErrRecordNotFound)
 }
 
+func (suite *RepositoryGetByIDTestSuite) TestGetCacheValidatorByIDMatchesRecipe() {
+	recipe, err := suite.repo.GetByIDWithRating(1, 10)
+	suite.NoError(err)
+
+	validator, err := suite.repo.GetCacheValidatorByID(1)
+	suite.NoError(err)
+
+	suite.Equal(recipe.Version, validator.Version)
+	suite.Equal(recipe.CacheValidator().ETag(), validator.ETag())
+}
+
+func (suite *RepositoryGetByIDTestSuite) TestGetCacheValidatorByIDChangesWhenOtherRecipeRated() {
+	before, err := suite.repo.GetCacheValidatorByID(1)
+	suite.NoError(err)
+
+	err = suite.db.Model(&suite.recipe).Updates(map[string]any{"rating_count": 1, "rating_sum": 5}).Error
+	suite.NoError(err)
+
+	after, err := suite.repo.GetCacheValidatorByID(1)
+	suite.NoError(err)
+
+	suite.NotEqual(before.ETag(), after.ETag())
+
+	recipe, err := suite.repo.GetByIDWithRating(1, 10)
+	suite.NoError(err)
+
+	suite.Equal(recipe.CacheValidator().ETag(), after.ETag())
+}
+
+func (suite *RepositoryGetByIDTestSuite) TestErrorGetCacheValidatorByID() {
+	validator, err := suite.repo.GetCacheValidatorByID(99)
+
+	suite.Equal(model.CacheValidator{}, validator)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *RepositoryGetByIDTestSuite) TestGetCacheValidatorChangesWhenRecipeDeleted() {
+	before, err := suite.repo.GetCacheValidator()
+	suite.NoError(err)
+	suite.Equal(int64(2), before.Count)
+
+	err = suite.repo.Delete(int(suite.recipe.ID), suite.recipe.Version, nil)
+	suite.NoError(err)
+
+	after, err := suite.repo.GetCacheValidator()
+	suite.NoError(err)
+
+	suite.Equal(int64(1), after.Count)
+	suite.NotEqual(before.ETag(), after.ETag())
+}
+
 func TestRepositoryGetByID(t *testing.T) {
 	suite.Run(t, new(RepositoryGetByIDTestSuite))
 }
+
+type RepositoryGetTrendingTestSuite struct {
+	RepositoryTestSuite
+
+	recipe model.FoodRecipe
+	query  model.TrendingQuery
+}
+
+func (suite *RepositoryGetTrendingTestSuite) SetupTest() {
+	// Super
+	suite.RepositoryTestSuite.SetupTest()
+
+	suite.recipe = model.FoodRecipe{
+		Name:              "Trending",
+		Description:       "Description",
+		Ingredient:        "Ingredient",
+		Instruction:       "Instruction",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		UserID:            "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}
+	suite.NoError(suite.db.Create(&suite.recipe).Error)
+
+	suite.query = model.TrendingQuery{
+		Limit:          10,
+		HalfLife:       24 * time.Hour,
+		Window:         168 * time.Hour,
+		FavoriteWeight: 5,
+		RatingWeight:   3,
+	}
+
+	// recipe 1 มี rating จาก init-db (5 กับ 3 คะแนน) และวิวเก่า 2 วัน
+	// recipe ใหม่มีวิวในชั่วโมงนี้ คะแนนจึงสูงกว่า
+	err := suite.db.Exec(
+		`INSERT INTO recipe_view_hourly (food_recipe_id, hour, views) VALUES
+		(1, date_trunc('hour', now()) - interval '48 hours', 10),
+		(?, date_trunc('hour', now()), 10)`,
+		suite.recipe.ID,
+	).Error
+	suite.NoError(err)
+}
+
+// container ใช้ร่วมกันทั้ง suite จึงต้องลบข้อมูลของแต่ละ test ออก
+func (suite *RepositoryGetTrendingTestSuite) TearDownTest() {
+	suite.NoError(suite.db.Exec("DELETE FROM recipe_view_hourly").Error)
+	suite.NoError(suite.db.Exec("DELETE FROM favorites").Error)
+	suite.NoError(suite.db.Unscoped().Delete(&suite.recipe).Error)
+
+	// Super
+	suite.RepositoryTestSuite.TearDownTest()
+}
+
+func (suite *RepositoryGetTrendingTestSuite) TestReturnRecipesOrderedByDecayedScore() {
+	recipes, err := suite.repo.GetTrending(suite.query)
+	suite.NoError(err)
+
+	suite.Len(recipes, 2)
+	suite.Equal(suite.recipe.ID, recipes[0].ID)
+	suite.Equal(uint(1), recipes[1].ID)
+	suite.Equal("Easy", recipes[1].Difficulty.Name)
+}
+
+func (suite *RepositoryGetTrendingTestSuite) TestCountFavoritesAndLimit() {
+	err := suite.db.Exec(
+		`INSERT INTO favorites (food_recipe_id, user_id, created_at, updated_at)
+		VALUES (1, 'user-1', LOCALTIMESTAMP, LOCALTIMESTAMP)`,
+	).Error
+	suite.NoError(err)
+
+	suite.query.Limit = 1
+
+	recipes, err := suite.repo.GetTrending(suite.query)
+	suite.NoError(err)
+
+	suite.Len(recipes, 1)
+	suite.Equal(uint(1), recipes[0].ID)
+}
+
+func TestRepositoryGetTrending(t *testing.T) {
+	suite.Run(t, new(RepositoryGetTrendingTestSuite))
+}
+
+type RepositoryGetFavoriteStatesTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryGetFavoriteStatesTestSuite) TestCountFavoritesOfViewer() {
+	err := suite.db.Exec(
+		`INSERT INTO favorites (food_recipe_id, user_id, created_at, updated_at, deleted_at) VALUES
+		(1, 'viewer', LOCALTIMESTAMP, LOCALTIMESTAMP, NULL),
+		(1, 'other', LOCALTIMESTAMP, LOCALTIMESTAMP, NULL),
+		(1, 'removed', LOCALTIMESTAMP, LOCALTIMESTAMP, LOCALTIMESTAMP)`,
+	).Error
+	suite.NoError(err)
+
+	states, err := suite.repo.GetFavoriteStates([]uint{1, 2}, "viewer")
+	suite.NoError(err)
+
+	// recipe 2 ไม่มี favorite จึงไม่อยู่ในผลลัพธ์ และแถวที่ลบแล้วไม่นับ
+	suite.Equal([]model.FavoriteState{{FoodRecipeID: 1, FavoriteCount: 2, IsFavorited: true}}, states)
+
+	states, err = suite.repo.GetFavoriteStates([]uint{1}, "removed")
+	suite.NoError(err)
+	suite.False(states[0].IsFavorited)
+}
+
+func TestRepositoryGetFavoriteStates(t *testing.T) {
+	suite.Run(t, new(RepositoryGetFavoriteStatesTestSuite))
+}
@@ -1,30 +1,54 @@
 package foodrecipe
 
 import (
+	"wongnok/internal/cookingduration"
+	"wongnok/internal/difficulty"
 	"wongnok/internal/global"
+	"wongnok/internal/helper"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
+	"wongnok/internal/preference"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
+type IDifficultyService difficulty.IService
+
+type ICookingDurationService cookingduration.IService
+
+type IPreferenceService preference.IService
+
 type IService interface {
 	Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)
 	Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)
 	GetByID(id int) (model.FoodRecipe, error)
-	Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error)
-	Delete(id int, claims model.Claims) error
+	// GetByIDWithRating ใช้กับหน้ารายละเอียด ซึ่งแสดง weighted rating เหมือนรายการ
+	GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error)
+	GetCacheValidator() (model.CacheValidator, error)
+	GetCacheValidatorByID(id int) (model.CacheValidator, error)
+	GetTrending(query model.TrendingQuery) (model.FoodRecipes, error)
+	// WithFavorites เติม isFavorited และ favoriteCount ตามผู้ใช้ใน claims
+	WithFavorites(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error)
+	Update(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)
+	Patch(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)
+	Delete(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)
 }
 
 type Service struct {
-	Repository IRepository
+	Repository             IRepository
+	DifficultyService      IDifficultyService
+	CookingDurationService ICookingDurationService
+	PreferenceService      IPreferenceService
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
-		Repository: NewRepository(db),
+		Repository:             NewRepository(db),
+		DifficultyService:      difficulty.NewService(db),
+		CookingDurationService: cookingduration.NewService(db),
+		PreferenceService:      preference.NewService(db),
 	}
 }
 
@@ -35,6 +59,10 @@ func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims
 	}
 
 	var recipe model.FoodRecipe
+	if err := service.checkReferences(&request, recipe); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
 	recipe = recipe.FromRequest(request, claims)
 
 	if err := service.Repository.Create(&recipe); err != nil {
@@ -44,8 +72,18 @@ func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims
 	return recipe, nil
 }
 
+// Get ผู้ใช้ที่ login ได้ตัวกรองและการเรียงจาก preferences ในช่องที่ query ไม่ได้ระบุ
 func (service Service) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
-	total, err := service.Repository.Count()
+	if foodRecipeQuery.ViewerID != "" && !foodRecipeQuery.IgnorePreferences {
+		preferences, err := service.PreferenceService.Get(foodRecipeQuery.ViewerID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		foodRecipeQuery = foodRecipeQuery.WithPreferences(preferences)
+	}
+
+	total, err := service.Repository.Count(foodRecipeQuery)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -71,26 +109,128 @@ func (service Service) GetByID(id int) (model.FoodRecipe, error) {
 	return results, nil
 }
 
-func (service Service) Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error) {
+func (service Service) GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error) {
+	results, err := service.Repository.GetByIDWithRating(id, ratingPriorWeight)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	results = results.CalculateAverageRating()
+
+	return results, nil
+}
+
+func (service Service) GetCacheValidator() (model.CacheValidator, error) {
+	return service.Repository.GetCacheValidator()
+}
+
+func (service Service) GetCacheValidatorByID(id int) (model.CacheValidator, error) {
+	return service.Repository.GetCacheValidatorByID(id)
+}
+
+func (service Service) GetTrending(query model.TrendingQuery) (model.FoodRecipes, error) {
+	results, err := service.Repository.GetTrending(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results = results.CalculateAverageRatings()
+
+	return results, nil
+}
+
+func (service Service) WithFavorites(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+	recipeIDs := make([]uint, 0, len(recipes))
+	for _, recipe := range recipes {
+		recipeIDs = append(recipeIDs, recipe.ID)
+	}
+
+	states, err := service.Repository.GetFavoriteStates(recipeIDs, claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "find favorites")
+	}
+
+	return recipes.WithFavoriteStates(states), nil
+}
+
+func (service Service) Update(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
 	validate := validator.New()
 	if err := validate.Struct(request); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "request invalid")
 	}
 
+	recipe, err := service.findForUpdate(id, ifMatch, claims)
+	if err != nil {
+		return recipe, err
+	}
+
+	return service.save(recipe, request, claims)
+}
+
+func (service Service) Patch(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.findForUpdate(id, ifMatch, claims)
+	if err != nil {
+		return recipe, err
+	}
+
+	// merge patch เข้ากับข้อมูลปัจจุบันแล้วค่อย validate ผลลัพธ์
+	request := recipe.ToRequest()
+	if err := helper.MergePatch(&request, patch); err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "request invalid")
+	}
+
+	if request.CookingDurationID != recipe.CookingDurationID &&
+		request.PrepMinutes != nil && *request.PrepMinutes == recipe.PrepMinutes &&
+		request.CookMinutes != nil && *request.CookMinutes == recipe.CookMinutes {
+		// patch เปลี่ยนแค่หมวดเวลา ให้ประมาณนาทีจากหมวดใหม่แทนการคำนวณหมวดจากนาทีเดิม
+		request.PrepMinutes = nil
+		request.CookMinutes = nil
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "request invalid")
+	}
+
+	return service.save(recipe, request, claims)
+}
+
+func (service Service) findForUpdate(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
 	recipe, err := service.Repository.GetByID(id)
 	if err != nil {
 		// กรณีไม่พบ id ที่ต้องการ update
 		return model.FoodRecipe{}, errors.Wrap(err, "find recipe")
 	}
 
-	if recipe.UserID != claims.ID {
-		// กรณี user ที่ login ไม่ตรงกับ user ที่สร้าง recipe
+	if recipe.UserID != claims.ID && !claims.Can(model.PermissionEditAnyRecipe) {
+		// กรณี user ที่ login ไม่ตรงกับ user ที่สร้าง recipe และไม่มี role ที่แก้ของผู้อื่นได้
 		return model.FoodRecipe{}, global.ErrForbidden
 	}
 
-	recipe = recipe.FromRequest(request, claims)
+	if !ifMatch.Matches(recipe.Version) {
+		// กรณี client แก้ไขจาก version เก่า ส่ง recipe ปัจจุบันกลับไปให้
+		return recipe.CalculateAverageRating(), global.ErrPreconditionFailed
+	}
+
+	return recipe, nil
+}
+
+func (service Service) save(recipe model.FoodRecipe, request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	if err := service.checkReferences(&request, recipe); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	override := model.RecipeOverride(recipe, claims, model.ModerationRecipeUpdate)
+
+	// ผู้ดูแลแก้แทนไม่เปลี่ยนเจ้าของ recipe
+	recipe = recipe.FromRequest(request, model.Claims{ID: recipe.UserID})
+
+	if err := service.Repository.Update(&recipe, override); err != nil {
+		if errors.Is(err, global.ErrPreconditionFailed) {
+			// มีคนแก้ไขตัดหน้าระหว่างที่อ่านกับเขียน
+			return service.current(int(recipe.ID))
+		}
 
-	if err := service.Repository.Update(&recipe); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "update recipe")
 	}
 
@@ -99,18 +239,121 @@ func (service Service) Update(request dto.FoodRecipeRequest, id int, claims mode
 	return recipe, nil
 }
 
-func (service Service) Delete(id int, claims model.Claims) error {
+func (service Service) Delete(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
 	recipe, err := service.Repository.GetByID(id)
 	if err != nil {
 		// กรณีไม่พบ id ที่ต้องการ update
-		return errors.Wrap(err, "find recipe")
+		return model.FoodRecipe{}, errors.Wrap(err, "find recipe")
+
+	}
+
+	if recipe.UserID != claims.ID && !claims.Can(model.PermissionDeleteAnyRecipe) {
+		// กรณี user ที่ login ไม่ตรงกับ user ที่สร้าง recipe และไม่มี role ที่ลบของผู้อื่นได้
+		return model.FoodRecipe{}, global.ErrForbidden
+	}
+
+	if !ifMatch.Matches(recipe.Version) {
+		return recipe.CalculateAverageRating(), global.ErrPreconditionFailed
+	}
+
+	override := model.RecipeOverride(recipe, claims, model.ModerationRecipeDelete)
+
+	if err := service.Repository.Delete(id, recipe.Version, override); err != nil {
+		if errors.Is(err, global.ErrPreconditionFailed) {
+			return service.current(id)
+		}
+
+		return model.FoodRecipe{}, err
+	}
+
+	return model.FoodRecipe{}, nil
+}
+
+// checkReferences ห้ามใช้ค่าอ้างอิงที่เลิกใช้แล้ว
+// ยกเว้น recipe ที่ใช้ค่านั้นอยู่ก่อนและไม่ได้เปลี่ยน
+func (service Service) checkReferences(request *dto.FoodRecipeRequest, current model.FoodRecipe) error {
+	if request.DifficultyID != current.DifficultyID {
+		difficulty, err := service.DifficultyService.GetByID(int(request.DifficultyID))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.Wrapf(global.ErrInvalidReference, "difficulty %d not found", request.DifficultyID)
+			}
+			return errors.Wrap(err, "find difficulty")
+		}
+
+		if difficulty.IsRetired() {
+			return errors.Wrapf(global.ErrInvalidReference, "difficulty %d is retired", request.DifficultyID)
+		}
+	}
+
+	return service.resolveCookingDuration(request, current)
+}
+
+// resolveCookingDuration เติมเวลาเป็นนาทีและหมวดเวลาให้ครบทั้งคู่
+// ถ้าส่งนาทีมาจะหาหมวดเวลาจากเวลารวม ถ้าไม่ส่ง (client เดิม) จะประมาณนาทีจากหมวดเวลา
+func (service Service) resolveCookingDuration(request *dto.FoodRecipeRequest, current model.FoodRecipe) error {
+	if request.PrepMinutes != nil || request.CookMinutes != nil {
+		var prepMinutes, cookMinutes int
+		if request.PrepMinutes != nil {
+			prepMinutes = *request.PrepMinutes
+		}
+		if request.CookMinutes != nil {
+			cookMinutes = *request.CookMinutes
+		}
+
+		if current.ID != 0 && request.CookingDurationID == current.CookingDurationID &&
+			prepMinutes == current.PrepMinutes && cookMinutes == current.CookMinutes {
+			// เวลาไม่เปลี่ยน ไม่ต้องหาหมวดเวลาใหม่
+			return nil
+		}
+
+		totalMinutes := prepMinutes + cookMinutes
+
+		duration, err := service.CookingDurationService.GetByMinutes(totalMinutes)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.Wrapf(global.ErrInvalidReference, "no cooking duration covers %d minutes", totalMinutes)
+			}
+			return errors.Wrap(err, "find cooking duration")
+		}
 
+		request.CookingDurationID = duration.ID
+		return nil
 	}
 
-	if recipe.UserID != claims.ID {
-		// กรณี user ที่ login ไม่ตรงกับ user ที่สร้าง recipe
-		return global.ErrForbidden
+	if request.CookingDurationID == current.CookingDurationID {
+		// หมวดเวลาเดิม ใช้นาทีเดิมต่อ
+		request.PrepMinutes = &current.PrepMinutes
+		request.CookMinutes = &current.CookMinutes
+		return nil
+	}
+
+	duration, err := service.CookingDurationService.GetByID(int(request.CookingDurationID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.Wrapf(global.ErrInvalidReference, "cooking duration %d not found", request.CookingDurationID)
+		}
+		return errors.Wrap(err, "find cooking duration")
+	}
+
+	if duration.IsRetired() {
+		return errors.Wrapf(global.ErrInvalidReference, "cooking duration %d is retired", request.CookingDurationID)
+	}
+
+	// ไม่รู้สัดส่วนเวลาเตรียมกับเวลาทำ จึงนับเป็นเวลาทำทั้งหมด
+	prepMinutes, cookMinutes := 0, duration.MidpointMinutes()
+	request.PrepMinutes = &prepMinutes
+	request.CookMinutes = &cookMinutes
+
+	return nil
+}
+
+// current โหลด recipe ล่าสุดเพื่อส่งกลับพร้อม ErrPreconditionFailed
+func (service Service) current(id int) (model.FoodRecipe, error) {
+	recipe, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "find recipe")
 	}
 
-	return service.Repository.Delete(id)
+	return recipe.CalculateAverageRating(), global.ErrPreconditionFailed
 }
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 	"wongnok/internal/foodrecipe"
 	"wongnok/internal/global"
 	"wongnok/internal/model"
@@ -34,24 +35,52 @@ type ServiceCreateTestSuite struct {
 	suite.Suite
 
 	// Dependencies
-	service foodrecipe.IService
-	repo    *MockIRepository
+	service         foodrecipe.IService
+	repo            *MockIRepository
+	difficulty      *MockIDifficultyService
+	cookingDuration *MockICookingDurationService
 
 	// Mock data
+	respDifficulty      model.Difficulty
+	errDifficulty       error
+	respCookingDuration model.CookingDuration
+	errCookingDuration  error
 	errRepositoryCreate error
+	createdRecipe       model.FoodRecipe
 }
 
 // This will run before each test
 func (suite *ServiceCreateTestSuite) SetupTest() {
 	suite.repo = new(MockIRepository)
+	suite.difficulty = new(MockIDifficultyService)
+	suite.cookingDuration = new(MockICookingDurationService)
 	suite.service = &foodrecipe.Service{
-		Repository: suite.repo,
+		Repository:             suite.repo,
+		DifficultyService:      suite.difficulty,
+		CookingDurationService: suite.cookingDuration,
 	}
 
+	suite.respDifficulty = model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy"}
+	suite.errDifficulty = nil
+	maxMinutes := 10
+	suite.respCookingDuration = model.CookingDuration{Model: gorm.Model{ID: 1}, Name: "5 - 10", MinMinutes: 5, MaxMinutes: &maxMinutes}
+	suite.errCookingDuration = nil
 	suite.errRepositoryCreate = nil
+	suite.createdRecipe = model.FoodRecipe{}
+
+	suite.difficulty.On("GetByID", mock.Anything).Return(func(int) (model.Difficulty, error) {
+		return suite.respDifficulty, suite.errDifficulty
+	})
+	suite.cookingDuration.On("GetByID", mock.Anything).Return(func(int) (model.CookingDuration, error) {
+		return suite.respCookingDuration, suite.errCookingDuration
+	})
+	suite.cookingDuration.On("GetByMinutes", mock.Anything).Return(func(int) (model.CookingDuration, error) {
+		return suite.respCookingDuration, suite.errCookingDuration
+	})
 
 	suite.repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
 		recipe := args.Get(0).(*model.FoodRecipe)
+		suite.createdRecipe = *recipe
 		*recipe = model.FoodRecipe{
 			Name:              "Name",
 			Description:       "Description",
@@ -133,6 +162,152 @@ func (suite *ServiceCreateTestSuite) TestErrorWhenRepositoryCreate() {
 	suite.Empty(recipe)
 }
 
+func (suite *ServiceCreateTestSuite) TestEstimateMinutesFromCookingDuration() {
+	_, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:              "Name",
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.NoError(err)
+
+	suite.Equal(0, suite.createdRecipe.PrepMinutes)
+	suite.Equal(8, suite.createdRecipe.CookMinutes)
+	suite.Equal(8, suite.createdRecipe.TotalMinutes)
+	suite.cookingDuration.AssertNotCalled(suite.T(), "GetByMinutes", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestDeriveCookingDurationFromMinutes() {
+	prepMinutes, cookMinutes := 10, 15
+	suite.respCookingDuration = model.CookingDuration{Model: gorm.Model{ID: 2}, Name: "11 - 30"}
+
+	_, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:         "Name",
+			Description:  "Description",
+			Ingredient:   "Ingredient",
+			Instruction:  "Instruction",
+			PrepMinutes:  &prepMinutes,
+			CookMinutes:  &cookMinutes,
+			DifficultyID: 1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.NoError(err)
+
+	suite.Equal(uint(2), suite.createdRecipe.CookingDurationID)
+	suite.Equal(10, suite.createdRecipe.PrepMinutes)
+	suite.Equal(15, suite.createdRecipe.CookMinutes)
+	suite.Equal(25, suite.createdRecipe.TotalMinutes)
+	suite.cookingDuration.AssertCalled(suite.T(), "GetByMinutes", 25)
+	suite.cookingDuration.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNoCookingDurationCoversMinutes() {
+	cookMinutes := 5
+	suite.errCookingDuration = gorm.ErrRecordNotFound
+
+	recipe, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:         "Name",
+			Description:  "Description",
+			Ingredient:   "Ingredient",
+			Instruction:  "Instruction",
+			CookMinutes:  &cookMinutes,
+			DifficultyID: 1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.ErrorIs(err, global.ErrInvalidReference)
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNeitherMinutesNorCookingDuration() {
+	recipe, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:         "Name",
+			Description:  "Description",
+			Ingredient:   "Ingredient",
+			Instruction:  "Instruction",
+			DifficultyID: 1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(recipe)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenDifficultyRetired() {
+	retiredAt := time.Now()
+	suite.respDifficulty.RetiredAt = &retiredAt
+
+	recipe, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:              "Name",
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.ErrorIs(err, global.ErrInvalidReference)
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenCookingDurationNotFound() {
+	suite.errCookingDuration = gorm.ErrRecordNotFound
+
+	recipe, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:              "Name",
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			CookingDurationID: 9,
+			DifficultyID:      1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.ErrorIs(err, global.ErrInvalidReference)
+	suite.True(strings.HasPrefix(err.Error(), "cooking duration 9 not found"))
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenFindDifficulty() {
+	suite.errDifficulty = assert.AnError
+
+	recipe, err := suite.service.Create(
+		dto.FoodRecipeRequest{
+			Name:              "Name",
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		model.Claims{ID: "UID"},
+	)
+	suite.ErrorIs(err, assert.AnError)
+	suite.NotErrorIs(err, global.ErrInvalidReference)
+
+	suite.Empty(recipe)
+}
+
 func TestServiceCreateRecipe(t *testing.T) {
 	suite.Run(t, new(ServiceCreateTestSuite))
 }
@@ -141,21 +316,26 @@ type ServiceGetTestSuite struct {
 	suite.Suite
 
 	// Dependencies
-	service foodrecipe.IService
-	repo    *MockIRepository
+	service    foodrecipe.IService
+	repo       *MockIRepository
+	preference *MockIPreferenceService
 
 	// Mock data
 	respRepositoryCount int64
 	errRepositoryCount  error
 	respRepositoryGet   model.FoodRecipes
 	errRepositoryGet    error
+	respPreferences     model.UserPreferences
+	errPreferences      error
 }
 
 // This will run before each test
 func (suite *ServiceGetTestSuite) SetupTest() {
 	suite.repo = new(MockIRepository)
+	suite.preference = new(MockIPreferenceService)
 	suite.service = &foodrecipe.Service{
-		Repository: suite.repo,
+		Repository:        suite.repo,
+		PreferenceService: suite.preference,
 	}
 
 	suite.respRepositoryCount = 10
@@ -166,8 +346,19 @@ func (suite *ServiceGetTestSuite) SetupTest() {
 		},
 	}
 	suite.errRepositoryGet = nil
+	suite.respPreferences = model.UserPreferences{
+		UserID:              "UID",
+		Diets:               model.Labels{model.DietVegan},
+		DislikedIngredients: model.Labels{"cilantro"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}
+	suite.errPreferences = nil
 
-	suite.repo.On("Count").Return(func() (int64, error) {
+	suite.preference.On("Get", mock.Anything).Return(func(string) (model.UserPreferences, error) {
+		return suite.respPreferences, suite.errPreferences
+	})
+
+	suite.repo.On("Count", mock.Anything).Return(func(model.FoodRecipeQuery) (int64, error) {
 		return suite.respRepositoryCount, suite.errRepositoryCount
 	})
 
@@ -232,6 +423,67 @@ func (suite *ServiceGetTestSuite) TestErrorWhenCount() {
 	suite.repo.AssertNotCalled(suite.T(), "Get")
 }
 
+func (suite *ServiceGetTestSuite) TestSkipPreferencesWhenAnonymous() {
+	_, _, err := suite.service.Get(model.FoodRecipeQuery{Page: 1, Limit: 10})
+
+	suite.NoError(err)
+	suite.preference.AssertNotCalled(suite.T(), "Get", mock.Anything)
+	suite.repo.AssertCalled(suite.T(), "Get", model.FoodRecipeQuery{Page: 1, Limit: 10})
+}
+
+func (suite *ServiceGetTestSuite) TestApplyPreferencesWhenQueryUnset() {
+	_, _, err := suite.service.Get(model.FoodRecipeQuery{Page: 1, Limit: 10, ViewerID: "UID"})
+
+	expected := model.FoodRecipeQuery{
+		Page:               1,
+		Limit:              10,
+		ViewerID:           "UID",
+		Diet:               model.Labels{model.DietVegan},
+		ExcludeIngredients: model.Labels{"cilantro"},
+		Sort:               model.FoodRecipeSortRating,
+	}
+
+	suite.NoError(err)
+	suite.preference.AssertCalled(suite.T(), "Get", "UID")
+	suite.repo.AssertCalled(suite.T(), "Count", expected)
+	suite.repo.AssertCalled(suite.T(), "Get", expected)
+}
+
+func (suite *ServiceGetTestSuite) TestQueryOverridesPreferences() {
+	query := model.FoodRecipeQuery{
+		Page:               1,
+		Limit:              10,
+		ViewerID:           "UID",
+		Diet:               []string{model.DietHalal},
+		ExcludeIngredients: []string{"peanut"},
+		Sort:               model.FoodRecipeSortName,
+	}
+
+	_, _, err := suite.service.Get(query)
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Get", query)
+}
+
+func (suite *ServiceGetTestSuite) TestIgnorePreferences() {
+	query := model.FoodRecipeQuery{Page: 1, Limit: 10, ViewerID: "UID", IgnorePreferences: true}
+
+	_, _, err := suite.service.Get(query)
+
+	suite.NoError(err)
+	suite.preference.AssertNotCalled(suite.T(), "Get", mock.Anything)
+	suite.repo.AssertCalled(suite.T(), "Get", query)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenGetPreferences() {
+	suite.errPreferences = assert.AnError
+
+	_, _, err := suite.service.Get(model.FoodRecipeQuery{Page: 1, Limit: 10, ViewerID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.repo.AssertNotCalled(suite.T(), "Count", mock.Anything)
+}
+
 func TestServiceGetRecipes(t *testing.T) {
 	suite.Run(t, new(ServiceGetTestSuite))
 }
@@ -265,6 +517,12 @@ func (suite *ServiceGetByIDTestSuite) SetupTest() {
 		}
 		return model.FoodRecipe{}, gorm.ErrRecordNotFound
 	})
+	suite.repo.On("GetByIDWithRating", mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(func(id int, _ float64) (model.FoodRecipe, error) {
+		if id == 1 {
+			return suite.respRepositoryGetByID, suite.errRepositoryGetByID
+		}
+		return model.FoodRecipe{}, gorm.ErrRecordNotFound
+	})
 }
 
 func (suite *ServiceGetByIDTestSuite) TestReturnRecipeWhenFound() {
@@ -287,30 +545,141 @@ func (suite *ServiceGetByIDTestSuite) TestErrorWhenNotFound() {
 	suite.repo.AssertCalled(suite.T(), "GetByID", 2)
 }
 
+func (suite *ServiceGetByIDTestSuite) TestReturnRecipeWithRating() {
+	recipe, err := suite.service.GetByIDWithRating(1, 10)
+	suite.NoError(err)
+
+	suite.Equal(model.FoodRecipe{Name: "Name"}, recipe)
+	suite.repo.AssertCalled(suite.T(), "GetByIDWithRating", 1, 10.0)
+}
+
+func (suite *ServiceGetByIDTestSuite) TestErrorWithRatingWhenNotFound() {
+	recipe, err := suite.service.GetByIDWithRating(2, 10)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Empty(recipe)
+}
+
 func TestServiceGetRecipeByID(t *testing.T) {
 	suite.Run(t, new(ServiceGetByIDTestSuite))
 }
 
-type ServiceUpdateTestSuite struct {
+type ServiceGetTrendingTestSuite struct {
 	suite.Suite
 
 	// Dependencies
 	service foodrecipe.IService
 	repo    *MockIRepository
 
+	// Mock data
+	respRepositoryGetTrending model.FoodRecipes
+	errRepositoryGetTrending  error
+}
+
+func (suite *ServiceGetTrendingTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &foodrecipe.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respRepositoryGetTrending = model.FoodRecipes{
+		{Model: gorm.Model{ID: 2}, RatingSummary: model.RatingSummary{RatingCount: 2, RatingSum: 9}},
+		{Model: gorm.Model{ID: 1}},
+	}
+	suite.errRepositoryGetTrending = nil
+
+	suite.repo.On("GetTrending", mock.Anything).Return(func(model.TrendingQuery) (model.FoodRecipes, error) {
+		return suite.respRepositoryGetTrending, suite.errRepositoryGetTrending
+	})
+}
+
+func (suite *ServiceGetTrendingTestSuite) TestReturnRecipesWithAverageRating() {
+	query := model.TrendingQuery{Limit: 10, HalfLife: time.Hour}
+
+	recipes, err := suite.service.GetTrending(query)
+	suite.NoError(err)
+
+	suite.Len(recipes, 2)
+	suite.Equal(uint(2), recipes[0].ID)
+	suite.Equal(4.5, recipes[0].AverageRating)
+	suite.repo.AssertCalled(suite.T(), "GetTrending", query)
+}
+
+func (suite *ServiceGetTrendingTestSuite) TestErrorWhenRepositoryGetTrending() {
+	suite.respRepositoryGetTrending = nil
+	suite.errRepositoryGetTrending = assert.AnError
+
+	recipes, err := suite.service.GetTrending(model.TrendingQuery{Limit: 10})
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.Empty(recipes)
+}
+
+func TestServiceGetTrendingRecipes(t *testing.T) {
+	suite.Run(t, new(ServiceGetTrendingTestSuite))
+}
+
+func TestServiceGetCacheValidator(t *testing.T) {
+	t.Run("ShouldReturnValidatorFromRepository", func(t *testing.T) {
+		repo := new(MockIRepository)
+		service := &foodrecipe.Service{
+			Repository: repo,
+		}
+
+		expectedValidator := model.CacheValidator{Count: 2}
+		repo.On("GetCacheValidator").Return(expectedValidator, nil)
+
+		validator, err := service.GetCacheValidator()
+		assert.NoError(t, err)
+
+		assert.Equal(t, expectedValidator, validator)
+	})
+
+	t.Run("ShouldReturnValidatorByIDFromRepository", func(t *testing.T) {
+		repo := new(MockIRepository)
+		service := &foodrecipe.Service{
+			Repository: repo,
+		}
+
+		expectedValidator := model.CacheValidator{Version: 3}
+		repo.On("GetCacheValidatorByID", 1).Return(expectedValidator, nil)
+
+		validator, err := service.GetCacheValidatorByID(1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expectedValidator, validator)
+	})
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service    foodrecipe.IService
+	repo       *MockIRepository
+	difficulty *MockIDifficultyService
+
 	// Mock data
 	respGetByID          model.FoodRecipe
 	errGetByID           error
 	respRepositoryUpdate model.FoodRecipe
 	errRepositoryUpdate  error
+	retiredAt            time.Time
 }
 
 func (suite *ServiceUpdateTestSuite) SetupTest() {
 	suite.repo = new(MockIRepository)
+	suite.difficulty = new(MockIDifficultyService)
 	suite.service = &foodrecipe.Service{
-		Repository: suite.repo,
+		Repository:        suite.repo,
+		DifficultyService: suite.difficulty,
 	}
 
+	suite.retiredAt = time.Now()
+	suite.difficulty.On("GetByID", mock.Anything).Return(func(id int) (model.Difficulty, error) {
+		return model.Difficulty{Model: gorm.Model{ID: uint(id)}, RetiredAt: &suite.retiredAt}, nil
+	})
+
 	suite.respGetByID = model.FoodRecipe{
 		Model:             gorm.Model{ID: 1},
 		Name:              "Name",
@@ -337,10 +706,10 @@ func (suite *ServiceUpdateTestSuite) SetupTest() {
 	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.FoodRecipe, error) {
 		return suite.respGetByID, suite.errGetByID
 	})
-	suite.repo.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+	suite.repo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		recipe := args.Get(0).(*model.FoodRecipe)
 		*recipe = suite.respRepositoryUpdate
-	}).Return(func(*model.FoodRecipe) error {
+	}).Return(func(*model.FoodRecipe, *model.ModerationLog) error {
 		return suite.errRepositoryUpdate
 	})
 }
@@ -370,6 +739,7 @@ func (suite *ServiceUpdateTestSuite) TestReturnRecipeUpdated() {
 			DifficultyID:      1,
 		},
 		1,
+		model.IfMatch{},
 		claims,
 	)
 	suite.NoError(err)
@@ -385,17 +755,73 @@ func (suite *ServiceUpdateTestSuite) TestReturnRecipeUpdated() {
 		CookingDurationID: 1,
 		DifficultyID:      1,
 		UserID:            "UID",
+	}, (*model.ModerationLog)(nil))
+}
+
+func (suite *ServiceUpdateTestSuite) TestAdminUpdateKeepOwnerAndRecordOverride() {
+	claims := model.Claims{
+		ID:          "ADMIN",
+		RealmAccess: model.RoleAccess{Roles: []string{model.RoleAdmin}},
+	}
+
+	_, err := suite.service.Update(
+		dto.FoodRecipeRequest{
+			Name:              "NameUpdated",
+			Description:       "DescriptionUpdated",
+			Ingredient:        "IngredientUpdated",
+			Instruction:       "InstructionUpdated",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		1,
+		model.IfMatch{},
+		claims,
+	)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return recipe.UserID == "UID"
+	}), &model.ModerationLog{
+		ActorID:      "ADMIN",
+		Roles:        model.Labels{model.RoleAdmin},
+		Action:       model.ModerationRecipeUpdate,
+		FoodRecipeID: 1,
+		OwnerID:      "UID",
 	})
 }
 
+func (suite *ServiceUpdateTestSuite) TestErrorForbiddenWhenModeratorUpdate() {
+	claims := model.Claims{
+		ID:          "MODERATOR",
+		RealmAccess: model.RoleAccess{Roles: []string{model.RoleModerator}},
+	}
+
+	_, err := suite.service.Update(
+		dto.FoodRecipeRequest{
+			Name:              "NameUpdated",
+			Description:       "DescriptionUpdated",
+			Ingredient:        "IngredientUpdated",
+			Instruction:       "InstructionUpdated",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		1,
+		model.IfMatch{},
+		claims,
+	)
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
 func (suite *ServiceUpdateTestSuite) TestErrorWhenRequestValidate() {
-	recipe, err := suite.service.Update(dto.FoodRecipeRequest{}, 1, model.Claims{})
+	recipe, err := suite.service.Update(dto.FoodRecipeRequest{}, 1, model.IfMatch{}, model.Claims{})
 	suite.Error(err)
 	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
 
 	suite.Empty(recipe)
 	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
-	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
 }
 
 func (suite *ServiceUpdateTestSuite) TestErrorWhenGetByID() {
@@ -415,6 +841,7 @@ func (suite *ServiceUpdateTestSuite) TestErrorWhenGetByID() {
 			DifficultyID:      1,
 		},
 		1,
+		model.IfMatch{},
 		claims,
 	)
 	suite.ErrorIs(err, assert.AnError)
@@ -422,7 +849,7 @@ func (suite *ServiceUpdateTestSuite) TestErrorWhenGetByID() {
 
 	suite.Empty(recipe)
 
-	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
 }
 
 func (suite *ServiceUpdateTestSuite) TestErrorForbidden() {
@@ -440,12 +867,13 @@ func (suite *ServiceUpdateTestSuite) TestErrorForbidden() {
 			DifficultyID:      1,
 		},
 		1,
+		model.IfMatch{},
 		claims,
 	)
 	suite.ErrorIs(err, global.ErrForbidden)
 	suite.Empty(recipe)
 
-	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
 }
 
 func (suite *ServiceUpdateTestSuite) TestErrorWhenRepositoryUpdate() {
@@ -465,6 +893,7 @@ func (suite *ServiceUpdateTestSuite) TestErrorWhenRepositoryUpdate() {
 			DifficultyID:      1,
 		},
 		1,
+		model.IfMatch{},
 		claims,
 	)
 	suite.ErrorIs(err, assert.AnError)
@@ -473,10 +902,320 @@ func (suite *ServiceUpdateTestSuite) TestErrorWhenRepositoryUpdate() {
 	suite.Empty(recipe)
 }
 
+func (suite *ServiceUpdateTestSuite) TestErrorWhenVersionMismatch() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	suite.respGetByID.Version = 3
+
+	recipe, err := suite.service.Update(
+		dto.FoodRecipeRequest{
+			Name:              "NameUpdated",
+			Description:       "DescriptionUpdated",
+			Ingredient:        "IngredientUpdated",
+			Instruction:       "InstructionUpdated",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		1,
+		model.IfMatch{Present: true, Versions: []uint{2}},
+		claims,
+	)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+
+	// ส่ง recipe ปัจจุบันกลับไปให้ client
+	suite.Equal("Name", recipe.Name)
+	suite.Equal(uint(3), recipe.Version)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenRepositoryReportsConflict() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	suite.errRepositoryUpdate = global.ErrPreconditionFailed
+
+	recipe, err := suite.service.Update(
+		dto.FoodRecipeRequest{
+			Name:              "NameUpdated",
+			Description:       "DescriptionUpdated",
+			Ingredient:        "IngredientUpdated",
+			Instruction:       "InstructionUpdated",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		1,
+		model.IfMatch{},
+		claims,
+	)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+	suite.Equal("Name", recipe.Name)
+}
+
+func (suite *ServiceUpdateTestSuite) TestAllowRetiredReferenceWhenUnchanged() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	_, err := suite.service.Update(
+		dto.FoodRecipeRequest{
+			Name:              "NameUpdated",
+			Description:       "DescriptionUpdated",
+			Ingredient:        "IngredientUpdated",
+			Instruction:       "InstructionUpdated",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+		1,
+		model.IfMatch{},
+		claims,
+	)
+	suite.NoError(err)
+
+	// recipe เดิมใช้ค่านี้อยู่แล้ว จึงไม่ต้องตรวจซ้ำ
+	suite.difficulty.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenChangedToRetiredReference() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	_, err := suite.service.Update(
+		dto.FoodRecipeRequest{
+			Name:              "NameUpdated",
+			Description:       "DescriptionUpdated",
+			Ingredient:        "IngredientUpdated",
+			Instruction:       "InstructionUpdated",
+			CookingDurationID: 1,
+			DifficultyID:      2,
+		},
+		1,
+		model.IfMatch{},
+		claims,
+	)
+	suite.ErrorIs(err, global.ErrInvalidReference)
+
+	suite.difficulty.AssertCalled(suite.T(), "GetByID", 2)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func TestServiceWithFavorites(t *testing.T) {
+	t.Run("ShouldFillStateOfEveryRecipe", func(t *testing.T) {
+		repo := new(MockIRepository)
+		repo.On("GetFavoriteStates", []uint{1, 2}, "UID").Return([]model.FavoriteState{
+			{FoodRecipeID: 2, FavoriteCount: 4, IsFavorited: true},
+		}, nil)
+
+		recipes, err := foodrecipe.Service{Repository: repo}.WithFavorites(model.FoodRecipes{
+			{Model: gorm.Model{ID: 1}},
+			{Model: gorm.Model{ID: 2}},
+		}, model.Claims{ID: "UID"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &model.FavoriteState{FoodRecipeID: 1}, recipes[0].Favorite)
+		assert.Equal(t, &model.FavoriteState{FoodRecipeID: 2, FavoriteCount: 4, IsFavorited: true}, recipes[1].Favorite)
+	})
+
+	t.Run("ShouldWrapRepositoryError", func(t *testing.T) {
+		repo := new(MockIRepository)
+		repo.On("GetFavoriteStates", mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		_, err := foodrecipe.Service{Repository: repo}.WithFavorites(model.FoodRecipes{{}}, model.Claims{ID: "UID"})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
 func TestServiceUpdateRecipe(t *testing.T) {
 	suite.Run(t, new(ServiceUpdateTestSuite))
 }
 
+type ServicePatchTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service         foodrecipe.IService
+	repo            *MockIRepository
+	cookingDuration *MockICookingDurationService
+
+	// Mock data
+	respGetByID         model.FoodRecipe
+	errGetByID          error
+	errRepositoryUpdate error
+}
+
+func (suite *ServicePatchTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.cookingDuration = new(MockICookingDurationService)
+	suite.service = &foodrecipe.Service{
+		Repository:             suite.repo,
+		CookingDurationService: suite.cookingDuration,
+	}
+
+	maxMinutes := 60
+	suite.cookingDuration.On("GetByID", mock.Anything).Return(func(id int) (model.CookingDuration, error) {
+		return model.CookingDuration{Model: gorm.Model{ID: uint(id)}, MinMinutes: 31, MaxMinutes: &maxMinutes}, nil
+	})
+	suite.cookingDuration.On("GetByMinutes", mock.Anything).Return(func(int) (model.CookingDuration, error) {
+		return model.CookingDuration{Model: gorm.Model{ID: 2}}, nil
+	})
+
+	imageURL := "https://example.com/old.png"
+	suite.respGetByID = model.FoodRecipe{
+		Model:             gorm.Model{ID: 1},
+		Name:              "Name",
+		Description:       "Description",
+		Ingredient:        "Ingredient",
+		Instruction:       "Instruction",
+		ImageURL:          &imageURL,
+		PrepMinutes:       0,
+		CookMinutes:       8,
+		TotalMinutes:      8,
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		UserID:            "UID",
+		Version:           1,
+	}
+	suite.errGetByID = nil
+	suite.errRepositoryUpdate = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.FoodRecipe, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything, mock.Anything).Return(func(*model.FoodRecipe, *model.ModerationLog) error {
+		return suite.errRepositoryUpdate
+	})
+}
+
+func (suite *ServicePatchTestSuite) TestReturnRecipePatched() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"imageUrl":"https://example.com/new.png"}`), 1, model.IfMatch{}, claims)
+	suite.NoError(err)
+
+	imageURL := "https://example.com/new.png"
+	expectedRecipe := model.FoodRecipe{
+		Model:             gorm.Model{ID: 1},
+		Name:              "Name",
+		Description:       "Description",
+		Ingredient:        "Ingredient",
+		Instruction:       "Instruction",
+		ImageURL:          &imageURL,
+		CookMinutes:       8,
+		TotalMinutes:      8,
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		UserID:            "UID",
+		Version:           1,
+	}
+
+	suite.Equal(expectedRecipe, recipe)
+	suite.repo.AssertCalled(suite.T(), "Update", &expectedRecipe, (*model.ModerationLog)(nil))
+	suite.cookingDuration.AssertNotCalled(suite.T(), "GetByMinutes", mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestDeriveCookingDurationWhenMinutesPatched() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"prepMinutes":10}`), 1, model.IfMatch{}, claims)
+	suite.NoError(err)
+
+	suite.Equal(10, recipe.PrepMinutes)
+	suite.Equal(8, recipe.CookMinutes)
+	suite.Equal(18, recipe.TotalMinutes)
+	suite.Equal(uint(2), recipe.CookingDurationID)
+	suite.cookingDuration.AssertCalled(suite.T(), "GetByMinutes", 18)
+}
+
+func (suite *ServicePatchTestSuite) TestEstimateMinutesWhenOnlyCookingDurationPatched() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"cookingDurationId":3}`), 1, model.IfMatch{}, claims)
+	suite.NoError(err)
+
+	suite.Equal(uint(3), recipe.CookingDurationID)
+	suite.Equal(0, recipe.PrepMinutes)
+	suite.Equal(46, recipe.CookMinutes)
+	suite.Equal(46, recipe.TotalMinutes)
+	suite.cookingDuration.AssertCalled(suite.T(), "GetByID", 3)
+	suite.cookingDuration.AssertNotCalled(suite.T(), "GetByMinutes", mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestRemoveImageWhenNull() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"imageUrl":null}`), 1, model.IfMatch{}, claims)
+	suite.NoError(err)
+
+	suite.Nil(recipe.ImageURL)
+	suite.Equal("Name", recipe.Name)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenMergedResultInvalid() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"name":null}`), 1, model.IfMatch{}, claims)
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenPatchInvalid() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`[]`), 1, model.IfMatch{}, claims)
+	suite.ErrorIs(err, global.ErrInvalidPatch)
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorForbidden() {
+	claims := model.Claims{
+		ID: "FAKE",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"name":"Updated"}`), 1, model.IfMatch{}, claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenVersionMismatch() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Patch([]byte(`{"name":"Updated"}`), 1, model.IfMatch{Present: true, Versions: []uint{2}}, claims)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+	suite.Equal("Name", recipe.Name)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func TestServicePatchRecipe(t *testing.T) {
+	suite.Run(t, new(ServicePatchTestSuite))
+}
+
 type ServiceDeleteTestSuite struct {
 	suite.Suite
 
@@ -497,7 +1236,8 @@ func (suite *ServiceDeleteTestSuite) SetupTest() {
 	}
 
 	suite.respGetByID = model.FoodRecipe{
-		UserID: "UID",
+		UserID:  "UID",
+		Version: 2,
 	}
 	suite.errGetByID = nil
 	suite.errRepositoryDelete = nil
@@ -505,7 +1245,7 @@ func (suite *ServiceDeleteTestSuite) SetupTest() {
 	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.FoodRecipe, error) {
 		return suite.respGetByID, suite.errGetByID
 	})
-	suite.repo.On("Delete", mock.AnythingOfType("int")).Return(func(int) error {
+	suite.repo.On("Delete", mock.AnythingOfType("int"), mock.AnythingOfType("uint"), mock.Anything).Return(func(int, uint, *model.ModerationLog) error {
 		return suite.errRepositoryDelete
 	})
 }
@@ -515,11 +1255,11 @@ func (suite *ServiceDeleteTestSuite) TestRecipeDeleted() {
 		ID: "UID",
 	}
 
-	err := suite.service.Delete(1, claims)
+	_, err := suite.service.Delete(1, model.IfMatch{}, claims)
 	suite.NoError(err)
 
 	suite.repo.AssertCalled(suite.T(), "GetByID", 1)
-	suite.repo.AssertCalled(suite.T(), "Delete", 1)
+	suite.repo.AssertCalled(suite.T(), "Delete", 1, uint(2), (*model.ModerationLog)(nil))
 }
 
 func (suite *ServiceDeleteTestSuite) TestErrorWhenGetByID() {
@@ -529,11 +1269,11 @@ func (suite *ServiceDeleteTestSuite) TestErrorWhenGetByID() {
 
 	suite.errGetByID = assert.AnError
 
-	err := suite.service.Delete(1, claims)
+	_, err := suite.service.Delete(1, model.IfMatch{}, claims)
 	suite.ErrorIs(err, assert.AnError)
 	suite.True(strings.HasPrefix(err.Error(), "find recipe"))
 
-	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *ServiceDeleteTestSuite) TestErrorFoebidden() {
@@ -541,10 +1281,40 @@ func (suite *ServiceDeleteTestSuite) TestErrorFoebidden() {
 		ID: "FAKE",
 	}
 
-	err := suite.service.Delete(1, claims)
+	_, err := suite.service.Delete(1, model.IfMatch{}, claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestModeratorDeleteRecordOverride() {
+	claims := model.Claims{
+		ID:              "MODERATOR",
+		AuthorizedParty: "wongnok",
+		ResourceAccess:  map[string]model.RoleAccess{"wongnok": {Roles: []string{model.RoleModerator}}},
+	}
+
+	_, err := suite.service.Delete(1, model.IfMatch{}, claims)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Delete", 1, uint(2), &model.ModerationLog{
+		ActorID: "MODERATOR",
+		Roles:   model.Labels{model.RoleModerator},
+		Action:  model.ModerationRecipeDelete,
+		OwnerID: "UID",
+	})
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorForbiddenWhenEditorDelete() {
+	claims := model.Claims{
+		ID:          "EDITOR",
+		RealmAccess: model.RoleAccess{Roles: []string{model.RoleEditor}},
+	}
+
+	_, err := suite.service.Delete(1, model.IfMatch{}, claims)
 	suite.ErrorIs(err, global.ErrForbidden)
 
-	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *ServiceDeleteTestSuite) TestErrorWhenRepositoryDelete() {
@@ -554,10 +1324,34 @@ func (suite *ServiceDeleteTestSuite) TestErrorWhenRepositoryDelete() {
 
 	suite.errRepositoryDelete = assert.AnError
 
-	err := suite.service.Delete(1, claims)
+	_, err := suite.service.Delete(1, model.IfMatch{}, claims)
 	suite.ErrorIs(err, assert.AnError)
 }
 
+func (suite *ServiceDeleteTestSuite) TestErrorWhenVersionMismatch() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	recipe, err := suite.service.Delete(1, model.IfMatch{Present: true, Versions: []uint{1}}, claims)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+	suite.Equal(uint(2), recipe.Version)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenRepositoryReportsConflict() {
+	claims := model.Claims{
+		ID: "UID",
+	}
+
+	suite.errRepositoryDelete = global.ErrPreconditionFailed
+
+	recipe, err := suite.service.Delete(1, model.IfMatch{}, claims)
+	suite.ErrorIs(err, global.ErrPreconditionFailed)
+	suite.Equal(uint(2), recipe.Version)
+}
+
 func TestServiceDeleteRecipe(t *testing.T) {
 	suite.Run(t, new(ServiceDeleteTestSuite))
 }
@@ -3,5 +3,22 @@ package global
 import "errors"
 
 var (
-	ErrForbidden error = errors.New("forbidden")
+	ErrForbidden            error = errors.New("forbidden")
+	ErrEditWindowExpired    error = errors.New("edit window expired")
+	ErrInvalidParent        error = errors.New("invalid parent comment")
+	ErrPreconditionFailed   error = errors.New("precondition failed")
+	ErrPreconditionRequired error = errors.New("precondition required")
+	ErrInvalidPatch         error = errors.New("invalid merge patch")
+	ErrInvalidReference     error = errors.New("invalid reference data")
+	ErrFollowSelf           error = errors.New("cannot follow yourself")
+	ErrBlockSelf            error = errors.New("cannot block or mute yourself")
+	ErrBlocked              error = errors.New("blocked by or blocking this user")
+	ErrInvalidCursor        error = errors.New("invalid cursor")
+	ErrInvalidTransfer      error = errors.New("invalid recipe transfer target")
+	ErrInvalidHandle        error = errors.New("invalid handle")
+	ErrHandleReserved       error = errors.New("handle is reserved")
+	ErrHandleTaken          error = errors.New("handle is already taken")
+	ErrRefreshTokenInvalid  error = errors.New("refresh token is invalid")
+	ErrRefreshTokenExpired  error = errors.New("refresh token has expired")
+	ErrRefreshTokenRevoked  error = errors.New("refresh token has been revoked")
 )
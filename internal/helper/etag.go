@@ -0,0 +1,85 @@
+package helper
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetCacheValidators(ctx *gin.Context, validator model.CacheValidator) {
+	ctx.Header("ETag", validator.ETag())
+
+	if !validator.LastModified.IsZero() {
+		ctx.Header("Last-Modified", validator.LastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// IsNotModified ตรวจ If-None-Match ก่อน ถ้าไม่มีจึงใช้ If-Modified-Since
+func IsNotModified(ctx *gin.Context, validator model.CacheValidator) bool {
+	if header := strings.TrimSpace(ctx.GetHeader("If-None-Match")); header != "" {
+		if header == "*" {
+			return true
+		}
+
+		etag := validator.ETag()
+		for _, tag := range strings.Split(header, ",") {
+			// If-None-Match ใช้การเปรียบเทียบแบบ weak
+			if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	header := ctx.GetHeader("If-Modified-Since")
+	if header == "" || validator.LastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(header)
+	if err != nil {
+		return false
+	}
+
+	return !validator.LastModified.Truncate(time.Second).After(since)
+}
+
+func DecodeIfMatch(ctx *gin.Context) model.IfMatch {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" {
+		return model.IfMatch{}
+	}
+
+	if header == "*" {
+		return model.IfMatch{Present: true, Any: true}
+	}
+
+	ifMatch := model.IfMatch{Present: true}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		// If-Match ใช้การเปรียบเทียบแบบ strong จึงไม่รับ weak ETag
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+
+		// ETag อยู่ในรูป "<version>-<hash>" ใช้เฉพาะ version ในการเทียบ
+		tag = strings.Trim(tag, `"`)
+		tag, _, _ = strings.Cut(tag, "-")
+
+		version, err := strconv.ParseUint(tag, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		ifMatch.Versions = append(ifMatch.Versions, uint(version))
+	}
+
+	return ifMatch
+}
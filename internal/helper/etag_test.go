@@ -0,0 +1,124 @@
+package helper_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newETagContext(headers map[string]string) (*gin.Context, *httptest.ResponseRecorder) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	for key, value := range headers {
+		ctx.Request.Header.Set(key, value)
+	}
+
+	return ctx, recorder
+}
+
+func TestSetCacheValidators(t *testing.T) {
+	t.Run("ShouldSetETagAndLastModified", func(t *testing.T) {
+		validator := model.CacheValidator{
+			Version:      1,
+			LastModified: time.Date(2025, 7, 9, 10, 10, 10, 0, time.UTC),
+		}
+
+		ctx, recorder := newETagContext(nil)
+		helper.SetCacheValidators(ctx, validator)
+
+		assert.Equal(t, validator.ETag(), recorder.Header().Get("ETag"))
+		assert.Equal(t, "Wed, 09 Jul 2025 10:10:10 GMT", recorder.Header().Get("Last-Modified"))
+	})
+
+	t.Run("ShouldSkipLastModifiedWhenUnknown", func(t *testing.T) {
+		ctx, recorder := newETagContext(nil)
+		helper.SetCacheValidators(ctx, model.CacheValidator{Version: 1})
+
+		assert.Empty(t, recorder.Header().Get("Last-Modified"))
+	})
+}
+
+func TestIsNotModified(t *testing.T) {
+	validator := model.CacheValidator{
+		Version:      2,
+		LastModified: time.Date(2025, 7, 9, 10, 10, 10, 500, time.UTC),
+	}
+
+	t.Run("ShouldBeFalseWithoutConditionalHeaders", func(t *testing.T) {
+		ctx, _ := newETagContext(nil)
+
+		assert.False(t, helper.IsNotModified(ctx, validator))
+	})
+
+	t.Run("ShouldMatchETagWithWeakComparison", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-None-Match": `"other", W/` + validator.ETag()})
+
+		assert.True(t, helper.IsNotModified(ctx, validator))
+	})
+
+	t.Run("ShouldMatchWildcard", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-None-Match": "*"})
+
+		assert.True(t, helper.IsNotModified(ctx, validator))
+	})
+
+	t.Run("ShouldIgnoreIfModifiedSinceWhenIfNoneMatchPresent", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{
+			"If-None-Match":     `"other"`,
+			"If-Modified-Since": "Wed, 09 Jul 2025 10:10:10 GMT",
+		})
+
+		assert.False(t, helper.IsNotModified(ctx, validator))
+	})
+
+	t.Run("ShouldCompareIfModifiedSinceBySecond", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-Modified-Since": "Wed, 09 Jul 2025 10:10:10 GMT"})
+		assert.True(t, helper.IsNotModified(ctx, validator))
+
+		ctx, _ = newETagContext(map[string]string{"If-Modified-Since": "Wed, 09 Jul 2025 10:10:09 GMT"})
+		assert.False(t, helper.IsNotModified(ctx, validator))
+	})
+
+	t.Run("ShouldBeFalseWhenIfModifiedSinceInvalid", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-Modified-Since": "yesterday"})
+
+		assert.False(t, helper.IsNotModified(ctx, validator))
+	})
+}
+
+func TestDecodeIfMatch(t *testing.T) {
+	t.Run("ShouldBeNotPresentWhenHeaderMissing", func(t *testing.T) {
+		ctx, _ := newETagContext(nil)
+
+		assert.Equal(t, model.IfMatch{}, helper.DecodeIfMatch(ctx))
+	})
+
+	t.Run("ShouldMatchAnyWhenWildcard", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-Match": "*"})
+
+		assert.Equal(t, model.IfMatch{Present: true, Any: true}, helper.DecodeIfMatch(ctx))
+	})
+
+	t.Run("ShouldParseVersionsAndSkipWeakTags", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-Match": `"1-9f2c", W/"2-aa", "3"`})
+
+		assert.Equal(t, model.IfMatch{Present: true, Versions: []uint{1, 3}}, helper.DecodeIfMatch(ctx))
+	})
+
+	t.Run("ShouldNeverMatchWhenTagsInvalid", func(t *testing.T) {
+		ctx, _ := newETagContext(map[string]string{"If-Match": `"abc"`})
+
+		ifMatch := helper.DecodeIfMatch(ctx)
+
+		assert.True(t, ifMatch.Present)
+		assert.False(t, ifMatch.Matches(1))
+	})
+}
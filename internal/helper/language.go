@@ -0,0 +1,30 @@
+package helper
+
+import (
+	"strings"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DecodeLanguage ใช้ query lang ก่อน ถ้าไม่มีจึงดูจาก Accept-Language
+func DecodeLanguage(ctx *gin.Context) string {
+	candidates := []string{ctx.Query("lang")}
+	for _, tag := range strings.Split(ctx.GetHeader("Accept-Language"), ",") {
+		candidates = append(candidates, tag)
+	}
+
+	for _, candidate := range candidates {
+		// ตัด quality (;q=0.8) และ region (-TH) ออก
+		language, _, _ := strings.Cut(strings.TrimSpace(candidate), ";")
+		language, _, _ = strings.Cut(language, "-")
+		language = strings.ToLower(language)
+
+		switch language {
+		case model.LanguageEnglish, model.LanguageThai:
+			return language
+		}
+	}
+
+	return model.LanguageEnglish
+}
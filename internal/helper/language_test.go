@@ -0,0 +1,40 @@
+package helper_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeLanguage(t *testing.T) {
+	newContext := func(url string, acceptLanguage string) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, url, nil)
+		if acceptLanguage != "" {
+			ctx.Request.Header.Set("Accept-Language", acceptLanguage)
+		}
+
+		return ctx
+	}
+
+	t.Run("ShouldDefaultToEnglish", func(t *testing.T) {
+		assert.Equal(t, model.LanguageEnglish, helper.DecodeLanguage(newContext("/", "")))
+	})
+
+	t.Run("ShouldUseAcceptLanguage", func(t *testing.T) {
+		assert.Equal(t, model.LanguageThai, helper.DecodeLanguage(newContext("/", "th-TH,th;q=0.9,en;q=0.8")))
+	})
+
+	t.Run("ShouldSkipUnsupportedLanguages", func(t *testing.T) {
+		assert.Equal(t, model.LanguageThai, helper.DecodeLanguage(newContext("/", "ja-JP, TH;q=0.5")))
+	})
+
+	t.Run("ShouldPreferQueryOverHeader", func(t *testing.T) {
+		assert.Equal(t, model.LanguageEnglish, helper.DecodeLanguage(newContext("/?lang=en", "th")))
+	})
+}
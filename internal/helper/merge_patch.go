@@ -0,0 +1,82 @@
+package helper
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"wongnok/internal/global"
+
+	"github.com/pkg/errors"
+)
+
+// MergePatch ใช้ JSON Merge Patch (RFC 7396) กับ document ที่ส่งมาเป็น pointer
+// key ใน patch เทียบแบบไม่สนตัวพิมพ์เล็กใหญ่ให้เหมือนกับการ bind JSON ปกติ
+func MergePatch(document any, patch []byte) error {
+	var patchValue any
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return errors.Wrap(global.ErrInvalidPatch, err.Error())
+	}
+
+	patchObject, ok := patchValue.(map[string]any)
+	if !ok {
+		return errors.Wrap(global.ErrInvalidPatch, "patch must be a JSON object")
+	}
+
+	current, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	var target map[string]any
+	if err := json.Unmarshal(current, &target); err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(mergeObject(target, patchObject))
+	if err != nil {
+		return err
+	}
+
+	// decode ลงค่าใหม่ เพื่อให้ field ที่ถูกลบด้วย null กลับเป็น zero value
+	value := reflect.ValueOf(document).Elem()
+	result := reflect.New(value.Type())
+
+	if err := json.Unmarshal(merged, result.Interface()); err != nil {
+		return errors.Wrap(global.ErrInvalidPatch, err.Error())
+	}
+
+	value.Set(result.Elem())
+
+	return nil
+}
+
+func mergeObject(target map[string]any, patch map[string]any) map[string]any {
+	if target == nil {
+		target = make(map[string]any)
+	}
+
+	for patchKey, patchValue := range patch {
+		key := patchKey
+		for targetKey := range target {
+			if strings.EqualFold(targetKey, patchKey) {
+				key = targetKey
+				break
+			}
+		}
+
+		if patchValue == nil {
+			delete(target, key)
+			continue
+		}
+
+		if patchObject, ok := patchValue.(map[string]any); ok {
+			targetObject, _ := target[key].(map[string]any)
+			target[key] = mergeObject(targetObject, patchObject)
+			continue
+		}
+
+		target[key] = patchValue
+	}
+
+	return target
+}
@@ -0,0 +1,80 @@
+package helper_test
+
+import (
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mergePatchDocument struct {
+	Name     string
+	ImageURL *string
+	Tags     map[string]string
+}
+
+func TestMergePatch(t *testing.T) {
+	newDocument := func() mergePatchDocument {
+		imageURL := "https://example.com/image.png"
+		return mergePatchDocument{
+			Name:     "Name",
+			ImageURL: &imageURL,
+			Tags:     map[string]string{"a": "1", "b": "2"},
+		}
+	}
+
+	t.Run("ShouldReplaceOnlyGivenFields", func(t *testing.T) {
+		document := newDocument()
+
+		err := helper.MergePatch(&document, []byte(`{"name":"Updated"}`))
+		assert.NoError(t, err)
+
+		assert.Equal(t, "Updated", document.Name)
+		assert.Equal(t, "https://example.com/image.png", *document.ImageURL)
+	})
+
+	t.Run("ShouldRemoveFieldWhenNull", func(t *testing.T) {
+		document := newDocument()
+
+		err := helper.MergePatch(&document, []byte(`{"imageUrl":null}`))
+		assert.NoError(t, err)
+
+		assert.Nil(t, document.ImageURL)
+		assert.Equal(t, "Name", document.Name)
+	})
+
+	t.Run("ShouldMergeNestedObjects", func(t *testing.T) {
+		document := newDocument()
+
+		err := helper.MergePatch(&document, []byte(`{"tags":{"a":null,"c":"3"}}`))
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[string]string{"b": "2", "c": "3"}, document.Tags)
+	})
+
+	t.Run("ShouldBeErrorWhenPatchIsNotObject", func(t *testing.T) {
+		document := newDocument()
+
+		err := helper.MergePatch(&document, []byte(`["name"]`))
+		assert.ErrorIs(t, err, global.ErrInvalidPatch)
+
+		assert.Equal(t, newDocument(), document)
+	})
+
+	t.Run("ShouldBeErrorWhenPatchIsMalformed", func(t *testing.T) {
+		document := newDocument()
+
+		err := helper.MergePatch(&document, []byte(`{`))
+		assert.ErrorIs(t, err, global.ErrInvalidPatch)
+	})
+
+	t.Run("ShouldBeErrorWhenTypeMismatch", func(t *testing.T) {
+		document := newDocument()
+
+		err := helper.MergePatch(&document, []byte(`{"name":1}`))
+		assert.ErrorIs(t, err, global.ErrInvalidPatch)
+
+		assert.Equal(t, newDocument(), document)
+	})
+}
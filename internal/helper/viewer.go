@@ -0,0 +1,20 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DecodeViewer ใช้ user id ถ้า login ไม่เช่นนั้นใช้ hash ของ IP กับ User-Agent
+// เก็บแค่ hash เพื่อไม่ต้องเก็บ IP ของผู้ชมไว้ในฐานข้อมูล
+func DecodeViewer(ctx *gin.Context) string {
+	if claims, err := DecodeClaims(ctx); err == nil && claims.ID != "" {
+		return "u:" + claims.ID
+	}
+
+	sum := sha256.Sum256([]byte(ctx.ClientIP() + "|" + ctx.GetHeader("User-Agent")))
+
+	return "a:" + hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,42 @@
+package helper_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newViewerContext(remoteAddr string, userAgent string) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx.Request.RemoteAddr = remoteAddr
+	ctx.Request.Header.Set("User-Agent", userAgent)
+
+	return ctx
+}
+
+func TestDecodeViewer(t *testing.T) {
+	t.Run("ShouldUseUserIDWhenLoggedIn", func(t *testing.T) {
+		ctx := newViewerContext("10.0.0.1:1234", "Firefox")
+		ctx.Set("claims", model.Claims{ID: "user-1"})
+
+		assert.Equal(t, "u:user-1", helper.DecodeViewer(ctx))
+	})
+
+	t.Run("ShouldHashIPAndUserAgentWhenAnonymous", func(t *testing.T) {
+		viewer := helper.DecodeViewer(newViewerContext("10.0.0.1:1234", "Firefox"))
+
+		assert.True(t, strings.HasPrefix(viewer, "a:"))
+		assert.NotContains(t, viewer, "10.0.0.1")
+		// port เปลี่ยนได้ทุก request จึงไม่นำมาคิด
+		assert.Equal(t, viewer, helper.DecodeViewer(newViewerContext("10.0.0.1:5678", "Firefox")))
+		assert.NotEqual(t, viewer, helper.DecodeViewer(newViewerContext("10.0.0.1:1234", "Chrome")))
+		assert.NotEqual(t, viewer, helper.DecodeViewer(newViewerContext("10.0.0.2:1234", "Firefox")))
+	})
+}
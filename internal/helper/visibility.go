@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// HiddenUsersSQL ผู้ใช้ที่ @viewer ไม่ควรเห็นเนื้อหา คือคนที่ mute ไว้และคนที่ block กันไม่ว่าฝ่ายไหนเป็นคน block
+const HiddenUsersSQL = `
+	SELECT muted_id FROM user_mutes WHERE muter_id = @viewer
+	UNION SELECT blocked_id FROM user_blocks WHERE blocker_id = @viewer
+	UNION SELECT blocker_id FROM user_blocks WHERE blocked_id = @viewer`
+
+// HideUsers ตัดแถวที่ column เป็นผู้ใช้ที่ viewerID ไม่ควรเห็น ไม่ได้ login (viewerID ว่าง) จะไม่กรอง
+func HideUsers(viewerID string, column string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewerID == "" {
+			return db
+		}
+
+		return db.Where(column+" NOT IN ("+HiddenUsersSQL+")", sql.Named("viewer", viewerID))
+	}
+}
@@ -7,34 +7,54 @@ import (
 	"wongnok/internal/model"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 )
 
+const bearerPrefix = "Bearer "
+
 func Authorize(verifier config.IOIDCTokenVerifier) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		bearerPrefix := "Bearer "
-
-		tokenWithBearer := ctx.GetHeader("Authorization")
-		if !strings.HasPrefix(tokenWithBearer, bearerPrefix) {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": http.StatusText(http.StatusUnauthorized)})
-			return
-		}
-
-		rawToken := strings.TrimPrefix(tokenWithBearer, bearerPrefix)
-		idToken, err := verifier.Verify(ctx.Request.Context(), rawToken)
+		claims, err := verifyClaims(ctx, verifier)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
 			return
 		}
 
-		var claims model.Claims
-		if err := idToken.Claims(&claims); err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
-			return
-		}
-
 		// Set claims in context
 		ctx.Set("claims", claims)
 
 		ctx.Next()
 	}
 }
+
+// OptionalAuthorize สำหรับ route สาธารณะที่อยากรู้ว่าใครเรียก
+// ไม่มี token หรือ token ใช้ไม่ได้จะทำงานต่อแบบไม่ได้ login
+func OptionalAuthorize(verifier config.IOIDCTokenVerifier) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if claims, err := verifyClaims(ctx, verifier); err == nil {
+			ctx.Set("claims", claims)
+		}
+
+		ctx.Next()
+	}
+}
+
+func verifyClaims(ctx *gin.Context, verifier config.IOIDCTokenVerifier) (model.Claims, error) {
+	tokenWithBearer := ctx.GetHeader("Authorization")
+	if !strings.HasPrefix(tokenWithBearer, bearerPrefix) {
+		return model.Claims{}, errors.New(http.StatusText(http.StatusUnauthorized))
+	}
+
+	rawToken := strings.TrimPrefix(tokenWithBearer, bearerPrefix)
+	idToken, err := verifier.Verify(ctx.Request.Context(), rawToken)
+	if err != nil {
+		return model.Claims{}, err
+	}
+
+	var claims model.Claims
+	if err := idToken.Claims(&claims); err != nil {
+		return model.Claims{}, err
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CacheControl ใส่ header Cache-Control ตาม policy ของแต่ละ route
+// เฉพาะ response ที่สำเร็จ เพื่อไม่ให้ CDN cache error ไว้
+func CacheControl(policy string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if policy != "" {
+			ctx.Writer = &cacheControlWriter{ResponseWriter: ctx.Writer, policy: policy}
+		}
+
+		ctx.Next()
+	}
+}
+
+type cacheControlWriter struct {
+	gin.ResponseWriter
+	policy string
+}
+
+func (writer *cacheControlWriter) WriteHeader(statusCode int) {
+	if statusCode < http.StatusBadRequest && writer.Header().Get("Cache-Control") == "" {
+		writer.Header().Set("Cache-Control", writer.policy)
+	}
+
+	writer.ResponseWriter.WriteHeader(statusCode)
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRole ผ่านเมื่อผู้เรียกมี role ใด role หนึ่ง ต้องใช้หลัง Authorize เพราะอ่าน claims จาก context
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, err := helper.DecodeClaims(ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+			return
+		}
+
+		if !claims.HasRole(roles...) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": global.ErrForbidden.Error()})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// RequirePermission ผ่านเมื่อ role ใด role หนึ่งของผู้เรียกให้สิทธิ์นี้ ต้องใช้หลัง Authorize เพราะอ่าน claims จาก context
+func RequirePermission(permission model.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, err := helper.DecodeClaims(ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+			return
+		}
+
+		if !claims.Can(permission) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": global.ErrForbidden.Error()})
+			return
+		}
+
+		ctx.Next()
+	}
+}
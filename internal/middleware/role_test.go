@@ -0,0 +1,94 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"wongnok/internal/middleware"
+	"wongnok/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := func(claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+		router.GET("/moderation", middleware.RequireRole(model.RoleAdmin, model.RoleModerator), func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/moderation", nil)
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	t.Run("ShouldPassWhenHasAnyRole", func(t *testing.T) {
+		claims := model.Claims{ID: "UID", RealmAccess: model.RoleAccess{Roles: []string{model.RoleModerator}}}
+
+		assert.Equal(t, http.StatusOK, server(&claims).Code)
+	})
+
+	t.Run("ShouldForbidWhenMissingRole", func(t *testing.T) {
+		claims := model.Claims{ID: "UID", RealmAccess: model.RoleAccess{Roles: []string{model.RoleEditor}}}
+
+		response := server(&claims)
+
+		assert.Equal(t, http.StatusForbidden, response.Code)
+		assert.Equal(t, `{"message":"forbidden"}`, response.Body.String())
+	})
+
+	t.Run("ShouldRejectWithoutClaims", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, server(nil).Code)
+	})
+}
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := func(claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+		router.POST("/difficulties", middleware.RequirePermission(model.PermissionManageReferenceData), func(ctx *gin.Context) {
+			ctx.Status(http.StatusCreated)
+		})
+
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPost, "/difficulties", nil)
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	t.Run("ShouldPassWhenRoleGrantsPermission", func(t *testing.T) {
+		claims := model.Claims{ID: "UID", RealmAccess: model.RoleAccess{Roles: []string{model.RoleAdmin}}}
+
+		assert.Equal(t, http.StatusCreated, server(&claims).Code)
+	})
+
+	t.Run("ShouldForbidWhenNoRoleGrantsPermission", func(t *testing.T) {
+		claims := model.Claims{ID: "UID", RealmAccess: model.RoleAccess{Roles: []string{model.RoleModerator, model.RoleEditor}}}
+
+		response := server(&claims)
+
+		assert.Equal(t, http.StatusForbidden, response.Code)
+		assert.Equal(t, `{"message":"forbidden"}`, response.Body.String())
+	})
+
+	t.Run("ShouldRejectWithoutClaims", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, server(nil).Code)
+	})
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+	"wongnok/internal/model/dto"
+)
+
+// สิ่งที่ทำกับ recipe ของบัญชีที่ถูกลบ ต้องตรงกับ CHECK ของ account_deletions.recipes
+const (
+	RecipesTransfer = "transfer"
+	RecipesDelete   = "delete"
+)
+
+// AccountDeletion คำขอลบบัญชี ผู้ใช้ถูก soft delete ทันทีและถูก purge เมื่อถึง PurgeAt
+// login ก่อนถึงกำหนดถือว่ายกเลิกคำขอ
+type AccountDeletion struct {
+	UserID       string `gorm:"primaryKey"`
+	Recipes      string
+	TransferToID *string
+	PurgeAt      time.Time
+	CreatedAt    time.Time
+}
+
+func (deletion AccountDeletion) FromRequest(request dto.AccountDeletionRequest, userID string, purgeAt time.Time) AccountDeletion {
+	result := AccountDeletion{
+		UserID:  userID,
+		Recipes: request.Recipes,
+		PurgeAt: purgeAt,
+	}
+
+	if request.Recipes == RecipesTransfer {
+		result.TransferToID = &request.TransferTo
+	}
+
+	return result
+}
+
+func (deletion AccountDeletion) ToResponse() dto.AccountDeletionResponse {
+	return dto.AccountDeletionResponse{
+		Recipes:    deletion.Recipes,
+		TransferTo: derefString(deletion.TransferToID),
+		PurgeAt:    deletion.PurgeAt,
+	}
+}
+
+// DeletedUser ผู้ใช้แทนที่ถือรีวิวและความคิดเห็นของบัญชีที่ถูก purge
+// แยกหนึ่งคนต่อบัญชี เพราะ unique index ของ ratings ไม่ให้ผู้ใช้คนเดียวรีวิวสูตรเดิมซ้ำ
+func DeletedUser(id string) User {
+	return User{
+		ID:        id,
+		FirstName: "Deleted",
+		LastName:  "user",
+		NickName:  "Deleted user",
+		ProfilePrivacy: ProfilePrivacy{
+			HideBio:               true,
+			HideAvatar:            true,
+			HideJoinedAt:          true,
+			HideRecipeCount:       true,
+			HideAverageRating:     true,
+			HideFavoritesReceived: true,
+		},
+	}
+}
+
+// AccountExport ข้อมูลส่วนบุคคลทั้งหมดของผู้ใช้สำหรับคำขอตาม PDPA
+type AccountExport struct {
+	User      User
+	Recipes   FoodRecipes
+	Ratings   Ratings
+	Favorites FoodRecipes
+}
+
+func (export AccountExport) ToResponse() dto.AccountExportResponse {
+	return dto.AccountExportResponse{
+		Profile:   export.User.ToAccountResponse(),
+		Recipes:   export.Recipes.ToResponse(int64(len(export.Recipes))),
+		Ratings:   export.Ratings.ToResponse(int64(len(export.Ratings))),
+		Favorites: export.Favorites.ToResponse(int64(len(export.Favorites))),
+	}
+}
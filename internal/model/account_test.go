@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountDeletion(t *testing.T) {
+	purgeAt := time.Date(2026, 11, 18, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldKeepTransferTargetWhenTransfer", func(t *testing.T) {
+		deletion := model.AccountDeletion{}.FromRequest(dto.AccountDeletionRequest{Recipes: model.RecipesTransfer, TransferTo: "Receiver"}, "UID", purgeAt)
+
+		assert.Equal(t, "UID", deletion.UserID)
+		assert.Equal(t, "Receiver", *deletion.TransferToID)
+		assert.Equal(t, dto.AccountDeletionResponse{Recipes: model.RecipesTransfer, TransferTo: "Receiver", PurgeAt: purgeAt}, deletion.ToResponse())
+	})
+
+	t.Run("ShouldIgnoreTransferTargetWhenDelete", func(t *testing.T) {
+		deletion := model.AccountDeletion{}.FromRequest(dto.AccountDeletionRequest{Recipes: model.RecipesDelete, TransferTo: "Receiver"}, "UID", purgeAt)
+
+		assert.Nil(t, deletion.TransferToID)
+		assert.Equal(t, "", deletion.ToResponse().TransferTo)
+	})
+}
+
+func TestDeletedUser(t *testing.T) {
+	t.Run("ShouldHideEveryProfileField", func(t *testing.T) {
+		user := model.DeletedUser("deleted-1")
+
+		assert.Equal(t, "deleted-1", user.ID)
+		assert.Equal(t, "", user.Email)
+		assert.Nil(t, user.ImageUrl)
+		assert.Equal(t, model.ProfilePrivacy{
+			HideBio:               true,
+			HideAvatar:            true,
+			HideJoinedAt:          true,
+			HideRecipeCount:       true,
+			HideAverageRating:     true,
+			HideFavoritesReceived: true,
+		}, user.ProfilePrivacy)
+	})
+}
+
+func TestAccountExportToResponse(t *testing.T) {
+	t.Run("ShouldReturnEmptyListsWhenNoData", func(t *testing.T) {
+		response := model.AccountExport{User: model.User{ID: "UID", Email: "demo@example.com"}}.ToResponse()
+
+		assert.Equal(t, "demo@example.com", response.Profile.Email)
+		assert.Equal(t, []dto.FoodRecipeResponse{}, response.Recipes.Results)
+		assert.Equal(t, []dto.RatingResponse{}, response.Ratings.Results)
+		assert.Equal(t, []dto.FoodRecipeResponse{}, response.Favorites.Results)
+	})
+}
@@ -26,4 +26,10 @@ type Claims struct {
 	ID        string `json:"sub" validate:"required"`
 	FirstName string `json:"given_name" validate:"required"`
 	LastName  string `json:"family_name" validate:"required"`
+	// มีเมื่อขอ scope email ใช้เป็นที่อยู่สำหรับส่ง digest
+	Email string `json:"email"`
+	// role จาก Keycloak ดูที่ Roles
+	AuthorizedParty string                `json:"azp"`
+	RealmAccess     RoleAccess            `json:"realm_access"`
+	ResourceAccess  map[string]RoleAccess `json:"resource_access"`
 }
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// UserBlock BlockerID และ BlockedID มองไม่เห็นเนื้อหาของกันและกัน และโต้ตอบกับเนื้อหาของอีกฝ่ายไม่ได้
+type UserBlock struct {
+	BlockerID string `gorm:"primaryKey"`
+	BlockedID string `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
+
+// UserMute ซ่อนเนื้อหาของ MutedID จาก MuterID ฝ่ายเดียว MutedID ยังโต้ตอบได้ตามปกติ
+type UserMute struct {
+	MuterID   string `gorm:"primaryKey"`
+	MutedID   string `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
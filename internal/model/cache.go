@@ -0,0 +1,40 @@
+package model
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// CacheValidator ข้อมูลที่ใช้สร้าง ETag และ Last-Modified ของ response
+type CacheValidator struct {
+	Version      uint
+	Count        int64
+	RatingCount  int64
+	RatingSum    float64
+	LastModified time.Time
+
+	// rating ของทุกสูตรรวมกัน หน้ารายละเอียดใช้ เพราะ weighted rating ขึ้นกับค่าเฉลี่ยรวม
+	PriorRatingCount int64
+	PriorRatingSum   float64
+}
+
+// Observe เลื่อน LastModified ไปเป็นเวลาที่ใหม่กว่า
+func (validator *CacheValidator) Observe(modifiedAt time.Time) {
+	if modifiedAt.After(validator.LastModified) {
+		validator.LastModified = modifiedAt
+	}
+}
+
+// ETag ขึ้นต้นด้วย version (ถ้ามี) เพื่อให้ใช้กับ If-Match ได้
+func (validator CacheValidator) ETag() string {
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%d:%d:%g:%d:%g:%d", validator.Count, validator.RatingCount, validator.RatingSum,
+		validator.PriorRatingCount, validator.PriorRatingSum, validator.LastModified.UnixMicro())
+
+	if validator.Version > 0 {
+		return fmt.Sprintf(`"%d-%x"`, validator.Version, hash.Sum64())
+	}
+
+	return fmt.Sprintf(`"%x"`, hash.Sum64())
+}
@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCacheValidatorETag(t *testing.T) {
+	mockTime := time.Date(2025, 7, 9, 10, 10, 10, 0, time.UTC)
+
+	t.Run("ShouldPrefixVersion", func(t *testing.T) {
+		etag := model.CacheValidator{Version: 3, LastModified: mockTime}.ETag()
+
+		assert.True(t, strings.HasPrefix(etag, `"3-`))
+	})
+
+	t.Run("ShouldChangeWhenRatingChanges", func(t *testing.T) {
+		before := model.CacheValidator{Version: 1, RatingCount: 1, RatingSum: 4, LastModified: mockTime}
+		after := model.CacheValidator{Version: 1, RatingCount: 2, RatingSum: 9, LastModified: mockTime}
+
+		assert.NotEqual(t, before.ETag(), after.ETag())
+	})
+
+	t.Run("ShouldChangeWhenPriorRatingChanges", func(t *testing.T) {
+		before := model.CacheValidator{Version: 1, PriorRatingCount: 3, PriorRatingSum: 12, LastModified: mockTime}
+		after := model.CacheValidator{Version: 1, PriorRatingCount: 4, PriorRatingSum: 13, LastModified: mockTime}
+
+		assert.NotEqual(t, before.ETag(), after.ETag())
+	})
+}
+
+func TestFoodRecipeCacheValidator(t *testing.T) {
+	t.Run("ShouldUseLatestModification", func(t *testing.T) {
+		updatedAt := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+		ratedAt := time.Date(2025, 7, 10, 10, 0, 0, 0, time.UTC)
+
+		recipe := model.FoodRecipe{
+			Model:   gorm.Model{ID: 1, UpdatedAt: updatedAt},
+			Version: 2,
+			RatingSummary: model.RatingSummary{
+				RatingCount:     2,
+				RatingSum:       9,
+				RatingUpdatedAt: &ratedAt,
+			},
+			PriorRatingCount: 5,
+			PriorRatingSum:   21,
+		}
+
+		expectedValidator := model.CacheValidator{
+			Version:          2,
+			RatingCount:      2,
+			RatingSum:        9,
+			PriorRatingCount: 5,
+			PriorRatingSum:   21,
+			LastModified:     ratedAt,
+		}
+
+		assert.Equal(t, expectedValidator, recipe.CacheValidator())
+	})
+}
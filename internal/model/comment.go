@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+// ข้อความที่แสดงแทนความคิดเห็นที่ถูกลบ
+const CommentRemovedBody = "comment removed"
+
+type Comment struct {
+	gorm.Model
+	FoodRecipeID uint
+	UserID       string
+	User         User
+	ParentID     *uint
+	Body         string
+	PinnedAt     *time.Time
+	Replies      Comments `gorm:"foreignKey:ParentID"`
+}
+
+func (comment Comment) FromRequest(request dto.CommentRequest, claims Claims) Comment {
+	return Comment{
+		Model:        comment.Model,
+		FoodRecipeID: comment.FoodRecipeID,
+		ParentID:     request.ParentID,
+		Body:         request.Body,
+		UserID:       claims.ID,
+	}
+}
+
+func (comment Comment) IsRemoved() bool {
+	return comment.DeletedAt.Valid
+}
+
+func (comment Comment) ToResponse() dto.CommentResponse {
+	response := dto.CommentResponse{
+		ID:           comment.ID,
+		FoodRecipeID: comment.FoodRecipeID,
+		ParentID:     comment.ParentID,
+		Body:         comment.Body,
+		Pinned:       comment.PinnedAt != nil,
+		CreatedAt:    comment.CreatedAt,
+		UpdatedAt:    comment.UpdatedAt,
+	}
+
+	if comment.IsRemoved() {
+		// ความคิดเห็นที่ถูกลบยังคงแสดงอยู่ เพื่อไม่ให้กระทู้ขาดบริบท
+		response.Body = CommentRemovedBody
+		response.Removed = true
+	} else if comment.User.ID != "" {
+		user := comment.User.ToResponse()
+		response.User = &user
+	}
+
+	for _, reply := range comment.Replies {
+		response.Replies = append(response.Replies, reply.ToResponse())
+	}
+
+	return response
+}
+
+type Comments []Comment
+
+func (comments Comments) ToResponse(total int64) dto.CommentsResponse {
+	var results = make([]dto.CommentResponse, 0)
+
+	for _, comment := range comments {
+		results = append(results, comment.ToResponse())
+	}
+
+	return dto.CommentsResponse{
+		Total:   total,
+		Results: results,
+	}
+}
+
+type CommentQuery struct {
+	Page  int `form:"page" binding:"required,min=1"`
+	Limit int `form:"limit" binding:"required,min=1"`
+	// ผู้ใช้ที่ login ไม่เห็นความคิดเห็นของคนที่ mute หรือ block กัน
+	ViewerID string `form:"-"`
+}
@@ -0,0 +1,46 @@
+package model_test
+
+import (
+	"testing"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCommentToResponse(t *testing.T) {
+	t.Run("ShouldReturnCommentWithReplies", func(t *testing.T) {
+		parentID := uint(1)
+		comment := model.Comment{
+			Model:        gorm.Model{ID: 1},
+			FoodRecipeID: 1,
+			Body:         "Body",
+			User:         model.User{ID: "UID"},
+			Replies: model.Comments{
+				{Model: gorm.Model{ID: 2}, FoodRecipeID: 1, ParentID: &parentID, Body: "Reply"},
+			},
+		}
+
+		response := comment.ToResponse()
+
+		assert.Equal(t, "Body", response.Body)
+		assert.False(t, response.Removed)
+		assert.Equal(t, "UID", response.User.ID)
+		assert.Len(t, response.Replies, 1)
+		assert.Equal(t, &parentID, response.Replies[0].ParentID)
+	})
+
+	t.Run("ShouldHideBodyAndUserWhenRemoved", func(t *testing.T) {
+		comment := model.Comment{
+			Model: gorm.Model{ID: 1, DeletedAt: gorm.DeletedAt{Valid: true}},
+			Body:  "Body",
+			User:  model.User{ID: "UID"},
+		}
+
+		response := comment.ToResponse()
+
+		assert.Equal(t, model.CommentRemovedBody, response.Body)
+		assert.True(t, response.Removed)
+		assert.Nil(t, response.User)
+	})
+}
@@ -1,8 +1,92 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
 
 type CookingDuration struct {
 	gorm.Model
-	Name string
+	Name      string
+	NameTH    string `gorm:"column:name_th"`
+	SortOrder int
+	RetiredAt *time.Time
+	// ช่วงเวลารวมเป็นนาที ใช้หาหมวดเวลาจาก prepMinutes + cookMinutes
+	MinMinutes int
+	MaxMinutes *int // nil คือไม่มีขอบบน เช่น 60+
+}
+
+// openEndedSpanMinutes ช่วงที่ใช้คิดค่ากลางของหมวดเวลาที่ไม่มีขอบบน
+const openEndedSpanMinutes = 30
+
+func (duration CookingDuration) FromRequest(request dto.CookingDurationRequest) CookingDuration {
+	return CookingDuration{
+		Model:      duration.Model,
+		Name:       request.Name,
+		NameTH:     request.NameTH,
+		SortOrder:  request.SortOrder,
+		RetiredAt:  duration.RetiredAt,
+		MinMinutes: request.MinMinutes,
+		MaxMinutes: request.MaxMinutes,
+	}
+}
+
+// MidpointMinutes เวลาโดยประมาณของ recipe ที่ระบุแค่หมวดเวลา
+// ต้องได้ค่าเดียวกับ migration ที่ backfill เวลาของ recipe เดิม
+func (duration CookingDuration) MidpointMinutes() int {
+	if duration.MaxMinutes == nil {
+		return duration.MinMinutes + openEndedSpanMinutes
+	}
+
+	return (duration.MinMinutes + *duration.MaxMinutes + 1) / 2
+}
+
+// IsRetired ค่าที่เลิกใช้แล้วยังแสดงใน recipe เดิมได้ แต่ห้ามใช้กับ recipe ใหม่
+func (duration CookingDuration) IsRetired() bool {
+	return duration.RetiredAt != nil
+}
+
+func (duration CookingDuration) LocalizedName(language string) string {
+	if language == LanguageThai && duration.NameTH != "" {
+		return duration.NameTH
+	}
+
+	return duration.Name
+}
+
+func (duration CookingDuration) ToResponse(language string) dto.CookingDurationResponse {
+	names := map[string]string{LanguageEnglish: duration.Name}
+	if duration.NameTH != "" {
+		names[LanguageThai] = duration.NameTH
+	}
+
+	return dto.CookingDurationResponse{
+		ID:         duration.ID,
+		Name:       duration.LocalizedName(language),
+		Names:      names,
+		SortOrder:  duration.SortOrder,
+		Retired:    duration.IsRetired(),
+		MinMinutes: duration.MinMinutes,
+		MaxMinutes: duration.MaxMinutes,
+	}
+}
+
+type CookingDurations []CookingDuration
+
+func (durations CookingDurations) ToResponse(language string) dto.CookingDurationsResponse {
+	var results = make([]dto.CookingDurationResponse, 0)
+
+	for _, duration := range durations {
+		results = append(results, duration.ToResponse(language))
+	}
+
+	return dto.CookingDurationsResponse{
+		Results: results,
+	}
+}
+
+type ReferenceDataQuery struct {
+	IncludeRetired bool `form:"includeRetired"`
 }
@@ -1,8 +1,68 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
 
 type Difficulty struct {
 	gorm.Model
-	Name string
+	Name      string
+	NameTH    string `gorm:"column:name_th"`
+	SortOrder int
+	RetiredAt *time.Time
+}
+
+func (difficulty Difficulty) FromRequest(request dto.DifficultyRequest) Difficulty {
+	return Difficulty{
+		Model:     difficulty.Model,
+		Name:      request.Name,
+		NameTH:    request.NameTH,
+		SortOrder: request.SortOrder,
+		RetiredAt: difficulty.RetiredAt,
+	}
+}
+
+// IsRetired ค่าที่เลิกใช้แล้วยังแสดงใน recipe เดิมได้ แต่ห้ามใช้กับ recipe ใหม่
+func (difficulty Difficulty) IsRetired() bool {
+	return difficulty.RetiredAt != nil
+}
+
+func (difficulty Difficulty) LocalizedName(language string) string {
+	if language == LanguageThai && difficulty.NameTH != "" {
+		return difficulty.NameTH
+	}
+
+	return difficulty.Name
+}
+
+func (difficulty Difficulty) ToResponse(language string) dto.DifficultyResponse {
+	names := map[string]string{LanguageEnglish: difficulty.Name}
+	if difficulty.NameTH != "" {
+		names[LanguageThai] = difficulty.NameTH
+	}
+
+	return dto.DifficultyResponse{
+		ID:        difficulty.ID,
+		Name:      difficulty.LocalizedName(language),
+		Names:     names,
+		SortOrder: difficulty.SortOrder,
+		Retired:   difficulty.IsRetired(),
+	}
+}
+
+type Difficulties []Difficulty
+
+func (difficulties Difficulties) ToResponse(language string) dto.DifficultiesResponse {
+	var results = make([]dto.DifficultyResponse, 0)
+
+	for _, difficulty := range difficulties {
+		results = append(results, difficulty.ToResponse(language))
+	}
+
+	return dto.DifficultiesResponse{
+		Results: results,
+	}
 }
@@ -0,0 +1,96 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestDifficultyToResponse(t *testing.T) {
+	t.Run("ShouldLocalizeName", func(t *testing.T) {
+		difficulty := model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy", NameTH: "ง่าย", SortOrder: 1}
+
+		expected := dto.DifficultyResponse{
+			ID:        1,
+			Name:      "ง่าย",
+			Names:     map[string]string{"en": "Easy", "th": "ง่าย"},
+			SortOrder: 1,
+		}
+
+		assert.Equal(t, expected, difficulty.ToResponse(model.LanguageThai))
+		assert.Equal(t, "Easy", difficulty.ToResponse(model.LanguageEnglish).Name)
+	})
+
+	t.Run("ShouldFallbackToEnglishWithoutThaiName", func(t *testing.T) {
+		difficulty := model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy"}
+
+		response := difficulty.ToResponse(model.LanguageThai)
+
+		assert.Equal(t, "Easy", response.Name)
+		assert.Equal(t, map[string]string{"en": "Easy"}, response.Names)
+	})
+
+	t.Run("ShouldMarkRetired", func(t *testing.T) {
+		retiredAt := time.Now()
+		difficulty := model.Difficulty{Name: "Easy", RetiredAt: &retiredAt}
+
+		assert.True(t, difficulty.IsRetired())
+		assert.True(t, difficulty.ToResponse(model.LanguageEnglish).Retired)
+	})
+}
+
+func TestDifficultyFromRequest(t *testing.T) {
+	t.Run("ShouldKeepIDAndRetiredAt", func(t *testing.T) {
+		retiredAt := time.Now()
+		difficulty := model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Easy", RetiredAt: &retiredAt}
+
+		expected := model.Difficulty{Model: gorm.Model{ID: 1}, Name: "Simple", NameTH: "ง่าย", SortOrder: 3, RetiredAt: &retiredAt}
+
+		assert.Equal(t, expected, difficulty.FromRequest(dto.DifficultyRequest{Name: "Simple", NameTH: "ง่าย", SortOrder: 3}))
+	})
+}
+
+func TestCookingDurationToResponse(t *testing.T) {
+	t.Run("ShouldLocalizeName", func(t *testing.T) {
+		duration := model.CookingDuration{Model: gorm.Model{ID: 2}, Name: "11 - 30", NameTH: "11 - 30 นาที", SortOrder: 2}
+
+		assert.Equal(t, "11 - 30 นาที", duration.ToResponse(model.LanguageThai).Name)
+		assert.Equal(t, "11 - 30", duration.ToResponse(model.LanguageEnglish).Name)
+	})
+
+	t.Run("ShouldIncludeMinuteRange", func(t *testing.T) {
+		maxMinutes := 30
+		duration := model.CookingDuration{Model: gorm.Model{ID: 2}, Name: "11 - 30", MinMinutes: 11, MaxMinutes: &maxMinutes}
+
+		response := duration.ToResponse(model.LanguageEnglish)
+
+		assert.Equal(t, 11, response.MinMinutes)
+		assert.Equal(t, &maxMinutes, response.MaxMinutes)
+	})
+
+	t.Run("ShouldReturnEmptyResults", func(t *testing.T) {
+		response := model.CookingDurations{}.ToResponse(model.LanguageEnglish)
+
+		assert.NotNil(t, response.Results)
+		assert.Empty(t, response.Results)
+	})
+}
+
+func TestCookingDurationMidpointMinutes(t *testing.T) {
+	t.Run("ShouldRoundMidpoint", func(t *testing.T) {
+		maxMinutes := 10
+		duration := model.CookingDuration{MinMinutes: 5, MaxMinutes: &maxMinutes}
+
+		assert.Equal(t, 8, duration.MidpointMinutes())
+	})
+
+	t.Run("ShouldExtendOpenEndedDuration", func(t *testing.T) {
+		duration := model.CookingDuration{MinMinutes: 61}
+
+		assert.Equal(t, 91, duration.MidpointMinutes())
+	})
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// AccountDeletionRequest recipes เป็น transfer ต้องระบุผู้รับ transferTo
+type AccountDeletionRequest struct {
+	Recipes    string `json:"recipes" binding:"required,oneof=transfer delete"`
+	TransferTo string `json:"transferTo" binding:"required_if=Recipes transfer"`
+}
+
+type AccountDeletionResponse struct {
+	Recipes    string    `json:"recipes"`
+	TransferTo string    `json:"transferTo,omitempty"`
+	PurgeAt    time.Time `json:"purgeAt"`
+}
+
+// AccountExportResponse แต่ละช่องเป็นไฟล์ JSON หนึ่งไฟล์ใน ZIP
+type AccountExportResponse struct {
+	Profile   AccountResponse
+	Recipes   FoodRecipesResponse
+	Ratings   RatingsResponse
+	Favorites FoodRecipesResponse
+}
@@ -11,6 +11,10 @@ type CredentialResponse struct {
 	IDToken      string    `json:"idToken"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
 type KeycloakCallbackQuery struct {
 	State string `form:"state"`
 	Code  string `form:"code"`
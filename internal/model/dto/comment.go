@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type CommentRequest struct {
+	Body     string `validate:"required,max=2000"`
+	ParentID *uint
+}
+
+type CommentUpdateRequest struct {
+	Body string `validate:"required,max=2000"`
+}
+
+type CommentResponse struct {
+	ID           uint              `json:"id"`
+	FoodRecipeID uint              `json:"foodRecipeID"`
+	ParentID     *uint             `json:"parentID,omitempty"`
+	Body         string            `json:"body"`
+	Removed      bool              `json:"removed"`
+	Pinned       bool              `json:"pinned"`
+	User         *UserResponse     `json:"user,omitempty"`
+	Replies      []CommentResponse `json:"replies,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+}
+
+type CommentsResponse BaseListResponse[[]CommentResponse]
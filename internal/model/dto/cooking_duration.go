@@ -1,6 +1,21 @@
 package dto
 
+type CookingDurationRequest struct {
+	Name       string `validate:"required,max=100"`
+	NameTH     string `validate:"max=100"`
+	SortOrder  int    `validate:"min=0"`
+	MinMinutes int    `validate:"min=0"`
+	MaxMinutes *int   `validate:"omitempty,gtefield=MinMinutes"`
+}
+
 type CookingDurationResponse struct {
-	ID   uint   `json:"id"`
-	Name string `json:"name,omitempty"`
+	ID         uint              `json:"id"`
+	Name       string            `json:"name,omitempty"`
+	Names      map[string]string `json:"names,omitempty"`
+	SortOrder  int               `json:"sortOrder,omitempty"`
+	Retired    bool              `json:"retired,omitempty"`
+	MinMinutes int               `json:"minMinutes,omitempty"`
+	MaxMinutes *int              `json:"maxMinutes,omitempty"`
 }
+
+type CookingDurationsResponse BaseListResponse[[]CookingDurationResponse]
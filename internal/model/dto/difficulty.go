@@ -1,6 +1,17 @@
 package dto
 
+type DifficultyRequest struct {
+	Name      string `validate:"required,max=255"`
+	NameTH    string `validate:"max=255"`
+	SortOrder int    `validate:"min=0"`
+}
+
 type DifficultyResponse struct {
-	ID   uint   `json:"id"`
-	Name string `json:"name,omitempty"`
+	ID        uint              `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	Names     map[string]string `json:"names,omitempty"`
+	SortOrder int               `json:"sortOrder,omitempty"`
+	Retired   bool              `json:"retired,omitempty"`
 }
+
+type DifficultiesResponse BaseListResponse[[]DifficultyResponse]
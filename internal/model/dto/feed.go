@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// FeedItemResponse มี Recipe หรือ Review อย่างใดอย่างหนึ่งตาม Type
+type FeedItemResponse struct {
+	Type      string              `json:"type"`
+	CreatedAt time.Time           `json:"createdAt"`
+	User      UserResponse        `json:"user"`
+	Recipe    *FoodRecipeResponse `json:"recipe,omitempty"`
+	Review    *RatingResponse     `json:"review,omitempty"`
+}
+
+// FeedResponse ไม่มี NextCursor แปลว่าถึงรายการสุดท้ายแล้ว
+type FeedResponse struct {
+	Results    []FeedItemResponse `json:"results"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
@@ -3,13 +3,17 @@ package dto
 import "time"
 
 type FoodRecipeRequest struct {
-	Name              string  `validate:"required"`
-	Description       string  `validate:"required"`
-	Ingredient        string  `validate:"required"`
-	Instruction       string  `validate:"required"`
-	ImageURL          *string `validate:"omitempty,url"`
-	CookingDurationID uint    `validate:"required"`
-	DifficultyID      uint    `validate:"required"`
+	Name              string   `validate:"required"`
+	Description       string   `validate:"required"`
+	Ingredient        string   `validate:"required"`
+	Instruction       string   `validate:"required"`
+	ImageURL          *string  `validate:"omitempty,url"`
+	PrepMinutes       *int     `validate:"omitempty,min=0,max=1440"`
+	CookMinutes       *int     `validate:"omitempty,min=0,max=1440"`
+	CookingDurationID uint     `validate:"required_without_all=PrepMinutes CookMinutes"` // คำนวณจากเวลารวมแทนเมื่อส่งนาทีมา
+	DifficultyID      uint     `validate:"required"`
+	Allergens         []string `validate:"omitempty,unique,dive,oneof=peanut tree_nut shellfish fish gluten dairy egg soy sesame"`
+	Diets             []string `validate:"omitempty,unique,dive,oneof=vegan vegetarian halal gluten_free"`
 }
 
 type FoodRecipeResponse struct {
@@ -19,12 +23,23 @@ type FoodRecipeResponse struct {
 	Ingredient      string                  `json:"ingredient"`
 	Instruction     string                  `json:"instruction"`
 	ImageURL        *string                 `json:"imageUrl,omitempty"`
+	PrepMinutes     int                     `json:"prepMinutes"`
+	CookMinutes     int                     `json:"cookMinutes"`
+	TotalMinutes    int                     `json:"totalMinutes"`
 	CookingDuration CookingDurationResponse `json:"cookingDuration"`
 	Difficulty      DifficultyResponse      `json:"difficulty"`
+	Allergens       []LabelResponse         `json:"allergens,omitempty"`
+	Diets           []LabelResponse         `json:"diets,omitempty"`
 	CreatedAt       time.Time               `json:"createdAt"`
 	UpdatedAt       time.Time               `json:"updatedAt"`
 	AverageRating   float64                 `json:"averageRating"`
-	User            UserResponse            `json:"user"`
+	WeightedRating  float64                 `json:"weightedRating,omitempty"`
+	RatingCount     int64                   `json:"ratingCount"`
+	RatingHistogram RatingHistogramResponse `json:"ratingHistogram"`
+	// ส่งเฉพาะเมื่อผู้เรียก login
+	IsFavorited   *bool        `json:"isFavorited,omitempty"`
+	FavoriteCount *int64       `json:"favoriteCount,omitempty"`
+	User          UserResponse `json:"user"`
 }
 
 type FoodRecipesResponse BaseListResponse[[]FoodRecipeResponse]
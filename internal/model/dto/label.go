@@ -0,0 +1,6 @@
+package dto
+
+type LabelResponse struct {
+	Code   string `json:"code"`
+	Source string `json:"source"`
+}
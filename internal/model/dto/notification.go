@@ -0,0 +1,40 @@
+package dto
+
+import "time"
+
+type NotificationResponse struct {
+	ID           uint         `json:"id"`
+	Type         string       `json:"type"`
+	Actor        UserResponse `json:"actor"`
+	FoodRecipeID *uint        `json:"foodRecipeId,omitempty"`
+	RatingID     *uint        `json:"ratingId,omitempty"`
+	CommentID    *uint        `json:"commentId,omitempty"`
+	Read         bool         `json:"read"`
+	ReadAt       *time.Time   `json:"readAt,omitempty"`
+	CreatedAt    time.Time    `json:"createdAt"`
+}
+
+type NotificationsResponse BaseListResponse[[]NotificationResponse]
+
+type NotificationUnreadCountResponse struct {
+	Count int64 `json:"count"`
+}
+
+// NotificationPreferencesRequest ส่งเฉพาะช่องที่ต้องการเปลี่ยน
+type NotificationPreferencesRequest struct {
+	Rating      *bool   `json:"rating"`
+	Favorite    *bool   `json:"favorite"`
+	Comment     *bool   `json:"comment"`
+	Follow      *bool   `json:"follow"`
+	EmailDigest *bool   `json:"emailDigest"`
+	EmailLocale *string `json:"emailLocale" binding:"omitempty,oneof=th en"`
+}
+
+type NotificationPreferencesResponse struct {
+	Rating      bool   `json:"rating"`
+	Favorite    bool   `json:"favorite"`
+	Comment     bool   `json:"comment"`
+	Follow      bool   `json:"follow"`
+	EmailDigest bool   `json:"emailDigest"`
+	EmailLocale string `json:"emailLocale"`
+}
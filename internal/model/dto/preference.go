@@ -0,0 +1,18 @@
+package dto
+
+// PreferencesRequest แทนที่เอกสารเดิมทั้งหมด ช่องที่ไม่ได้ส่งมาจะกลับเป็นค่าว่าง
+type PreferencesRequest struct {
+	Language            string   `json:"language" binding:"required,oneof=en th"`
+	Units               string   `json:"units" binding:"required,oneof=metric imperial"`
+	Diets               []string `json:"diets" binding:"omitempty,max=4,unique,dive,oneof=vegan vegetarian halal gluten_free"`
+	DislikedIngredients []string `json:"dislikedIngredients" binding:"omitempty,max=50,dive,required,max=50"`
+	DefaultSort         string   `json:"defaultSort" binding:"omitempty,oneof=name rating"`
+}
+
+type PreferencesResponse struct {
+	Language            string   `json:"language"`
+	Units               string   `json:"units"`
+	Diets               []string `json:"diets"`
+	DislikedIngredients []string `json:"dislikedIngredients"`
+	DefaultSort         string   `json:"defaultSort"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// ProfileResponse ช่องที่เจ้าของเลือกซ่อนจะไม่ถูกส่งมา
+type ProfileResponse struct {
+	ID                string              `json:"id"`
+	FirstName         string              `json:"firstName"`
+	LastName          string              `json:"lastName"`
+	Nickname          string              `json:"nickName"`
+	Handle            string              `json:"handle,omitempty"`
+	Bio               *string             `json:"bio,omitempty"`
+	ImageUrl          *string             `json:"imageUrl,omitempty"`
+	JoinedAt          *time.Time          `json:"joinedAt,omitempty"`
+	RecipeCount       *int64              `json:"recipeCount,omitempty"`
+	AverageRating     *float64            `json:"averageRating,omitempty"`
+	FavoritesReceived *int64              `json:"favoritesReceived,omitempty"`
+	FollowerCount     int64               `json:"followerCount"`
+	FollowingCount    int64               `json:"followingCount"`
+	IsFollowing       *bool               `json:"isFollowing,omitempty"`
+	Recipes           FoodRecipesResponse `json:"recipes"`
+}
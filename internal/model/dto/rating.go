@@ -1,13 +1,45 @@
 package dto
 
+import "time"
+
 type RatingRequest struct {
-	Score float64 `validate:"required"`
+	// 1 ถึง 5 ทีละครึ่งดาว ratingstep ลงทะเบียนไว้ใน rating.Service
+	Score float64 `validate:"required,min=1,max=5,ratingstep" minimum:"1" maximum:"5" multipleOf:"0.5" example:"4.5"`
+	Title string  `validate:"max=120"`
+	Body  string  `validate:"max=5000"`
+	// รูปได้ไม่เกิน 5 รูป
+	Photos   []string `validate:"max=5,unique,dive,url"`
+	CookedOn string   `validate:"omitempty,datetime=2006-01-02" example:"2026-10-18"`
 }
 
 type RatingResponse struct {
-	Score        float64 `json:"score"`
-	FoodRecipeID uint    `json:"foodRecipeID"`
-	UserID       string  `json:"userID"`
+	ID             uint          `json:"id"`
+	Score          float64       `json:"score"`
+	Title          string        `json:"title,omitempty"`
+	Body           string        `json:"body,omitempty"`
+	Photos         []string      `json:"photos,omitempty"`
+	CookedOn       string        `json:"cookedOn,omitempty"`
+	HelpfulCount   int           `json:"helpfulCount"`
+	UnhelpfulCount int           `json:"unhelpfulCount"`
+	FoodRecipeID   uint          `json:"foodRecipeID"`
+	UserID         string        `json:"userID"`
+	User           *UserResponse `json:"user,omitempty"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
 }
 
 type RatingsResponse BaseListResponse[[]RatingResponse]
+
+type RatingVoteRequest struct {
+	// pointer เพราะ false ก็เป็นค่าที่ส่งมาได้
+	Helpful *bool `validate:"required"`
+}
+
+// RatingHistogramResponse จำนวน rating แยกตามดาว ครึ่งดาวนับรวมกับดาวเต็มที่ต่ำกว่า
+type RatingHistogramResponse struct {
+	Star1 int64 `json:"1"`
+	Star2 int64 `json:"2"`
+	Star3 int64 `json:"3"`
+	Star4 int64 `json:"4"`
+	Star5 int64 `json:"5"`
+}
@@ -5,10 +5,38 @@ type UserResponse struct {
 	FirstName string `json:"firstName"`
 	LastName  string `json:"lastName"`
 	Nickname  string `json:"nickName"`
+	Handle    string `json:"handle,omitempty"`
 	ImageUrl  string `json:"imageUrl"`
 }
 
+// HandleRequest กฎของ handle ดู model.ValidateHandle
+type HandleRequest struct {
+	Handle string `json:"handle" validate:"required"`
+}
+
 type UserRequest struct {
 	NickName string `validate:"required"`
 	ImageUrl string `validate:"required"`
+	Bio      string `validate:"max=500"`
+	Privacy  ProfilePrivacy
+}
+
+// AccountResponse UserResponse ของผู้ใช้เอง พร้อมช่องที่ผู้อื่นไม่เห็น
+type AccountResponse struct {
+	UserResponse
+	Email   string         `json:"email"`
+	Bio     string         `json:"bio"`
+	Privacy ProfilePrivacy `json:"privacy"`
 }
+
+// ProfilePrivacy true คือซ่อนช่องนั้นจากโปรไฟล์สาธารณะ
+type ProfilePrivacy struct {
+	HideBio               bool `json:"hideBio"`
+	HideAvatar            bool `json:"hideAvatar"`
+	HideJoinedAt          bool `json:"hideJoinedAt"`
+	HideRecipeCount       bool `json:"hideRecipeCount"`
+	HideAverageRating     bool `json:"hideAverageRating"`
+	HideFavoritesReceived bool `json:"hideFavoritesReceived"`
+}
+
+type UsersResponse BaseListResponse[[]UserResponse]
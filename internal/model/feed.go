@@ -0,0 +1,120 @@
+package model
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/model/dto"
+
+	"github.com/pkg/errors"
+)
+
+// ชนิดของรายการใน feed ต้องตรงกับค่าที่ query ใน feed.Repository
+const (
+	FeedItemRecipe = "recipe"
+	FeedItemReview = "review"
+)
+
+// FeedEntry ผลจาก query ที่รวมทุกชนิด ยังไม่มีข้อมูลของรายการ
+// เรียงตาม CreatedAt, Type, ID จากมากไปน้อย
+type FeedEntry struct {
+	Type      string
+	ID        uint
+	CreatedAt time.Time
+}
+
+// FeedCursor ตำแหน่งของรายการสุดท้ายในหน้าก่อน หน้าถัดไปเริ่มจากรายการที่น้อยกว่านี้
+type FeedCursor FeedEntry
+
+func (cursor FeedCursor) Encode() string {
+	raw := strings.Join([]string{
+		cursor.CreatedAt.UTC().Format(time.RFC3339Nano),
+		cursor.Type,
+		strconv.FormatUint(uint64(cursor.ID), 10),
+	}, "|")
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeFeedCursor(value string) (FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return FeedCursor{}, errors.Wrap(global.ErrInvalidCursor, err.Error())
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 || (parts[1] != FeedItemRecipe && parts[1] != FeedItemReview) {
+		return FeedCursor{}, global.ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return FeedCursor{}, errors.Wrap(global.ErrInvalidCursor, err.Error())
+	}
+
+	id, err := strconv.ParseUint(parts[2], 10, 0)
+	if err != nil {
+		return FeedCursor{}, errors.Wrap(global.ErrInvalidCursor, err.Error())
+	}
+
+	return FeedCursor{CreatedAt: createdAt, Type: parts[1], ID: uint(id)}, nil
+}
+
+// FeedQuery ไม่ส่ง Cursor มาคือหน้าแรก
+type FeedQuery struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"min=1,max=100"`
+}
+
+// FeedItem มี Recipe หรือ Review อย่างใดอย่างหนึ่งตาม Type
+type FeedItem struct {
+	FeedEntry
+	Recipe *FoodRecipe
+	Review *Rating
+}
+
+func (item FeedItem) ToResponse() dto.FeedItemResponse {
+	response := dto.FeedItemResponse{
+		Type:      item.Type,
+		CreatedAt: item.CreatedAt,
+	}
+
+	if item.Recipe != nil {
+		recipe := item.Recipe.ToResponse()
+		response.Recipe = &recipe
+		response.User = recipe.User
+	}
+
+	if item.Review != nil {
+		review := item.Review.ToResponse()
+		response.Review = &review
+		response.User = item.Review.User.ToResponse()
+	}
+
+	return response
+}
+
+type Feed struct {
+	Items      []FeedItem
+	NextCursor *FeedCursor
+}
+
+func (feed Feed) ToResponse() dto.FeedResponse {
+	var results = make([]dto.FeedItemResponse, 0)
+
+	for _, item := range feed.Items {
+		results = append(results, item.ToResponse())
+	}
+
+	response := dto.FeedResponse{
+		Results: results,
+	}
+
+	if feed.NextCursor != nil {
+		response.NextCursor = feed.NextCursor.Encode()
+	}
+
+	return response
+}
@@ -0,0 +1,96 @@
+package model_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestFeedCursor(t *testing.T) {
+	t.Run("ShouldDecodeEncodedCursor", func(t *testing.T) {
+		cursor := model.FeedCursor{
+			CreatedAt: time.Date(2026, 10, 19, 8, 30, 0, 123456000, time.UTC),
+			Type:      model.FeedItemReview,
+			ID:        42,
+		}
+
+		decoded, err := model.DecodeFeedCursor(cursor.Encode())
+
+		assert.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("ShouldErrorWhenNotBase64", func(t *testing.T) {
+		_, err := model.DecodeFeedCursor("%%%")
+
+		assert.ErrorIs(t, err, global.ErrInvalidCursor)
+	})
+
+	t.Run("ShouldErrorWhenTypeUnknown", func(t *testing.T) {
+		value := base64.RawURLEncoding.EncodeToString([]byte("2026-10-19T08:30:00Z|cookbook|1"))
+
+		_, err := model.DecodeFeedCursor(value)
+
+		assert.ErrorIs(t, err, global.ErrInvalidCursor)
+	})
+
+	t.Run("ShouldErrorWhenIDInvalid", func(t *testing.T) {
+		value := base64.RawURLEncoding.EncodeToString([]byte("2026-10-19T08:30:00Z|recipe|x"))
+
+		_, err := model.DecodeFeedCursor(value)
+
+		assert.ErrorIs(t, err, global.ErrInvalidCursor)
+	})
+}
+
+func TestFeedToResponse(t *testing.T) {
+	mockTime := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldFillItemByType", func(t *testing.T) {
+		feed := model.Feed{
+			Items: []model.FeedItem{
+				{
+					FeedEntry: model.FeedEntry{Type: model.FeedItemRecipe, ID: 1, CreatedAt: mockTime},
+					Recipe:    &model.FoodRecipe{Model: gorm.Model{ID: 1}, User: model.User{ID: "Cook"}},
+				},
+				{
+					FeedEntry: model.FeedEntry{Type: model.FeedItemReview, ID: 2, CreatedAt: mockTime},
+					Review:    &model.Rating{Model: gorm.Model{ID: 2}, Body: "Body", User: model.User{ID: "Reviewer"}},
+				},
+			},
+		}
+
+		response := feed.ToResponse()
+
+		assert.Len(t, response.Results, 2)
+		assert.Equal(t, "recipe", response.Results[0].Type)
+		assert.Equal(t, uint(1), response.Results[0].Recipe.ID)
+		assert.Equal(t, "Cook", response.Results[0].User.ID)
+		assert.Nil(t, response.Results[0].Review)
+		assert.Equal(t, "review", response.Results[1].Type)
+		assert.Equal(t, "Body", response.Results[1].Review.Body)
+		assert.Equal(t, "Reviewer", response.Results[1].User.ID)
+		assert.Empty(t, response.NextCursor)
+	})
+
+	t.Run("ShouldEncodeNextCursor", func(t *testing.T) {
+		cursor := model.FeedCursor{Type: model.FeedItemRecipe, ID: 1, CreatedAt: mockTime}
+
+		response := model.Feed{NextCursor: &cursor}.ToResponse()
+
+		assert.Equal(t, cursor.Encode(), response.NextCursor)
+		assert.NotNil(t, response.Results)
+	})
+}
+
+func TestUsersToResponse(t *testing.T) {
+	response := model.Users{{ID: "ID", NickName: "NickName"}}.ToResponse(3)
+
+	assert.Equal(t, int64(3), response.Total)
+	assert.Equal(t, "NickName", response.Results[0].Nickname)
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+	"wongnok/internal/model/dto"
+)
+
+// Follow FollowerID ติดตาม FolloweeID ติดตามตัวเองไม่ได้ (CHECK ใน follows)
+type Follow struct {
+	FollowerID string `gorm:"primaryKey"`
+	FolloweeID string `gorm:"primaryKey"`
+	CreatedAt  time.Time
+}
+
+// FollowQuery ไม่ส่งมาจะได้หน้าแรก คนที่ติดตามล่าสุดก่อน
+type FollowQuery struct {
+	Page  int `form:"page" binding:"min=1"`
+	Limit int `form:"limit" binding:"min=1,max=100"`
+}
+
+type Users []User
+
+func (users Users) ToResponse(total int64) dto.UsersResponse {
+	var results = make([]dto.UserResponse, 0)
+
+	for _, user := range users {
+		results = append(results, user.ToResponse())
+	}
+
+	return dto.UsersResponse{
+		Total:   total,
+		Results: results,
+	}
+}
@@ -13,17 +13,37 @@ type FoodRecipe struct {
 	Ingredient        string
 	Instruction       string
 	ImageURL          *string
+	PrepMinutes       int
+	CookMinutes       int
+	TotalMinutes      int
 	CookingDurationID uint
 	CookingDuration   CookingDuration
 	DifficultyID      uint
 	Difficulty        Difficulty
-	Ratings           Ratings
-	AverageRating     float64 `gorm:"-"`
+	Allergens         Labels `gorm:"type:jsonb"` // ผู้เขียนระบุเอง ส่วนที่เดาจากส่วนผสมคำนวณตอนอ่าน
+	Diets             Labels `gorm:"type:jsonb"`
+	RatingSummary     `gorm:"embedded"`
+	AverageRating     float64        `gorm:"-"`
+	WeightedRating    float64        `gorm:"->;-:migration"` // คำนวณใน query ของรายการและหน้ารายละเอียดเท่านั้น
+	Favorite          *FavoriteState `gorm:"-"`              // มีเฉพาะเมื่อผู้เรียก login
 	UserID            string
 	User              User
+	Version           uint `gorm:"default:1"`
+
+	// rating ของทุกสูตรรวมกันที่ใช้คำนวณ WeightedRating ได้มาพร้อม WeightedRating
+	PriorRatingCount int64   `gorm:"->;-:migration"`
+	PriorRatingSum   float64 `gorm:"->;-:migration"`
 }
 
 func (recipe FoodRecipe) FromRequest(request dto.FoodRecipeRequest, claims Claims) FoodRecipe {
+	var prepMinutes, cookMinutes int
+	if request.PrepMinutes != nil {
+		prepMinutes = *request.PrepMinutes
+	}
+	if request.CookMinutes != nil {
+		cookMinutes = *request.CookMinutes
+	}
+
 	return FoodRecipe{
 		Model:             recipe.Model,
 		Name:              request.Name,
@@ -31,20 +51,45 @@ func (recipe FoodRecipe) FromRequest(request dto.FoodRecipeRequest, claims Claim
 		Ingredient:        request.Ingredient,
 		Instruction:       request.Instruction,
 		ImageURL:          request.ImageURL,
+		PrepMinutes:       prepMinutes,
+		CookMinutes:       cookMinutes,
+		TotalMinutes:      prepMinutes + cookMinutes,
 		CookingDurationID: request.CookingDurationID,
 		DifficultyID:      request.DifficultyID,
+		Allergens:         Labels(request.Allergens),
+		Diets:             Labels(request.Diets),
 		UserID:            claims.ID,
+		Version:           recipe.Version,
+	}
+}
+
+func (recipe FoodRecipe) ToRequest() dto.FoodRecipeRequest {
+	return dto.FoodRecipeRequest{
+		Name:              recipe.Name,
+		Description:       recipe.Description,
+		Ingredient:        recipe.Ingredient,
+		Instruction:       recipe.Instruction,
+		ImageURL:          recipe.ImageURL,
+		PrepMinutes:       &recipe.PrepMinutes,
+		CookMinutes:       &recipe.CookMinutes,
+		CookingDurationID: recipe.CookingDurationID,
+		DifficultyID:      recipe.DifficultyID,
+		Allergens:         []string(recipe.Allergens),
+		Diets:             []string(recipe.Diets),
 	}
 }
 
 func (recipe FoodRecipe) ToResponse() dto.FoodRecipeResponse {
-	return dto.FoodRecipeResponse{
-		ID:          recipe.ID,
-		Name:        recipe.Name,
-		Description: recipe.Description,
-		Ingredient:  recipe.Ingredient,
-		Instruction: recipe.Instruction,
-		ImageURL:    recipe.ImageURL,
+	response := dto.FoodRecipeResponse{
+		ID:           recipe.ID,
+		Name:         recipe.Name,
+		Description:  recipe.Description,
+		Ingredient:   recipe.Ingredient,
+		Instruction:  recipe.Instruction,
+		ImageURL:     recipe.ImageURL,
+		PrepMinutes:  recipe.PrepMinutes,
+		CookMinutes:  recipe.CookMinutes,
+		TotalMinutes: recipe.TotalMinutes,
 		CookingDuration: dto.CookingDurationResponse{
 			ID:   recipe.CookingDuration.ID,
 			Name: recipe.CookingDuration.Name,
@@ -53,11 +98,43 @@ func (recipe FoodRecipe) ToResponse() dto.FoodRecipeResponse {
 			ID:   recipe.Difficulty.ID,
 			Name: recipe.Difficulty.Name,
 		},
-		AverageRating: recipe.AverageRating,
-		User:          recipe.User.ToResponse(),
-		CreatedAt:     recipe.CreatedAt,
-		UpdatedAt:     recipe.UpdatedAt,
+		Allergens:       recipe.Allergens.ToResponse(InferAllergens(recipe.Ingredient), Allergens),
+		Diets:           recipe.Diets.ToResponse(nil, Diets),
+		AverageRating:   recipe.AverageRating,
+		WeightedRating:  recipe.WeightedRating,
+		RatingCount:     recipe.RatingCount,
+		RatingHistogram: recipe.RatingSummary.HistogramResponse(),
+		User:            recipe.User.ToResponse(),
+		CreatedAt:       recipe.CreatedAt,
+		UpdatedAt:       recipe.UpdatedAt,
 	}
+
+	if recipe.Favorite != nil {
+		response.IsFavorited = &recipe.Favorite.IsFavorited
+		response.FavoriteCount = &recipe.Favorite.FavoriteCount
+	}
+
+	return response
+}
+
+// CacheValidator ของ recipe จาก GetByIDWithRating ต้องได้ค่าเดียวกับ Repository.GetCacheValidatorByID
+func (recipe FoodRecipe) CacheValidator() CacheValidator {
+	validator := CacheValidator{
+		Version:          recipe.Version,
+		RatingCount:      recipe.RatingCount,
+		RatingSum:        recipe.RatingSum,
+		PriorRatingCount: recipe.PriorRatingCount,
+		PriorRatingSum:   recipe.PriorRatingSum,
+		LastModified:     recipe.UpdatedAt,
+	}
+
+	validator.Observe(recipe.User.UpdatedAt)
+
+	if recipe.RatingUpdatedAt != nil {
+		validator.Observe(*recipe.RatingUpdatedAt)
+	}
+
+	return validator
 }
 
 type FoodRecipes []FoodRecipe
@@ -76,35 +153,79 @@ func (recipes FoodRecipes) ToResponse(total int64) dto.FoodRecipesResponse {
 }
 
 func (recipe FoodRecipe) CalculateAverageRating() FoodRecipe {
-	if len(recipe.Ratings) > 0 {
-		var totalRating float64
-		for _, rating := range recipe.Ratings {
-			totalRating += rating.Score
-		}
-		recipe.AverageRating = totalRating / float64(len(recipe.Ratings))
-	} else {
-		recipe.AverageRating = 0
-	}
+	recipe.AverageRating = recipe.RatingSummary.Average()
 	return recipe
 }
 
 func (recipes FoodRecipes) CalculateAverageRatings() FoodRecipes {
 	for i, recipe := range recipes {
-		if len(recipe.Ratings) > 0 {
-			var totalRating float64
-			for _, rating := range recipe.Ratings {
-				totalRating += rating.Score
-			}
-			recipes[i].AverageRating = totalRating / float64(len(recipe.Ratings))
-		} else {
-			recipes[i].AverageRating = 0
-		}
+		recipes[i] = recipe.CalculateAverageRating()
+	}
+	return recipes
+}
+
+// FavoriteState ยอด favorite ของ recipe และสถานะของผู้เรียก
+type FavoriteState struct {
+	FoodRecipeID  uint
+	FavoriteCount int64
+	IsFavorited   bool
+}
+
+// WithFavoriteStates recipe ที่ไม่มีใน states คือยังไม่มีใคร favorite
+func (recipes FoodRecipes) WithFavoriteStates(states []FavoriteState) FoodRecipes {
+	byRecipe := make(map[uint]FavoriteState, len(states))
+	for _, state := range states {
+		byRecipe[state.FoodRecipeID] = state
+	}
+
+	for i, recipe := range recipes {
+		state := byRecipe[recipe.ID]
+		state.FoodRecipeID = recipe.ID
+		recipes[i].Favorite = &state
 	}
+
 	return recipes
 }
 
+// การเรียงรายการ ต้องตรงกับ oneof ใน FoodRecipeQuery
+const (
+	FoodRecipeSortName   = "name"
+	FoodRecipeSortRating = "rating"
+)
+
 type FoodRecipeQuery struct {
-	Search string `form:"search"`
-	Page   int    `form:"page" binding:"required,min=1"`  // page number for pagination
-	Limit  int    `form:"limit" binding:"required,min=1"` // number of items per page
+	Search          string `form:"search"`
+	Page            int    `form:"page" binding:"required,min=1"`  // page number for pagination
+	Limit           int    `form:"limit" binding:"required,min=1"` // number of items per page
+	MaxTotalMinutes int    `form:"maxTotalMinutes" binding:"omitempty,min=1"`
+	// ส่งหลายค่าด้วยการใส่ซ้ำ เช่น ?diet=vegan&diet=halal
+	ExcludeAllergens []string `form:"excludeAllergens" binding:"omitempty,dive,oneof=peanut tree_nut shellfish fish gluten dairy egg soy sesame"`
+	Diet             []string `form:"diet" binding:"omitempty,dive,oneof=vegan vegetarian halal gluten_free"`
+	// ตัด recipe ที่ส่วนผสมมีคำนี้ ไม่สนตัวพิมพ์
+	ExcludeIngredients []string `form:"excludeIngredients" binding:"omitempty,max=50,dive,required,max=50"`
+	// true คือไม่ใช้ค่าเริ่มต้นจาก preferences ของผู้ใช้ที่ login
+	IgnorePreferences bool `form:"ignorePreferences"`
+	// rating เรียงตาม weighted rating ไม่ใช่ค่าเฉลี่ยตรง ๆ
+	Sort string `form:"sort" binding:"omitempty,oneof=name rating"`
+	// มาจาก config.Rating
+	RatingPriorWeight float64 `form:"-"`
+	// ผู้ใช้ที่ login ไม่เห็น recipe ของคนที่ mute หรือ block กัน
+	ViewerID string `form:"-"`
+}
+
+// WithPreferences ใช้ค่าจาก preferences กับช่องที่ request ไม่ได้ส่งมาเท่านั้น
+func (query FoodRecipeQuery) WithPreferences(preferences UserPreferences) FoodRecipeQuery {
+	if len(query.Diet) == 0 {
+		query.Diet = preferences.Diets
+	}
+
+	if len(query.ExcludeIngredients) == 0 {
+		query.ExcludeIngredients = preferences.DislikedIngredients
+	}
+
+	if query.Sort == "" {
+		query.Sort = preferences.DefaultSort
+	}
+
+	return query
 }
@@ -85,6 +85,67 @@ func TestFoodRecipeFromRequest(t *testing.T) {
 	})
 }
 
+func TestFoodRecipeFromRequestMinutes(t *testing.T) {
+	t.Run("ShouldDeriveTotalMinutes", func(t *testing.T) {
+		prepMinutes, cookMinutes := 10, 25
+
+		request := dto.FoodRecipeRequest{
+			Name:              "Name",
+			PrepMinutes:       &prepMinutes,
+			CookMinutes:       &cookMinutes,
+			CookingDurationID: 3,
+		}
+
+		recipe := model.FoodRecipe{}.FromRequest(request, model.Claims{ID: "UID"})
+
+		assert.Equal(t, 10, recipe.PrepMinutes)
+		assert.Equal(t, 25, recipe.CookMinutes)
+		assert.Equal(t, 35, recipe.TotalMinutes)
+	})
+
+	t.Run("ShouldTreatMissingMinutesAsZero", func(t *testing.T) {
+		cookMinutes := 20
+
+		recipe := model.FoodRecipe{}.FromRequest(dto.FoodRecipeRequest{CookMinutes: &cookMinutes}, model.Claims{})
+
+		assert.Equal(t, 0, recipe.PrepMinutes)
+		assert.Equal(t, 20, recipe.TotalMinutes)
+	})
+}
+
+func TestFoodRecipeToRequest(t *testing.T) {
+	t.Run("ShouldReturnFoodRecipeRequest", func(t *testing.T) {
+		imageURL := "ImageURL"
+
+		recipe := model.FoodRecipe{
+			Model:             gorm.Model{ID: 1},
+			Name:              "Name",
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			ImageURL:          &imageURL,
+			CookingDurationID: 1,
+			DifficultyID:      2,
+			UserID:            "UID",
+		}
+
+		prepMinutes, cookMinutes := 0, 0
+		expectedRequest := dto.FoodRecipeRequest{
+			Name:              "Name",
+			Description:       "Description",
+			Ingredient:        "Ingredient",
+			Instruction:       "Instruction",
+			ImageURL:          &imageURL,
+			PrepMinutes:       &prepMinutes,
+			CookMinutes:       &cookMinutes,
+			CookingDurationID: 1,
+			DifficultyID:      2,
+		}
+
+		assert.Equal(t, expectedRequest, recipe.ToRequest())
+	})
+}
+
 func TestFoodRecipeToResponse(t *testing.T) {
 	mockTime := time.Date(2025, 7, 9, 10, 10, 10, 0, time.Local)
 
@@ -215,14 +276,7 @@ func TestCalculateAverageRating(t *testing.T) {
 				Model: gorm.Model{ID: 2},
 				Name:  "DifficultyName",
 			},
-			Ratings: model.Ratings{
-				model.Rating{
-					Score: 5,
-				},
-				model.Rating{
-					Score: 4,
-				},
-			},
+			RatingSummary: model.RatingSummary{}.Add(5, 1).Add(4, 1),
 			User: model.User{
 				ID: "UID",
 			},
@@ -280,14 +334,7 @@ func TestCalculateAverageRatings(t *testing.T) {
 					Model: gorm.Model{ID: 2},
 					Name:  "DifficultyName",
 				},
-				Ratings: model.Ratings{
-					model.Rating{
-						Score: 5,
-					},
-					model.Rating{
-						Score: 4,
-					},
-				},
+				RatingSummary: model.RatingSummary{}.Add(5, 1).Add(4, 1),
 				User: model.User{
 					ID: "UID",
 				},
@@ -307,14 +354,7 @@ func TestCalculateAverageRatings(t *testing.T) {
 					Model: gorm.Model{ID: 2},
 					Name:  "DifficultyName",
 				},
-				Ratings: model.Ratings{
-					model.Rating{
-						Score: 3,
-					},
-					model.Rating{
-						Score: 1,
-					},
-				},
+				RatingSummary: model.RatingSummary{}.Add(3, 1).Add(1, 1),
 				User: model.User{
 					ID: "UID",
 				},
@@ -347,3 +387,37 @@ func TestCalculateAverageRatings(t *testing.T) {
 		assert.Equal(t, float64(0), result[2].AverageRating)
 	})
 }
+
+func TestFoodRecipeToResponseRatingSummary(t *testing.T) {
+	t.Run("ShouldIncludeCountAndHistogram", func(t *testing.T) {
+		recipe := model.FoodRecipe{
+			RatingSummary:  model.RatingSummary{}.Add(5, 2).Add(4.5, 1).Add(1, 1),
+			WeightedRating: 4.1,
+		}
+
+		response := recipe.CalculateAverageRating().ToResponse()
+
+		assert.Equal(t, int64(4), response.RatingCount)
+		assert.Equal(t, 4.1, response.WeightedRating)
+		assert.Equal(t, 3.875, response.AverageRating)
+		assert.Equal(t, dto.RatingHistogramResponse{Star1: 1, Star4: 1, Star5: 2}, response.RatingHistogram)
+	})
+}
+
+func TestFoodRecipeToResponseFavorite(t *testing.T) {
+	t.Run("ShouldOmitWhenNotFilled", func(t *testing.T) {
+		response := model.FoodRecipe{}.ToResponse()
+
+		assert.Nil(t, response.IsFavorited)
+		assert.Nil(t, response.FavoriteCount)
+	})
+
+	t.Run("ShouldSetFromFavoriteState", func(t *testing.T) {
+		recipes := model.FoodRecipes{{}}.WithFavoriteStates(nil)
+
+		response := recipes[0].ToResponse()
+
+		assert.Equal(t, false, *response.IsFavorited)
+		assert.Equal(t, int64(0), *response.FavoriteCount)
+	})
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"wongnok/internal/global"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	HandleMinLength = 3
+	HandleMaxLength = 30
+	// HandleRedirectPeriod handle เดิมยังพาไปหาเจ้าของได้นานเท่านี้หลังเปลี่ยน
+	HandleRedirectPeriod = 90 * 24 * time.Hour
+)
+
+// ReservedHandles ชนกับ path หรือทำให้เข้าใจผิดว่าเป็นบัญชีของระบบ
+var ReservedHandles = []string{
+	"self", "me", "admin", "administrator", "moderator", "editor",
+	"root", "system", "support", "api", "wongnok", "deleted", "null",
+}
+
+var handlePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// HandleRedirect handle เดิมของผู้ใช้ ระหว่างนี้ผู้อื่นยังเอาไปใช้ไม่ได้
+type HandleRedirect struct {
+	Handle    string `gorm:"primaryKey"`
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// NormalizeHandle ตัด @ ข้างหน้าและทำเป็นตัวพิมพ์เล็ก ใช้เทียบและค้นหา handle
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(handle, "@"))
+}
+
+// ValidateHandle ขึ้นต้นด้วยตัวอักษร ตามด้วยตัวอักษร ตัวเลข หรือ _ ไม่สนตัวพิมพ์
+func ValidateHandle(handle string) error {
+	normalized := strings.ToLower(handle)
+
+	if len(normalized) < HandleMinLength || len(normalized) > HandleMaxLength {
+		return errors.Wrapf(global.ErrInvalidHandle, "length must be %d-%d characters", HandleMinLength, HandleMaxLength)
+	}
+
+	if !handlePattern.MatchString(normalized) {
+		return errors.Wrap(global.ErrInvalidHandle, "must start with a letter and contain only letters, digits and underscores")
+	}
+
+	if slices.Contains(ReservedHandles, normalized) {
+		return global.ErrHandleReserved
+	}
+
+	return nil
+}
+
+// HandleFromName handle ตั้งต้นจากชื่อ เหลือที่ไว้ให้ HandleCandidates ต่อท้ายได้
+// ชื่อที่ไม่มีตัวอักษรภาษาอังกฤษ เช่น ชื่อภาษาไทย ได้ user แทน
+func HandleFromName(firstName string, lastName string) string {
+	var parts []string
+	for _, name := range []string{firstName, lastName} {
+		var part strings.Builder
+		for _, char := range strings.ToLower(name) {
+			if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') {
+				part.WriteRune(char)
+			}
+		}
+		if part.Len() > 0 {
+			parts = append(parts, part.String())
+		}
+	}
+
+	handle := strings.Join(parts, "_")
+	if handle == "" || !handlePattern.MatchString(handle) {
+		handle = strings.TrimSuffix("user_"+handle, "_")
+	}
+
+	if limit := HandleMaxLength - 9; len(handle) > limit {
+		handle = strings.TrimRight(handle[:limit], "_")
+	}
+
+	for len(handle) < HandleMinLength {
+		handle += "0"
+	}
+
+	return handle
+}
+
+// HandleCandidates ลองชื่อตรง ๆ ก่อน ถ้าถูกใช้แล้วต่อท้ายด้วยส่วนต้นของ user id
+func HandleCandidates(base string, userID string) []string {
+	var suffix strings.Builder
+	for _, char := range strings.ToLower(userID) {
+		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') {
+			suffix.WriteRune(char)
+		}
+		if suffix.Len() == 8 {
+			break
+		}
+	}
+
+	candidates := []string{base}
+	if suffix.Len() > 0 {
+		candidates = append(candidates, base+"_"+suffix.String())
+	}
+
+	return candidates
+}
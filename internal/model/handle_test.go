@@ -0,0 +1,63 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHandle(t *testing.T) {
+	t.Run("ShouldAcceptLettersDigitsAndUnderscores", func(t *testing.T) {
+		for _, handle := range []string{"abc", "Chef_Demo", "somchai99", strings.Repeat("a", 30)} {
+			assert.NoError(t, model.ValidateHandle(handle), handle)
+		}
+	})
+
+	t.Run("ShouldRejectInvalidHandle", func(t *testing.T) {
+		for _, handle := range []string{"", "ab", strings.Repeat("a", 31), "9chef", "_chef", "chef-demo", "chef demo", "@chef", "เชฟ"} {
+			assert.ErrorIs(t, model.ValidateHandle(handle), global.ErrInvalidHandle, handle)
+		}
+	})
+
+	t.Run("ShouldRejectReservedHandleIgnoringCase", func(t *testing.T) {
+		for _, handle := range []string{"self", "Admin", "MODERATOR"} {
+			assert.ErrorIs(t, model.ValidateHandle(handle), global.ErrHandleReserved, handle)
+		}
+	})
+}
+
+func TestNormalizeHandle(t *testing.T) {
+	assert.Equal(t, "chef_demo", model.NormalizeHandle("@Chef_Demo"))
+	assert.Equal(t, "chef_demo", model.NormalizeHandle("chef_demo"))
+}
+
+func TestHandleFromName(t *testing.T) {
+	cases := map[string][2]string{
+		"demo_tester":           {"Demo", "Tester"},
+		"maryjane":              {"Mary-Jane", ""},
+		"user":                  {"สมชาย", "ใจดี"},
+		"user_007":              {"007", ""},
+		"al0":                   {"Al", ""},
+		"abcdefghijklmnopqrstu": {"abcdefghijklmnopqrstuvwxyz", ""},
+		"abcdefghijklmnopqrst":  {"abcdefghijklmnopqrst", "x"},
+	}
+
+	for expected, name := range cases {
+		handle := model.HandleFromName(name[0], name[1])
+
+		assert.Equal(t, expected, handle, name)
+		assert.NoError(t, model.ValidateHandle(handle), name)
+	}
+}
+
+func TestHandleCandidates(t *testing.T) {
+	assert.Equal(t, []string{"demo_tester", "demo_tester_38fa4e9e"}, model.HandleCandidates("demo_tester", "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"))
+	assert.Equal(t, []string{"demo_tester"}, model.HandleCandidates("demo_tester", ""))
+
+	for _, candidate := range model.HandleCandidates(model.HandleFromName(strings.Repeat("a", 40), ""), "38fa4e9e-27de") {
+		assert.LessOrEqual(t, len(candidate), model.HandleMaxLength)
+	}
+}
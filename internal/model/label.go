@@ -0,0 +1,174 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+	"strings"
+	"wongnok/internal/model/dto"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AllergenPeanut    = "peanut"
+	AllergenTreeNut   = "tree_nut"
+	AllergenShellfish = "shellfish"
+	AllergenFish      = "fish"
+	AllergenGluten    = "gluten"
+	AllergenDairy     = "dairy"
+	AllergenEgg       = "egg"
+	AllergenSoy       = "soy"
+	AllergenSesame    = "sesame"
+)
+
+const (
+	DietVegan      = "vegan"
+	DietVegetarian = "vegetarian"
+	DietHalal      = "halal"
+	DietGlutenFree = "gluten_free"
+)
+
+// ที่มาของป้าย ผู้เขียนระบุเอง หรือเดาจากส่วนผสม
+const (
+	LabelDeclared = "declared"
+	LabelInferred = "inferred"
+)
+
+// Allergens ลำดับนี้ใช้เรียงป้ายใน response ต้องตรงกับ oneof ใน dto.FoodRecipeRequest
+var Allergens = []string{
+	AllergenPeanut,
+	AllergenTreeNut,
+	AllergenShellfish,
+	AllergenFish,
+	AllergenGluten,
+	AllergenDairy,
+	AllergenEgg,
+	AllergenSoy,
+	AllergenSesame,
+}
+
+// Diets ต้องตรงกับ oneof ใน dto.FoodRecipeRequest
+var Diets = []string{DietVegan, DietVegetarian, DietHalal, DietGlutenFree}
+
+// AllergenRule คำที่บ่งบอกสารก่อภูมิแพ้ในส่วนผสม
+// Except คือวลีที่มีคำเหล่านั้นอยู่แต่ไม่ใช่สารก่อภูมิแพ้ เช่น coconut milk ไม่ใช่ dairy
+type AllergenRule struct {
+	Keywords []string
+	Except   []string
+}
+
+// AllergenRules ใช้ทั้งตอนเดาป้ายใน Go และตอนกรองใน SQL จึงต้องเป็นตัวพิมพ์เล็กทั้งหมด
+var AllergenRules = map[string]AllergenRule{
+	AllergenPeanut: {
+		Keywords: []string{"peanut", "ถั่วลิสง"},
+	},
+	AllergenTreeNut: {
+		Keywords: []string{"almond", "cashew", "walnut", "pecan", "hazelnut", "pistachio", "macadamia", "อัลมอนด์", "มะม่วงหิมพานต์", "วอลนัท"},
+	},
+	AllergenShellfish: {
+		Keywords: []string{"shrimp", "prawn", "crab", "lobster", "oyster", "mussel", "clam", "scallop", "squid", "กุ้ง", "ปู", "หอย", "ปลาหมึก"},
+		Except:   []string{"ปูเล่"},
+	},
+	AllergenFish: {
+		Keywords: []string{"fish", "salmon", "tuna", "anchovy", "ปลา", "น้ำปลา"},
+		Except:   []string{"ปลาหมึก"},
+	},
+	AllergenGluten: {
+		Keywords: []string{"wheat", "flour", "bread", "pasta", "barley", "แป้งสาลี", "ขนมปัง", "บะหมี่"},
+		Except:   []string{"rice flour", "corn flour", "tapioca flour", "แป้งข้าวเจ้า", "แป้งมัน"},
+	},
+	AllergenDairy: {
+		Keywords: []string{"milk", "butter", "cheese", "cream", "yogurt", "นม", "เนย", "ชีส", "ครีม", "โยเกิร์ต"},
+		Except:   []string{"coconut milk", "coconut cream", "peanut butter", "soy milk", "almond milk", "oat milk", "นมถั่วเหลือง", "นมอัลมอนด์", "เนยถั่ว", "ขนม"},
+	},
+	AllergenEgg: {
+		Keywords: []string{"egg", "mayonnaise", "ไข่", "มายองเนส"},
+		Except:   []string{"eggplant", "ไข่มุก"},
+	},
+	AllergenSoy: {
+		Keywords: []string{"soy", "tofu", "edamame", "miso", "ถั่วเหลือง", "เต้าหู้", "ซีอิ๊ว", "เต้าเจี้ยว"},
+	},
+	AllergenSesame: {
+		Keywords: []string{"sesame", "tahini", "งาขาว", "งาดำ", "น้ำมันงา"},
+	},
+}
+
+// Matches ตรวจจากตัวพิมพ์เล็กหลังตัดวลียกเว้นออก
+func (rule AllergenRule) Matches(text string) bool {
+	text = strings.ToLower(text)
+	for _, except := range rule.Except {
+		text = strings.ReplaceAll(text, except, "")
+	}
+
+	for _, keyword := range rule.Keywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InferAllergens เดาสารก่อภูมิแพ้จากส่วนผสม ใช้เป็นคำแนะนำเท่านั้น
+func InferAllergens(ingredient string) Labels {
+	var labels = make(Labels, 0)
+
+	for _, allergen := range Allergens {
+		if AllergenRules[allergen].Matches(ingredient) {
+			labels = append(labels, allergen)
+		}
+	}
+
+	return labels
+}
+
+// Labels เก็บเป็น jsonb array ของ code
+type Labels []string
+
+func (labels Labels) Value() (driver.Value, error) {
+	if labels == nil {
+		return "[]", nil
+	}
+
+	encoded, err := json.Marshal([]string(labels))
+	if err != nil {
+		return nil, err
+	}
+
+	return string(encoded), nil
+}
+
+func (labels *Labels) Scan(value any) error {
+	var raw []byte
+
+	switch value := value.(type) {
+	case nil:
+		*labels = Labels{}
+		return nil
+	case []byte:
+		raw = value
+	case string:
+		raw = []byte(value)
+	default:
+		return errors.Errorf("unsupported labels type %T", value)
+	}
+
+	return json.Unmarshal(raw, (*[]string)(labels))
+}
+
+// ToResponse รวมป้ายที่ผู้เขียนระบุกับป้ายที่เดาได้ เรียงตามลำดับใน order
+func (labels Labels) ToResponse(inferred Labels, order []string) []dto.LabelResponse {
+	var results []dto.LabelResponse
+
+	for _, code := range order {
+		switch {
+		case slices.Contains(labels, code):
+			results = append(results, dto.LabelResponse{Code: code, Source: LabelDeclared})
+		case slices.Contains(inferred, code):
+			results = append(results, dto.LabelResponse{Code: code, Source: LabelInferred})
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,112 @@
+package model_test
+
+import (
+	"testing"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferAllergens(t *testing.T) {
+	t.Run("ShouldInferFromEnglishAndThaiKeywords", func(t *testing.T) {
+		labels := model.InferAllergens("2 Eggs, กุ้งสด 200 g, น้ำปลา, ถั่วลิสงคั่ว")
+
+		assert.Equal(t, model.Labels{model.AllergenPeanut, model.AllergenShellfish, model.AllergenFish, model.AllergenEgg}, labels)
+	})
+
+	t.Run("ShouldIgnoreExceptPhrases", func(t *testing.T) {
+		labels := model.InferAllergens("coconut milk, eggplant, ปลาหมึก")
+
+		assert.Equal(t, model.Labels{model.AllergenShellfish}, labels)
+	})
+
+	t.Run("ShouldReturnEmptyWhenNothingMatches", func(t *testing.T) {
+		assert.Equal(t, model.Labels{}, model.InferAllergens("rice, water"))
+	})
+}
+
+func TestLabelsValue(t *testing.T) {
+	t.Run("ShouldEncodeJSONArray", func(t *testing.T) {
+		value, err := model.Labels{"vegan", "halal"}.Value()
+		assert.NoError(t, err)
+
+		assert.Equal(t, `["vegan","halal"]`, value)
+	})
+
+	t.Run("ShouldEncodeNilAsEmptyArray", func(t *testing.T) {
+		value, err := model.Labels(nil).Value()
+		assert.NoError(t, err)
+
+		assert.Equal(t, "[]", value)
+	})
+}
+
+func TestLabelsScan(t *testing.T) {
+	t.Run("ShouldDecodeBytes", func(t *testing.T) {
+		var labels model.Labels
+		assert.NoError(t, labels.Scan([]byte(`["egg"]`)))
+
+		assert.Equal(t, model.Labels{"egg"}, labels)
+	})
+
+	t.Run("ShouldDecodeNullAsEmpty", func(t *testing.T) {
+		var labels model.Labels
+		assert.NoError(t, labels.Scan(nil))
+
+		assert.Equal(t, model.Labels{}, labels)
+	})
+
+	t.Run("ShouldErrorWhenTypeUnsupported", func(t *testing.T) {
+		var labels model.Labels
+		assert.Error(t, labels.Scan(1))
+	})
+}
+
+func TestLabelsToResponse(t *testing.T) {
+	t.Run("ShouldPreferDeclaredAndKeepOrder", func(t *testing.T) {
+		declared := model.Labels{model.AllergenEgg}
+		inferred := model.Labels{model.AllergenPeanut, model.AllergenEgg}
+
+		expected := []dto.LabelResponse{
+			{Code: model.AllergenPeanut, Source: model.LabelInferred},
+			{Code: model.AllergenEgg, Source: model.LabelDeclared},
+		}
+
+		assert.Equal(t, expected, declared.ToResponse(inferred, model.Allergens))
+	})
+}
+
+func TestLabelsMatchRequestValidation(t *testing.T) {
+	validate := validator.New()
+	request := dto.FoodRecipeRequest{
+		Name:              "Name",
+		Description:       "Description",
+		Ingredient:        "Ingredient",
+		Instruction:       "Instruction",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}
+
+	t.Run("ShouldAcceptEveryKnownLabel", func(t *testing.T) {
+		request.Allergens = model.Allergens
+		request.Diets = model.Diets
+
+		assert.NoError(t, validate.Struct(request))
+	})
+
+	t.Run("ShouldRejectUnknownLabel", func(t *testing.T) {
+		request.Allergens = []string{"unknown"}
+		request.Diets = nil
+
+		assert.Error(t, validate.Struct(request))
+	})
+
+	t.Run("ShouldRejectDuplicateLabel", func(t *testing.T) {
+		request.Allergens = nil
+		request.Diets = []string{model.DietVegan, model.DietVegan}
+
+		assert.Error(t, validate.Struct(request))
+	})
+}
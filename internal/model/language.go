@@ -0,0 +1,6 @@
+package model
+
+const (
+	LanguageEnglish = "en"
+	LanguageThai    = "th"
+)
@@ -0,0 +1,113 @@
+package model
+
+import "time"
+
+// ภาษาของอีเมล ต้องตรงกับ oneof ใน NotificationPreferencesRequest และชื่อไฟล์ template
+const (
+	LocaleThai    = "th"
+	LocaleEnglish = "en"
+)
+
+// DigestSubscription ผู้ใช้ที่สมัครรับ digest ทางอีเมล ไม่มีแถวคือไม่รับ
+type DigestSubscription struct {
+	UserID     string `gorm:"primaryKey"`
+	User       User   `gorm:"foreignKey:UserID"`
+	Locale     string
+	LastSentAt *time.Time
+	CreatedAt  time.Time
+}
+
+// Since digest ครั้งแรกย้อนหลังหนึ่งช่วง ครั้งต่อไปนับจากครั้งที่แล้ว
+func (subscription DigestSubscription) Since(now time.Time, interval time.Duration) time.Time {
+	if subscription.LastSentAt != nil {
+		return *subscription.LastSentAt
+	}
+	return now.Add(-interval)
+}
+
+// DigestRecipeRatings rating ใหม่ของสูตรหนึ่งของผู้รับ รวมต่อสูตร
+type DigestRecipeRatings struct {
+	FoodRecipeID uint
+	Name         string
+	Count        int64
+	ScoreSum     float64
+}
+
+func (ratings DigestRecipeRatings) AverageScore() float64 {
+	if ratings.Count == 0 {
+		return 0
+	}
+	return ratings.ScoreSum / float64(ratings.Count)
+}
+
+// Digest เนื้อหาอีเมลสรุปของผู้รับหนึ่งคน
+type Digest struct {
+	User     User
+	Locale   string
+	Since    time.Time
+	Ratings  []DigestRecipeRatings
+	Recipes  FoodRecipes // สูตรใหม่จากคนที่ติดตาม
+	Trending FoodRecipes
+}
+
+func (digest Digest) IsEmpty() bool {
+	return len(digest.Ratings) == 0 && len(digest.Recipes) == 0 && len(digest.Trending) == 0
+}
+
+type EmailMessage struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// OutboxEmail อีเมลที่รอส่ง เขียนใน transaction เดียวกับสิ่งที่ทำให้ต้องส่ง
+// แล้ว dispatcher ค่อยส่งทีหลังพร้อม retry จึงไม่หายแม้ SMTP ล่มหรือโปรแกรมปิดกลางคัน
+type OutboxEmail struct {
+	ID            uint `gorm:"primaryKey"`
+	RecipientID   string
+	ToAddress     string
+	Subject       string
+	TextBody      string
+	HTMLBody      string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	SentAt        *time.Time
+	FailedAt      *time.Time // ส่งไม่สำเร็จครบจำนวนครั้งแล้ว เลิกส่ง
+	CreatedAt     time.Time
+}
+
+func (outbox OutboxEmail) FromMessage(recipientID string, message EmailMessage, now time.Time) OutboxEmail {
+	return OutboxEmail{
+		RecipientID:   recipientID,
+		ToAddress:     message.To,
+		Subject:       message.Subject,
+		TextBody:      message.Text,
+		HTMLBody:      message.HTML,
+		NextAttemptAt: now,
+	}
+}
+
+func (outbox OutboxEmail) ToMessage() EmailMessage {
+	return EmailMessage{
+		To:      outbox.ToAddress,
+		Subject: outbox.Subject,
+		Text:    outbox.TextBody,
+		HTML:    outbox.HTMLBody,
+	}
+}
+
+// Retry บันทึกการส่งไม่สำเร็จ รอ backoff เพิ่มเป็นเท่าตัวทุกครั้ง ครบ maxAttempts แล้วเลิก
+func (outbox OutboxEmail) Retry(cause error, now time.Time, backoff time.Duration, maxAttempts int) OutboxEmail {
+	outbox.Attempts++
+	outbox.LastError = cause.Error()
+
+	if outbox.Attempts >= maxAttempts {
+		outbox.FailedAt = &now
+		return outbox
+	}
+
+	outbox.NextAttemptAt = now.Add(backoff << (outbox.Attempts - 1))
+	return outbox
+}
@@ -0,0 +1,73 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestSubscriptionSince(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldLookBackOneIntervalWhenNeverSent", func(t *testing.T) {
+		since := model.DigestSubscription{}.Since(now, 168*time.Hour)
+
+		assert.Equal(t, now.Add(-168*time.Hour), since)
+	})
+
+	t.Run("ShouldStartFromLastSent", func(t *testing.T) {
+		lastSentAt := now.Add(-200 * time.Hour)
+
+		since := model.DigestSubscription{LastSentAt: &lastSentAt}.Since(now, 168*time.Hour)
+
+		assert.Equal(t, lastSentAt, since)
+	})
+}
+
+func TestDigest(t *testing.T) {
+	t.Run("ShouldAverageScore", func(t *testing.T) {
+		assert.Equal(t, 4.5, model.DigestRecipeRatings{Count: 2, ScoreSum: 9}.AverageScore())
+		assert.Equal(t, 0.0, model.DigestRecipeRatings{}.AverageScore())
+	})
+
+	t.Run("ShouldBeEmptyWithoutContent", func(t *testing.T) {
+		assert.True(t, model.Digest{User: model.User{ID: "UID"}}.IsEmpty())
+		assert.False(t, model.Digest{Trending: model.FoodRecipes{{Name: "Tom Yum"}}}.IsEmpty())
+	})
+}
+
+func TestOutboxEmail(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldRoundTripMessage", func(t *testing.T) {
+		message := model.EmailMessage{To: "demo@example.com", Subject: "Subject", Text: "Text", HTML: "<p>HTML</p>"}
+
+		outbox := model.OutboxEmail{}.FromMessage("UID", message, now)
+
+		assert.Equal(t, "UID", outbox.RecipientID)
+		assert.Equal(t, now, outbox.NextAttemptAt)
+		assert.Equal(t, message, outbox.ToMessage())
+	})
+
+	t.Run("ShouldDoubleBackoffEachAttempt", func(t *testing.T) {
+		outbox := model.OutboxEmail{}.Retry(assert.AnError, now, time.Minute, 3)
+
+		assert.Equal(t, 1, outbox.Attempts)
+		assert.Equal(t, now.Add(time.Minute), outbox.NextAttemptAt)
+		assert.Equal(t, assert.AnError.Error(), outbox.LastError)
+		assert.Nil(t, outbox.FailedAt)
+
+		outbox = outbox.Retry(assert.AnError, now, time.Minute, 3)
+
+		assert.Equal(t, now.Add(2*time.Minute), outbox.NextAttemptAt)
+	})
+
+	t.Run("ShouldGiveUpAfterMaxAttempts", func(t *testing.T) {
+		outbox := model.OutboxEmail{Attempts: 2}.Retry(assert.AnError, now, time.Minute, 3)
+
+		assert.Equal(t, 3, outbox.Attempts)
+		assert.Equal(t, &now, outbox.FailedAt)
+	})
+}
@@ -0,0 +1,162 @@
+package model
+
+import (
+	"time"
+	"wongnok/internal/model/dto"
+)
+
+// ประเภทของการแจ้งเตือน ต้องตรงกับค่าใน notifications.type และ notification_mutes.type
+const (
+	NotificationRating   = "rating"
+	NotificationFavorite = "favorite"
+	NotificationComment  = "comment"
+	NotificationFollow   = "follow"
+)
+
+// Notification ถูกเขียนใน transaction เดียวกับการกระทำที่เป็นต้นเหตุ
+// ฟิลด์อ้างอิงที่ใช้ขึ้นกับ Type เช่น rating มีทั้ง FoodRecipeID และ RatingID ส่วน follow ไม่มีเลย
+type Notification struct {
+	ID           uint `gorm:"primaryKey"`
+	RecipientID  string
+	ActorID      string
+	Actor        User `gorm:"foreignKey:ActorID"`
+	Type         string
+	FoodRecipeID *uint
+	RatingID     *uint
+	CommentID    *uint
+	ReadAt       *time.Time
+	CreatedAt    time.Time
+}
+
+func (notification Notification) ToResponse() dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:           notification.ID,
+		Type:         notification.Type,
+		Actor:        notification.Actor.ToResponse(),
+		FoodRecipeID: notification.FoodRecipeID,
+		RatingID:     notification.RatingID,
+		CommentID:    notification.CommentID,
+		Read:         notification.ReadAt != nil,
+		ReadAt:       notification.ReadAt,
+		CreatedAt:    notification.CreatedAt,
+	}
+}
+
+type Notifications []Notification
+
+func (notifications Notifications) ToResponse(total int64) dto.NotificationsResponse {
+	var results = make([]dto.NotificationResponse, 0)
+
+	for _, notification := range notifications {
+		results = append(results, notification.ToResponse())
+	}
+
+	return dto.NotificationsResponse{
+		Total:   total,
+		Results: results,
+	}
+}
+
+// NotificationMute ผู้ใช้ปิดการแจ้งเตือนประเภทนั้น ไม่มีแถวคือเปิดอยู่
+type NotificationMute struct {
+	UserID    string `gorm:"primaryKey"`
+	Type      string `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
+
+// NotificationPreferences true คือเปิดรับการแจ้งเตือนประเภทนั้น
+// EmailDigest คือสมัครรับ digest ทางอีเมล ซึ่งแยกจากการแจ้งเตือนในแอป
+type NotificationPreferences struct {
+	Rating      bool
+	Favorite    bool
+	Comment     bool
+	Follow      bool
+	EmailDigest bool
+	EmailLocale string
+}
+
+func (preferences NotificationPreferences) FromMutes(mutes []string) NotificationPreferences {
+	result := NotificationPreferences{Rating: true, Favorite: true, Comment: true, Follow: true, EmailLocale: LocaleThai}
+
+	for _, muted := range mutes {
+		switch muted {
+		case NotificationRating:
+			result.Rating = false
+		case NotificationFavorite:
+			result.Favorite = false
+		case NotificationComment:
+			result.Comment = false
+		case NotificationFollow:
+			result.Follow = false
+		}
+	}
+
+	return result
+}
+
+// MutesFromRequest ประเภทที่ request ระบุมา true คือปิด ประเภทที่ไม่ได้ส่งมาไม่เปลี่ยน
+func (preferences NotificationPreferences) MutesFromRequest(request dto.NotificationPreferencesRequest) map[string]bool {
+	mutes := make(map[string]bool)
+
+	for notificationType, enabled := range map[string]*bool{
+		NotificationRating:   request.Rating,
+		NotificationFavorite: request.Favorite,
+		NotificationComment:  request.Comment,
+		NotificationFollow:   request.Follow,
+	} {
+		if enabled != nil {
+			mutes[notificationType] = !*enabled
+		}
+	}
+
+	return mutes
+}
+
+func (preferences NotificationPreferences) WithDigest(subscription *DigestSubscription) NotificationPreferences {
+	preferences.EmailDigest = subscription != nil
+	if subscription != nil {
+		preferences.EmailLocale = subscription.Locale
+	}
+
+	return preferences
+}
+
+// DigestFromRequest การสมัคร digest หลังใช้ request คืน nil คือไม่รับ
+// ช่องที่ request ไม่ได้ส่งมาใช้ค่าจาก current
+func (preferences NotificationPreferences) DigestFromRequest(request dto.NotificationPreferencesRequest, userID string, current *DigestSubscription) *DigestSubscription {
+	enabled := current != nil
+	if request.EmailDigest != nil {
+		enabled = *request.EmailDigest
+	}
+	if !enabled {
+		return nil
+	}
+
+	result := DigestSubscription{UserID: userID, Locale: LocaleThai}
+	if current != nil {
+		result = *current
+	}
+	if request.EmailLocale != nil {
+		result.Locale = *request.EmailLocale
+	}
+
+	return &result
+}
+
+func (preferences NotificationPreferences) ToResponse() dto.NotificationPreferencesResponse {
+	return dto.NotificationPreferencesResponse{
+		Rating:      preferences.Rating,
+		Favorite:    preferences.Favorite,
+		Comment:     preferences.Comment,
+		Follow:      preferences.Follow,
+		EmailDigest: preferences.EmailDigest,
+		EmailLocale: preferences.EmailLocale,
+	}
+}
+
+// NotificationQuery ไม่ส่งมาจะได้หน้าแรก ล่าสุดก่อน ค่าเริ่มต้นตั้งใน handler ก่อน bind
+type NotificationQuery struct {
+	Page   int  `form:"page" binding:"min=1"`
+	Limit  int  `form:"limit" binding:"min=1,max=100"`
+	Unread bool `form:"unread"`
+}
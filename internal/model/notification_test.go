@@ -0,0 +1,101 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationToResponse(t *testing.T) {
+	t.Run("ShouldMarkReadWhenReadAtSet", func(t *testing.T) {
+		readAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+		recipeID := uint(1)
+
+		notification := model.Notification{
+			ID:           3,
+			Type:         model.NotificationFavorite,
+			Actor:        model.User{ID: "Actor", FirstName: "Demo"},
+			FoodRecipeID: &recipeID,
+			ReadAt:       &readAt,
+		}
+
+		response := notification.ToResponse()
+
+		assert.True(t, response.Read)
+		assert.Equal(t, &readAt, response.ReadAt)
+		assert.Equal(t, &recipeID, response.FoodRecipeID)
+		assert.Equal(t, "Actor", response.Actor.ID)
+	})
+
+	t.Run("ShouldReturnEmptyResultsWhenNoNotifications", func(t *testing.T) {
+		response := model.Notifications{}.ToResponse(0)
+
+		assert.Equal(t, dto.NotificationsResponse{Results: []dto.NotificationResponse{}}, response)
+	})
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	t.Run("ShouldEnableAllWhenNoMutes", func(t *testing.T) {
+		preferences := model.NotificationPreferences{}.FromMutes(nil)
+
+		assert.Equal(t, model.NotificationPreferences{Rating: true, Favorite: true, Comment: true, Follow: true, EmailLocale: model.LocaleThai}, preferences)
+	})
+
+	t.Run("ShouldDisableMutedTypes", func(t *testing.T) {
+		preferences := model.NotificationPreferences{}.FromMutes([]string{model.NotificationFavorite, model.NotificationFollow})
+
+		assert.Equal(t, model.NotificationPreferences{Rating: true, Favorite: false, Comment: true, Follow: false, EmailLocale: model.LocaleThai}, preferences)
+	})
+
+	t.Run("ShouldOnlyChangeTypesInRequest", func(t *testing.T) {
+		enabled, disabled := true, false
+
+		mutes := model.NotificationPreferences{}.MutesFromRequest(dto.NotificationPreferencesRequest{
+			Rating: &enabled,
+			Follow: &disabled,
+		})
+
+		assert.Equal(t, map[string]bool{model.NotificationRating: false, model.NotificationFollow: true}, mutes)
+	})
+
+	t.Run("ShouldShowDigestSubscription", func(t *testing.T) {
+		preferences := model.NotificationPreferences{}.FromMutes(nil).WithDigest(&model.DigestSubscription{Locale: model.LocaleEnglish})
+
+		assert.True(t, preferences.EmailDigest)
+		assert.Equal(t, model.LocaleEnglish, preferences.EmailLocale)
+	})
+}
+
+func TestNotificationPreferencesDigestFromRequest(t *testing.T) {
+	enabled, disabled := true, false
+	english := model.LocaleEnglish
+	lastSentAt := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	current := &model.DigestSubscription{UserID: "UID", Locale: model.LocaleThai, LastSentAt: &lastSentAt}
+
+	t.Run("ShouldSubscribeInThaiByDefault", func(t *testing.T) {
+		subscription := model.NotificationPreferences{}.DigestFromRequest(dto.NotificationPreferencesRequest{EmailDigest: &enabled}, "UID", nil)
+
+		assert.Equal(t, &model.DigestSubscription{UserID: "UID", Locale: model.LocaleThai}, subscription)
+	})
+
+	t.Run("ShouldKeepSubscriptionWhenOnlyLocaleChanged", func(t *testing.T) {
+		subscription := model.NotificationPreferences{}.DigestFromRequest(dto.NotificationPreferencesRequest{EmailLocale: &english}, "UID", current)
+
+		assert.Equal(t, &model.DigestSubscription{UserID: "UID", Locale: model.LocaleEnglish, LastSentAt: &lastSentAt}, subscription)
+	})
+
+	t.Run("ShouldNotSubscribeWhenOnlyLocaleSent", func(t *testing.T) {
+		subscription := model.NotificationPreferences{}.DigestFromRequest(dto.NotificationPreferencesRequest{EmailLocale: &english}, "UID", nil)
+
+		assert.Nil(t, subscription)
+	})
+
+	t.Run("ShouldUnsubscribe", func(t *testing.T) {
+		subscription := model.NotificationPreferences{}.DigestFromRequest(dto.NotificationPreferencesRequest{EmailDigest: &disabled}, "UID", current)
+
+		assert.Nil(t, subscription)
+	})
+}
@@ -0,0 +1,24 @@
+package model
+
+// IfMatch คือเงื่อนไขที่ client ส่งมากับ header If-Match
+type IfMatch struct {
+	Present  bool
+	Any      bool
+	Versions []uint
+}
+
+// Matches ตรวจว่า version ปัจจุบันตรงกับเงื่อนไขหรือไม่
+// ถ้า client ไม่ได้ส่ง If-Match มาถือว่าผ่าน
+func (ifMatch IfMatch) Matches(version uint) bool {
+	if !ifMatch.Present || ifMatch.Any {
+		return true
+	}
+
+	for _, expected := range ifMatch.Versions {
+		if expected == version {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,25 @@
+package model_test
+
+import (
+	"testing"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatchMatches(t *testing.T) {
+	t.Run("ShouldMatchWhenNotPresent", func(t *testing.T) {
+		assert.True(t, model.IfMatch{}.Matches(1))
+	})
+
+	t.Run("ShouldMatchAnyVersion", func(t *testing.T) {
+		assert.True(t, model.IfMatch{Present: true, Any: true}.Matches(7))
+	})
+
+	t.Run("ShouldMatchListedVersion", func(t *testing.T) {
+		ifMatch := model.IfMatch{Present: true, Versions: []uint{1, 2}}
+
+		assert.True(t, ifMatch.Matches(2))
+		assert.False(t, ifMatch.Matches(3))
+	})
+}
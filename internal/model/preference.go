@@ -0,0 +1,71 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"wongnok/internal/model/dto"
+)
+
+// ระบบหน่วย ต้องตรงกับ oneof ใน dto.PreferencesRequest
+const (
+	UnitsMetric   = "metric"
+	UnitsImperial = "imperial"
+)
+
+// UserPreferences เอกสารการตั้งค่าของผู้ใช้ ไม่มีแถวคือใช้ค่าเริ่มต้นจาก DefaultPreferences
+type UserPreferences struct {
+	UserID              string `gorm:"primaryKey"`
+	Language            string
+	Units               string
+	Diets               Labels
+	DislikedIngredients Labels
+	DefaultSort         string
+	UpdatedAt           time.Time
+}
+
+func DefaultPreferences(userID string) UserPreferences {
+	return UserPreferences{
+		UserID:              userID,
+		Language:            LanguageThai,
+		Units:               UnitsMetric,
+		Diets:               Labels{},
+		DislikedIngredients: Labels{},
+	}
+}
+
+// FromRequest ส่วนผสมที่ไม่ชอบเก็บเป็นตัวพิมพ์เล็ก ตัดช่องว่างและค่าซ้ำออก
+func (preferences UserPreferences) FromRequest(request dto.PreferencesRequest, userID string) UserPreferences {
+	disliked := make(Labels, 0, len(request.DislikedIngredients))
+	for _, ingredient := range request.DislikedIngredients {
+		ingredient = strings.ToLower(strings.TrimSpace(ingredient))
+		if ingredient != "" && !slices.Contains(disliked, ingredient) {
+			disliked = append(disliked, ingredient)
+		}
+	}
+
+	diets := make(Labels, 0, len(request.Diets))
+	diets = append(diets, request.Diets...)
+
+	return UserPreferences{
+		UserID:              userID,
+		Language:            request.Language,
+		Units:               request.Units,
+		Diets:               diets,
+		DislikedIngredients: disliked,
+		DefaultSort:         request.DefaultSort,
+	}
+}
+
+func (preferences UserPreferences) ToResponse() dto.PreferencesResponse {
+	diets := make([]string, 0, len(preferences.Diets))
+	disliked := make([]string, 0, len(preferences.DislikedIngredients))
+
+	return dto.PreferencesResponse{
+		Language:            preferences.Language,
+		Units:               preferences.Units,
+		Diets:               append(diets, preferences.Diets...),
+		DislikedIngredients: append(disliked, preferences.DislikedIngredients...),
+		DefaultSort:         preferences.DefaultSort,
+	}
+}
@@ -0,0 +1,36 @@
+package model_test
+
+import (
+	"testing"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoodRecipeQueryWithPreferences(t *testing.T) {
+	preferences := model.UserPreferences{
+		Diets:               model.Labels{model.DietVegan},
+		DislikedIngredients: model.Labels{"cilantro"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}
+
+	t.Run("ShouldFillUnsetFields", func(t *testing.T) {
+		query := model.FoodRecipeQuery{Page: 1, Limit: 10}.WithPreferences(preferences)
+
+		assert.Equal(t, []string{model.DietVegan}, query.Diet)
+		assert.Equal(t, []string{"cilantro"}, query.ExcludeIngredients)
+		assert.Equal(t, model.FoodRecipeSortRating, query.Sort)
+	})
+
+	t.Run("ShouldKeepFieldsFromRequest", func(t *testing.T) {
+		query := model.FoodRecipeQuery{
+			Diet:               []string{model.DietHalal},
+			ExcludeIngredients: []string{"durian"},
+			Sort:               model.FoodRecipeSortName,
+		}.WithPreferences(preferences)
+
+		assert.Equal(t, []string{model.DietHalal}, query.Diet)
+		assert.Equal(t, []string{"durian"}, query.ExcludeIngredients)
+		assert.Equal(t, model.FoodRecipeSortName, query.Sort)
+	})
+}
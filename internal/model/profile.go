@@ -0,0 +1,86 @@
+package model
+
+import "wongnok/internal/model/dto"
+
+// UserStats นับเฉพาะ recipe ที่ยังไม่ถูกลบ
+type UserStats struct {
+	RecipeCount       int64
+	RatingCount       int64
+	RatingSum         float64
+	FavoritesReceived int64
+	FollowerCount     int64
+	FollowingCount    int64
+}
+
+// AverageRating ค่าเฉลี่ยจาก rating ทุกอันที่ได้รับ ไม่ใช่ค่าเฉลี่ยของค่าเฉลี่ยแต่ละ recipe
+func (stats UserStats) AverageRating() float64 {
+	if stats.RatingCount == 0 {
+		return 0
+	}
+
+	return stats.RatingSum / float64(stats.RatingCount)
+}
+
+type Profile struct {
+	User    User
+	Stats   UserStats
+	Recipes FoodRecipes
+	// มีเฉพาะเมื่อผู้เรียก login และไม่ใช่เจ้าของโปรไฟล์
+	IsFollowing *bool
+}
+
+// ToResponse เจ้าของโปรไฟล์เห็นทุกช่อง ผู้อื่นเห็นเฉพาะช่องที่ไม่ได้ซ่อน
+func (profile Profile) ToResponse(owner bool) dto.ProfileResponse {
+	user := profile.User
+	privacy := user.ProfilePrivacy
+	if owner {
+		privacy = ProfilePrivacy{}
+	}
+
+	response := dto.ProfileResponse{
+		ID:             user.ID,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		Nickname:       user.NickName,
+		Handle:         derefString(user.Handle),
+		FollowerCount:  profile.Stats.FollowerCount,
+		FollowingCount: profile.Stats.FollowingCount,
+		IsFollowing:    profile.IsFollowing,
+	}
+
+	if !privacy.HideBio && user.Bio != "" {
+		response.Bio = &user.Bio
+	}
+	if !privacy.HideAvatar {
+		response.ImageUrl = user.ImageUrl
+	}
+	if !privacy.HideJoinedAt {
+		response.JoinedAt = &user.CreatedAt
+	}
+
+	total := profile.Stats.RecipeCount
+	if !privacy.HideRecipeCount {
+		response.RecipeCount = &total
+	} else {
+		// total ของรายการก็บอกจำนวน recipe จึงต้องซ่อนด้วย
+		total = 0
+	}
+
+	if !privacy.HideAverageRating {
+		average := profile.Stats.AverageRating()
+		response.AverageRating = &average
+	}
+	if !privacy.HideFavoritesReceived {
+		response.FavoritesReceived = &profile.Stats.FavoritesReceived
+	}
+
+	response.Recipes = profile.Recipes.ToResponse(total)
+
+	return response
+}
+
+// ProfileQuery ไม่ส่งมาจะได้หน้าแรก recipe ใหม่สุดก่อน ค่าเริ่มต้นตั้งใน handler ก่อน bind
+type ProfileQuery struct {
+	Page  int `form:"page" binding:"min=1"`
+	Limit int `form:"limit" binding:"min=1,max=100"`
+}
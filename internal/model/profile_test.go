@@ -0,0 +1,112 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestUserStatsAverageRating(t *testing.T) {
+	t.Run("ShouldBeZeroWhenNoRating", func(t *testing.T) {
+		assert.Equal(t, float64(0), model.UserStats{}.AverageRating())
+	})
+
+	t.Run("ShouldAverageAllRatingsReceived", func(t *testing.T) {
+		stats := model.UserStats{RatingCount: 4, RatingSum: 14}
+
+		assert.Equal(t, 3.5, stats.AverageRating())
+	})
+}
+
+func TestProfileToResponse(t *testing.T) {
+	mockTime := time.Date(2025, 7, 19, 0, 0, 0, 0, time.Local)
+	imageUrl := "ImageUrl"
+
+	newProfile := func(privacy model.ProfilePrivacy) model.Profile {
+		return model.Profile{
+			User: model.User{
+				ID:             "ID",
+				FirstName:      "FirstName",
+				LastName:       "LastName",
+				NickName:       "NickName",
+				ImageUrl:       &imageUrl,
+				Bio:            "Bio",
+				ProfilePrivacy: privacy,
+				CreatedAt:      mockTime,
+			},
+			Stats: model.UserStats{
+				RecipeCount:       3,
+				RatingCount:       2,
+				RatingSum:         9,
+				FavoritesReceived: 5,
+				FollowerCount:     7,
+				FollowingCount:    2,
+			},
+			Recipes: model.FoodRecipes{{Model: gorm.Model{ID: 1}}},
+		}
+	}
+
+	t.Run("ShouldShowAllFieldsByDefault", func(t *testing.T) {
+		response := newProfile(model.ProfilePrivacy{}).ToResponse(false)
+
+		assert.Equal(t, "ID", response.ID)
+		assert.Equal(t, "NickName", response.Nickname)
+		assert.Equal(t, "Bio", *response.Bio)
+		assert.Equal(t, "ImageUrl", *response.ImageUrl)
+		assert.Equal(t, mockTime, *response.JoinedAt)
+		assert.Equal(t, int64(3), *response.RecipeCount)
+		assert.Equal(t, 4.5, *response.AverageRating)
+		assert.Equal(t, int64(5), *response.FavoritesReceived)
+		assert.Equal(t, int64(7), response.FollowerCount)
+		assert.Equal(t, int64(2), response.FollowingCount)
+		assert.Nil(t, response.IsFollowing)
+		assert.Equal(t, int64(3), response.Recipes.Total)
+		assert.Len(t, response.Recipes.Results, 1)
+	})
+
+	t.Run("ShouldOmitHiddenFields", func(t *testing.T) {
+		privacy := model.ProfilePrivacy{
+			HideBio:               true,
+			HideAvatar:            true,
+			HideJoinedAt:          true,
+			HideRecipeCount:       true,
+			HideAverageRating:     true,
+			HideFavoritesReceived: true,
+		}
+
+		response := newProfile(privacy).ToResponse(false)
+
+		assert.Nil(t, response.Bio)
+		assert.Nil(t, response.ImageUrl)
+		assert.Nil(t, response.JoinedAt)
+		assert.Nil(t, response.RecipeCount)
+		assert.Nil(t, response.AverageRating)
+		assert.Nil(t, response.FavoritesReceived)
+		assert.Equal(t, int64(0), response.Recipes.Total)
+		assert.Len(t, response.Recipes.Results, 1)
+	})
+
+	t.Run("ShouldShowHiddenFieldsToOwner", func(t *testing.T) {
+		response := newProfile(model.ProfilePrivacy{HideBio: true, HideRecipeCount: true}).ToResponse(true)
+
+		assert.Equal(t, "Bio", *response.Bio)
+		assert.Equal(t, int64(3), *response.RecipeCount)
+		assert.Equal(t, int64(3), response.Recipes.Total)
+	})
+}
+
+func TestUserToAccountResponse(t *testing.T) {
+	response := model.User{
+		ID:             "ID",
+		Bio:            "Bio",
+		ProfilePrivacy: model.ProfilePrivacy{HideAvatar: true},
+	}.ToAccountResponse()
+
+	assert.Equal(t, "ID", response.ID)
+	assert.Equal(t, "Bio", response.Bio)
+	assert.True(t, response.Privacy.HideAvatar)
+	assert.False(t, response.Privacy.HideBio)
+}
@@ -1,31 +1,111 @@
 package model
 
 import (
+	"database/sql/driver"
+	"math"
+	"time"
 	"wongnok/internal/model/dto"
 
 	"gorm.io/gorm"
 )
 
+// คะแนน 1 ถึง 5 ดาว ให้ครึ่งดาวได้ ต้องตรงกับ CHECK ของ ratings.score
+const (
+	RatingMin  = 1.0
+	RatingMax  = 5.0
+	RatingStep = 0.5
+)
+
+// IsRatingStep คะแนนต้องลงตัวตามขั้นครึ่งดาวนับจาก RatingMin
+func IsRatingStep(score float64) bool {
+	steps := (score - RatingMin) / RatingStep
+	return steps == math.Trunc(steps)
+}
+
+// รูปแบบวันที่ของ CookedOn ทั้งใน request และ response
+const CookedOnLayout = time.DateOnly
+
+// การเรียงรีวิว ต้องตรงกับ oneof ใน RatingQuery
+const (
+	RatingSortNewest  = "newest"
+	RatingSortHighest = "highest"
+	RatingSortLowest  = "lowest"
+	RatingSortHelpful = "helpful"
+)
+
 type Rating struct {
 	gorm.Model
 	Score        float64
 	FoodRecipeID uint
 
+	// ส่วนของรีวิว ไม่บังคับ ให้คะแนนอย่างเดียวได้
+	Title    string
+	Body     string
+	Photos   Photos     `gorm:"type:jsonb"`
+	CookedOn *time.Time `gorm:"type:date"`
+
+	// ยอดโหวตจาก RatingVote ปรับใน transaction เดียวกับการโหวต
+	HelpfulCount   int
+	UnhelpfulCount int
+
 	UserID string
+	User   User
 }
 
 func (rating Rating) FromRequest(request dto.RatingRequest) Rating {
-	return Rating{
-		Score: request.Score,
+	result := Rating{
+		Score:  request.Score,
+		Title:  request.Title,
+		Body:   request.Body,
+		Photos: Photos(request.Photos),
+	}
+
+	// รูปแบบผ่านการ validate มาแล้ว
+	if request.CookedOn != "" {
+		if cookedOn, err := time.Parse(CookedOnLayout, request.CookedOn); err == nil {
+			result.CookedOn = &cookedOn
+		}
 	}
+
+	return result
 }
 
 func (rating Rating) ToResponse() dto.RatingResponse {
-	return dto.RatingResponse{
-		Score:        rating.Score,
-		FoodRecipeID: rating.FoodRecipeID,
-		UserID:       rating.UserID,
+	response := dto.RatingResponse{
+		ID:             rating.ID,
+		Score:          rating.Score,
+		Title:          rating.Title,
+		Body:           rating.Body,
+		Photos:         []string(rating.Photos),
+		HelpfulCount:   rating.HelpfulCount,
+		UnhelpfulCount: rating.UnhelpfulCount,
+		FoodRecipeID:   rating.FoodRecipeID,
+		UserID:         rating.UserID,
+		CreatedAt:      rating.CreatedAt,
+		UpdatedAt:      rating.UpdatedAt,
+	}
+
+	if rating.CookedOn != nil {
+		response.CookedOn = rating.CookedOn.Format(CookedOnLayout)
+	}
+
+	if rating.User.ID != "" {
+		user := rating.User.ToResponse()
+		response.User = &user
 	}
+
+	return response
+}
+
+// Photos URL รูปของรีวิว เก็บเป็น jsonb array แบบเดียวกับ Labels
+type Photos []string
+
+func (photos Photos) Value() (driver.Value, error) {
+	return Labels(photos).Value()
+}
+
+func (photos *Photos) Scan(value any) error {
+	return (*Labels)(photos).Scan(value)
 }
 
 // Ratings คือ "ชุดของ Rating หลาย ๆ อัน"
@@ -33,7 +113,7 @@ func (rating Rating) ToResponse() dto.RatingResponse {
 // ชื่อใหม่ (alias)
 type Ratings []Rating
 
-func (ratings Ratings) ToResponse() dto.RatingsResponse {
+func (ratings Ratings) ToResponse(total int64) dto.RatingsResponse {
 	var results = make([]dto.RatingResponse, 0)
 
 	for _, rating := range ratings {
@@ -41,6 +121,25 @@ func (ratings Ratings) ToResponse() dto.RatingsResponse {
 	}
 
 	return dto.RatingsResponse{
+		Total:   total,
 		Results: results,
 	}
 }
+
+// RatingVote ผู้ใช้ 1 คนโหวตได้ 1 ครั้งต่อ rating
+type RatingVote struct {
+	RatingID  uint   `gorm:"primaryKey"`
+	UserID    string `gorm:"primaryKey"`
+	Helpful   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RatingQuery ไม่ส่งมาจะได้หน้าแรกเรียงจากใหม่ไปเก่า
+type RatingQuery struct {
+	Page  int    `form:"page" binding:"omitempty,min=1"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Sort  string `form:"sort" binding:"omitempty,oneof=newest highest lowest helpful"`
+	// ผู้ใช้ที่ login ไม่เห็น rating ของคนที่ mute หรือ block กัน
+	ViewerID string `form:"-"`
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"math"
+	"time"
+	"wongnok/internal/model/dto"
+)
+
+// RatingSummary ยอดรวม rating ที่เก็บไว้ใน food_recipes
+// rating.Repository อัปเดตใน transaction เดียวกับการเขียน rating จึงไม่ต้องโหลด rating ทุกอันมาคำนวณ
+type RatingSummary struct {
+	RatingCount     int64
+	RatingSum       float64
+	RatingStar1     int64 `gorm:"column:rating_star_1"`
+	RatingStar2     int64 `gorm:"column:rating_star_2"`
+	RatingStar3     int64 `gorm:"column:rating_star_3"`
+	RatingStar4     int64 `gorm:"column:rating_star_4"`
+	RatingStar5     int64 `gorm:"column:rating_star_5"`
+	RatingUpdatedAt *time.Time
+}
+
+// RatingStar ช่องของ histogram ครึ่งดาวปัดลง เช่น 4.5 นับเป็น 4 ดาว
+func RatingStar(score float64) int {
+	return int(math.Max(RatingMin, math.Min(RatingMax, math.Floor(score))))
+}
+
+func (summary RatingSummary) Average() float64 {
+	if summary.RatingCount == 0 {
+		return 0
+	}
+
+	return summary.RatingSum / float64(summary.RatingCount)
+}
+
+// Add เพิ่ม (n > 0) หรือเอาออก (n < 0) rating ที่มีคะแนน score
+func (summary RatingSummary) Add(score float64, n int64) RatingSummary {
+	summary.RatingCount += n
+	summary.RatingSum += score * float64(n)
+
+	switch RatingStar(score) {
+	case 1:
+		summary.RatingStar1 += n
+	case 2:
+		summary.RatingStar2 += n
+	case 3:
+		summary.RatingStar3 += n
+	case 4:
+		summary.RatingStar4 += n
+	case 5:
+		summary.RatingStar5 += n
+	}
+
+	return summary
+}
+
+func (summary RatingSummary) HistogramResponse() dto.RatingHistogramResponse {
+	return dto.RatingHistogramResponse{
+		Star1: summary.RatingStar1,
+		Star2: summary.RatingStar2,
+		Star3: summary.RatingStar3,
+		Star4: summary.RatingStar4,
+		Star5: summary.RatingStar5,
+	}
+}
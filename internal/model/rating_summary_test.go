@@ -0,0 +1,35 @@
+package model_test
+
+import (
+	"testing"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatingStar(t *testing.T) {
+	t.Run("ShouldRoundHalfStarDown", func(t *testing.T) {
+		assert.Equal(t, 1, model.RatingStar(1.5))
+		assert.Equal(t, 4, model.RatingStar(4.5))
+		assert.Equal(t, 5, model.RatingStar(5))
+	})
+}
+
+func TestRatingSummaryAdd(t *testing.T) {
+	t.Run("ShouldAddToCountSumAndHistogram", func(t *testing.T) {
+		summary := model.RatingSummary{}.Add(4.5, 1).Add(4, 1).Add(2, 1)
+
+		assert.Equal(t, model.RatingSummary{RatingCount: 3, RatingSum: 10.5, RatingStar2: 1, RatingStar4: 2}, summary)
+		assert.Equal(t, 3.5, summary.Average())
+	})
+
+	t.Run("ShouldRemoveWhenNegative", func(t *testing.T) {
+		summary := model.RatingSummary{}.Add(4.5, 1).Add(3, 1).Add(4.5, -1)
+
+		assert.Equal(t, model.RatingSummary{RatingCount: 1, RatingSum: 3, RatingStar3: 1}, summary)
+	})
+
+	t.Run("ShouldAverageZeroWhenEmpty", func(t *testing.T) {
+		assert.Equal(t, float64(0), model.RatingSummary{}.Average())
+	})
+}
@@ -2,6 +2,7 @@ package model_test
 
 import (
 	"testing"
+	"time"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
@@ -25,6 +26,31 @@ func TestRatingFromRequest(t *testing.T) {
 	})
 }
 
+func TestRatingFromReviewRequest(t *testing.T) {
+	t.Run("ShouldSetReviewFields", func(t *testing.T) {
+		request := dto.RatingRequest{
+			Score:    4,
+			Title:    "Great",
+			Body:     "Easy to follow",
+			Photos:   []string{"https://example.com/a.jpg"},
+			CookedOn: "2026-10-18",
+		}
+
+		rating := model.Rating{}.FromRequest(request)
+
+		assert.Equal(t, "Great", rating.Title)
+		assert.Equal(t, "Easy to follow", rating.Body)
+		assert.Equal(t, model.Photos{"https://example.com/a.jpg"}, rating.Photos)
+		assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), *rating.CookedOn)
+	})
+
+	t.Run("ShouldLeaveCookedOnEmpty", func(t *testing.T) {
+		rating := model.Rating{}.FromRequest(dto.RatingRequest{Score: 4})
+
+		assert.Nil(t, rating.CookedOn)
+	})
+}
+
 func TestRatingToResponse(t *testing.T) {
 	t.Run("ShouldSetToResponseModel", func(t *testing.T) {
 		rating := model.Rating{
@@ -41,6 +67,23 @@ func TestRatingToResponse(t *testing.T) {
 
 		assert.Equal(t, expectRating.Score, response.Score)
 		assert.Equal(t, expectRating.FoodRecipeID, response.FoodRecipeID)
+		assert.Nil(t, response.User)
+		assert.Empty(t, response.CookedOn)
+	})
+
+	t.Run("ShouldIncludeReviewer", func(t *testing.T) {
+		cookedOn := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+		rating := model.Rating{
+			Score:    4,
+			CookedOn: &cookedOn,
+			UserID:   "1a",
+			User:     model.User{ID: "1a", FirstName: "Somchai"},
+		}
+
+		response := rating.ToResponse()
+
+		assert.Equal(t, "2026-10-18", response.CookedOn)
+		assert.Equal(t, &dto.UserResponse{ID: "1a", FirstName: "Somchai"}, response.User)
 	})
 }
 
@@ -51,7 +94,7 @@ func TestRatingsToResponse(t *testing.T) {
 			{Score: 3.0, FoodRecipeID: 2, UserID: "1a"},
 		}
 
-		response := ratings.ToResponse()
+		response := ratings.ToResponse(2)
 
 		expectRatings := dto.RatingsResponse{
 			Results: []dto.RatingResponse{
@@ -61,7 +104,22 @@ func TestRatingsToResponse(t *testing.T) {
 		}
 
 		assert.Len(t, response.Results, 2)
+		assert.Equal(t, int64(2), response.Total)
 		assert.Equal(t, expectRatings.Results[0].Score, response.Results[0].Score)
 		assert.Equal(t, expectRatings.Results[1].Score, response.Results[1].Score)
 	})
 }
+
+func TestIsRatingStep(t *testing.T) {
+	t.Run("ShouldAcceptHalfStars", func(t *testing.T) {
+		for _, score := range []float64{1, 1.5, 3, 4.5, 5} {
+			assert.True(t, model.IsRatingStep(score), score)
+		}
+	})
+
+	t.Run("ShouldRejectOtherFractions", func(t *testing.T) {
+		for _, score := range []float64{1.2, 2.25, 4.75} {
+			assert.False(t, model.IsRatingStep(score), score)
+		}
+	})
+}
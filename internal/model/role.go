@@ -0,0 +1,97 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// role ที่กำหนดใน Keycloak เป็น realm role หรือ client role ก็ได้
+const (
+	RoleAdmin     = "admin"
+	RoleModerator = "moderator"
+	RoleEditor    = "editor"
+)
+
+type Permission string
+
+const (
+	PermissionManageReferenceData Permission = "reference-data:manage"
+	PermissionEditAnyRecipe       Permission = "recipe:edit-any"
+	PermissionDeleteAnyRecipe     Permission = "recipe:delete-any"
+)
+
+// rolePermissions สิทธิ์เพิ่มเติมจากการเป็นเจ้าของข้อมูล
+var rolePermissions = map[string][]Permission{
+	RoleAdmin:     {PermissionManageReferenceData, PermissionEditAnyRecipe, PermissionDeleteAnyRecipe},
+	RoleModerator: {PermissionDeleteAnyRecipe},
+	RoleEditor:    {PermissionEditAnyRecipe},
+}
+
+// RoleAccess รูปแบบของ realm_access และแต่ละ client ใน resource_access ของ Keycloak token
+type RoleAccess struct {
+	Roles []string `json:"roles"`
+}
+
+// Roles realm role รวมกับ client role ของ client ที่ออก token (azp)
+// ไม่รวม role ของ client อื่นเพราะชื่อ role อาจซ้ำกันแต่ความหมายต่างกัน
+func (claims Claims) Roles() []string {
+	roles := slices.Clone(claims.RealmAccess.Roles)
+
+	if client, ok := claims.ResourceAccess[claims.AuthorizedParty]; ok {
+		roles = append(roles, client.Roles...)
+	}
+
+	return roles
+}
+
+func (claims Claims) HasRole(roles ...string) bool {
+	for _, role := range claims.Roles() {
+		if slices.Contains(roles, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (claims Claims) Can(permission Permission) bool {
+	for _, role := range claims.Roles() {
+		if slices.Contains(rolePermissions[role], permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// การแก้ข้อมูลของผู้อื่นด้วยสิทธิ์จาก role ต้องตรงกับ CHECK ของ moderation_logs.action
+const (
+	ModerationRecipeUpdate = "recipe_update"
+	ModerationRecipeDelete = "recipe_delete"
+)
+
+// ModerationLog บันทึกทุกครั้งที่ผู้ใช้แก้หรือลบ recipe ของคนอื่นด้วยสิทธิ์จาก role
+type ModerationLog struct {
+	ID           uint
+	ActorID      string
+	Roles        Labels `gorm:"type:jsonb"`
+	Action       string
+	FoodRecipeID uint
+	OwnerID      string
+	CreatedAt    time.Time
+}
+
+// RecipeOverride nil ถ้าผู้เรียกเป็นเจ้าของ recipe ซึ่งไม่ต้องบันทึก
+func RecipeOverride(recipe FoodRecipe, claims Claims, action string) *ModerationLog {
+	if recipe.UserID == claims.ID {
+		return nil
+	}
+
+	return &ModerationLog{
+		ActorID:      claims.ID,
+		Roles:        Labels(claims.Roles()),
+		Action:       action,
+		FoodRecipeID: recipe.ID,
+		OwnerID:      recipe.UserID,
+	}
+}
@@ -0,0 +1,59 @@
+package model_test
+
+import (
+	"testing"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestClaimsRoles(t *testing.T) {
+	claims := model.Claims{
+		AuthorizedParty: "wongnok",
+		RealmAccess:     model.RoleAccess{Roles: []string{"offline_access", model.RoleEditor}},
+		ResourceAccess: map[string]model.RoleAccess{
+			"wongnok": {Roles: []string{model.RoleModerator}},
+			"other":   {Roles: []string{model.RoleAdmin}},
+		},
+	}
+
+	t.Run("ShouldCombineRealmAndAuthorizedClientRoles", func(t *testing.T) {
+		assert.Equal(t, []string{"offline_access", model.RoleEditor, model.RoleModerator}, claims.Roles())
+	})
+
+	t.Run("ShouldIgnoreRolesOfOtherClients", func(t *testing.T) {
+		assert.False(t, claims.HasRole(model.RoleAdmin))
+		assert.True(t, claims.HasRole(model.RoleAdmin, model.RoleModerator))
+	})
+
+	t.Run("ShouldGrantPermissionsOfEveryRole", func(t *testing.T) {
+		assert.True(t, claims.Can(model.PermissionEditAnyRecipe))
+		assert.True(t, claims.Can(model.PermissionDeleteAnyRecipe))
+		assert.False(t, claims.Can(model.PermissionManageReferenceData))
+	})
+
+	t.Run("ShouldHaveNoPermissionWithoutRoles", func(t *testing.T) {
+		assert.False(t, model.Claims{ID: "UID"}.Can(model.PermissionEditAnyRecipe))
+	})
+}
+
+func TestRecipeOverride(t *testing.T) {
+	recipe := model.FoodRecipe{Model: gorm.Model{ID: 1}, UserID: "Owner"}
+
+	t.Run("ShouldBeNilWhenCallerIsOwner", func(t *testing.T) {
+		assert.Nil(t, model.RecipeOverride(recipe, model.Claims{ID: "Owner"}, model.ModerationRecipeUpdate))
+	})
+
+	t.Run("ShouldRecordActorRolesAndOwner", func(t *testing.T) {
+		claims := model.Claims{ID: "Admin", RealmAccess: model.RoleAccess{Roles: []string{model.RoleAdmin}}}
+
+		assert.Equal(t, &model.ModerationLog{
+			ActorID:      "Admin",
+			Roles:        model.Labels{model.RoleAdmin},
+			Action:       model.ModerationRecipeDelete,
+			FoodRecipeID: 1,
+			OwnerID:      "Owner",
+		}, model.RecipeOverride(recipe, claims, model.ModerationRecipeDelete))
+	})
+}
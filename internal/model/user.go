@@ -1,68 +1,102 @@
 package model
 
 import (
-	"wongnok/internal/model/dto"
 	"time"
-	//"gorm.io/gorm"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
 )
 
 type User struct {
 	ID        string `gorm:"primaryKey"`
 	FirstName string
 	LastName  string
+	Email     string
 	NickName  string
-	ImageUrl  *string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time `gorm:"index"`
+	// Handle ชื่อสำหรับแชร์ เช่น /users/@handle ผู้ใช้เดิมที่ยังไม่ได้ login อีกจะยังเป็น nil
+	Handle   *string
+	ImageUrl *string
+	Bio      string
+	// ใช้ CreatedAt เป็นวันที่เข้าร่วมในโปรไฟล์
+	ProfilePrivacy `gorm:"embedded"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	// ผู้ใช้ที่ขอลบบัญชีถูก soft delete ระหว่างรอ purge
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
+// FromClaims สำหรับผู้ใช้ใหม่ ตั้งชื่อเล่นและรูปเริ่มต้นให้ ผู้ใช้เดิมใช้ FromClaimsUpdate
 func (user User) FromClaims(claims Claims) User {
 	return User{
-		ID:        claims.ID,
-		FirstName: claims.FirstName,
-		LastName:  claims.LastName,
-		NickName:  claims.FirstName + " " + claims.LastName,
-		ImageUrl:  func(s string) *string { return &s }("https://avatar.iran.liara.run/public/boy"),
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-		DeletedAt: user.DeletedAt,
+		ID:             claims.ID,
+		FirstName:      claims.FirstName,
+		LastName:       claims.LastName,
+		Email:          user.claimedEmail(claims),
+		NickName:       claims.FirstName + " " + claims.LastName,
+		Handle:         user.Handle,
+		ImageUrl:       func(s string) *string { return &s }("https://avatar.iran.liara.run/public/boy"),
+		Bio:            user.Bio,
+		ProfilePrivacy: user.ProfilePrivacy,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		DeletedAt:      user.DeletedAt,
 	}
 }
 func (user User) FromClaimsUpdate(claims Claims) User {
 	return User{
-		ID:        claims.ID,
-		FirstName: claims.FirstName,
-		LastName:  claims.LastName,
-		NickName:  user.NickName,
-		ImageUrl:  user.ImageUrl,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-		DeletedAt: user.DeletedAt,
+		ID:             claims.ID,
+		FirstName:      claims.FirstName,
+		LastName:       claims.LastName,
+		Email:          user.claimedEmail(claims),
+		NickName:       user.NickName,
+		Handle:         user.Handle,
+		ImageUrl:       user.ImageUrl,
+		Bio:            user.Bio,
+		ProfilePrivacy: user.ProfilePrivacy,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		DeletedAt:      user.DeletedAt,
 	}
 }
 func (user User) FromClaimUpdate(claims Claims) *User {
 	return &User{
-		ID:        claims.ID,
-		FirstName: claims.FirstName,
-		LastName:  claims.LastName,
-		NickName:  user.NickName,
-		ImageUrl:  user.ImageUrl,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-		DeletedAt: user.DeletedAt,
+		ID:             claims.ID,
+		FirstName:      claims.FirstName,
+		LastName:       claims.LastName,
+		Email:          user.claimedEmail(claims),
+		NickName:       user.NickName,
+		Handle:         user.Handle,
+		ImageUrl:       user.ImageUrl,
+		Bio:            user.Bio,
+		ProfilePrivacy: user.ProfilePrivacy,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		DeletedAt:      user.DeletedAt,
 	}
 }
 func (user User) FromClaim(claims Claims) *User {
 	return &User{
-		ID:        claims.ID,
-		FirstName: claims.FirstName,
-		LastName:  claims.LastName,
-		NickName:  claims.FirstName + " " + claims.LastName,
-		ImageUrl:  func(s string) *string { return &s }("https://avatar.iran.liara.run/public/boy"),
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-		DeletedAt: user.DeletedAt,
+		ID:             claims.ID,
+		FirstName:      claims.FirstName,
+		LastName:       claims.LastName,
+		Email:          user.claimedEmail(claims),
+		NickName:       claims.FirstName + " " + claims.LastName,
+		Handle:         user.Handle,
+		ImageUrl:       func(s string) *string { return &s }("https://avatar.iran.liara.run/public/boy"),
+		Bio:            user.Bio,
+		ProfilePrivacy: user.ProfilePrivacy,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		DeletedAt:      user.DeletedAt,
+	}
+}
+
+func (user User) ToRequest() dto.UserRequest {
+	return dto.UserRequest{
+		NickName: user.NickName,
+		ImageUrl: derefString(user.ImageUrl),
+		Bio:      user.Bio,
+		Privacy:  user.ProfilePrivacy.ToResponse(),
 	}
 }
 
@@ -72,10 +106,19 @@ func (user User) ToResponse() dto.UserResponse {
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Nickname:  user.NickName,
+		Handle:    derefString(user.Handle),
 		ImageUrl:  derefString(user.ImageUrl),
 	}
 }
 
+// claimedEmail อีเมลมาจาก Keycloak token ที่ไม่มี claim email ไม่ลบอีเมลเดิม
+func (user User) claimedEmail(claims Claims) string {
+	if claims.Email != "" {
+		return claims.Email
+	}
+	return user.Email
+}
+
 func derefString(s *string) string {
 	if s != nil {
 		return *s
@@ -85,10 +128,56 @@ func derefString(s *string) string {
 
 func (user User) FromRequest(request dto.UserRequest, claims Claims) *User {
 	return &User{
-		ID:        claims.ID,
-		FirstName: claims.FirstName,
-		LastName:  claims.LastName,
-		NickName:  request.NickName,
-		ImageUrl:  &request.ImageUrl,
+		ID:             claims.ID,
+		FirstName:      claims.FirstName,
+		LastName:       claims.LastName,
+		Email:          claims.Email,
+		NickName:       request.NickName,
+		ImageUrl:       &request.ImageUrl,
+		Bio:            request.Bio,
+		ProfilePrivacy: ProfilePrivacy{}.FromRequest(request.Privacy),
 	}
-}
\ No newline at end of file
+}
+
+// ToAccountResponse ข้อมูลที่ผู้ใช้เห็นของตัวเอง รวมการตั้งค่าที่ไม่แสดงต่อผู้อื่น
+func (user User) ToAccountResponse() dto.AccountResponse {
+	return dto.AccountResponse{
+		UserResponse: user.ToResponse(),
+		Email:        user.Email,
+		Bio:          user.Bio,
+		Privacy:      user.ProfilePrivacy.ToResponse(),
+	}
+}
+
+// ProfilePrivacy ช่องในโปรไฟล์สาธารณะที่ผู้ใช้เลือกซ่อน
+// ค่าเริ่มต้นคือแสดงทุกช่อง จึงเก็บเป็น Hide ให้ตรงกับ zero value ของ bool
+type ProfilePrivacy struct {
+	HideBio               bool
+	HideAvatar            bool
+	HideJoinedAt          bool
+	HideRecipeCount       bool
+	HideAverageRating     bool
+	HideFavoritesReceived bool
+}
+
+func (privacy ProfilePrivacy) FromRequest(request dto.ProfilePrivacy) ProfilePrivacy {
+	return ProfilePrivacy{
+		HideBio:               request.HideBio,
+		HideAvatar:            request.HideAvatar,
+		HideJoinedAt:          request.HideJoinedAt,
+		HideRecipeCount:       request.HideRecipeCount,
+		HideAverageRating:     request.HideAverageRating,
+		HideFavoritesReceived: request.HideFavoritesReceived,
+	}
+}
+
+func (privacy ProfilePrivacy) ToResponse() dto.ProfilePrivacy {
+	return dto.ProfilePrivacy{
+		HideBio:               privacy.HideBio,
+		HideAvatar:            privacy.HideAvatar,
+		HideJoinedAt:          privacy.HideJoinedAt,
+		HideRecipeCount:       privacy.HideRecipeCount,
+		HideAverageRating:     privacy.HideAverageRating,
+		HideFavoritesReceived: privacy.HideFavoritesReceived,
+	}
+}
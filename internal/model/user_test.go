@@ -4,9 +4,9 @@ import (
 	"testing"
 	"time"
 	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
 
 	"github.com/stretchr/testify/assert"
-	"gorm.io/gorm"
 )
 
 func TestUserFromClaims(t *testing.T) {
@@ -20,24 +20,51 @@ func TestUserFromClaims(t *testing.T) {
 		}
 
 		user := model.User{
-			Model: gorm.Model{
-				ID:        1,
-				CreatedAt: mockTime,
-				UpdatedAt: mockTime,
-			},
+			CreatedAt: mockTime,
+			UpdatedAt: mockTime,
 		}
 
+		imageURL := "https://avatar.iran.liara.run/public/boy"
 		expectedUser := model.User{
-			Model: gorm.Model{
-				ID:        1,
-				CreatedAt: mockTime,
-				UpdatedAt: mockTime,
-			},
 			ID:        "ID",
 			FirstName: "FirstName",
 			LastName:  "LastName",
+			NickName:  "FirstName LastName",
+			ImageUrl:  &imageURL,
+			CreatedAt: mockTime,
+			UpdatedAt: mockTime,
 		}
 
 		assert.Equal(t, expectedUser, user.FromClaims(claims))
 	})
+
+	t.Run("ShouldKeepEmailWhenClaimMissing", func(t *testing.T) {
+		user := model.User{Email: "old@example.com"}
+
+		assert.Equal(t, "old@example.com", user.FromClaims(model.Claims{ID: "ID"}).Email)
+		assert.Equal(t, "new@example.com", user.FromClaims(model.Claims{ID: "ID", Email: "new@example.com"}).Email)
+	})
+}
+
+func TestUserToRequest(t *testing.T) {
+	t.Run("ShouldReturnUserRequest", func(t *testing.T) {
+		imageURL := "ImageURL"
+
+		user := model.User{
+			ID:       "ID",
+			NickName: "NickName",
+			ImageUrl: &imageURL,
+		}
+
+		assert.Equal(t, dto.UserRequest{NickName: "NickName", ImageUrl: "ImageURL"}, user.ToRequest())
+	})
+
+	t.Run("ShouldReturnEmptyImageWhenUserHasNoImage", func(t *testing.T) {
+		user := model.User{
+			ID:       "ID",
+			NickName: "NickName",
+		}
+
+		assert.Equal(t, dto.UserRequest{NickName: "NickName"}, user.ToRequest())
+	})
 }
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// RecipeView การเปิดดูสูตรอาหาร 1 ครั้ง
+// Viewer คือ "u:<user id>" ถ้า login หรือ "a:<fingerprint>" ถ้าไม่ได้ login
+type RecipeView struct {
+	FoodRecipeID uint
+	Viewer       string
+	ViewedAt     time.Time
+}
+
+// WindowStart ช่วงเวลาที่ใช้ตัดวิวซ้ำ ผู้ชมคนเดิมนับได้ครั้งเดียวต่อช่วง
+func (view RecipeView) WindowStart(window time.Duration) time.Time {
+	return view.ViewedAt.UTC().Truncate(window)
+}
+
+type RecipeViews []RecipeView
+
+// Dedup ตัดวิวซ้ำในช่วงเดียวกันออก เก็บครั้งแรกไว้
+func (views RecipeViews) Dedup(window time.Duration) RecipeViews {
+	type key struct {
+		foodRecipeID uint
+		viewer       string
+		windowStart  time.Time
+	}
+
+	var (
+		results = make(RecipeViews, 0, len(views))
+		seen    = make(map[key]bool, len(views))
+	)
+
+	for _, view := range views {
+		k := key{view.FoodRecipeID, view.Viewer, view.WindowStart(window)}
+		if seen[k] {
+			continue
+		}
+
+		seen[k] = true
+		results = append(results, view)
+	}
+
+	return results
+}
+
+// RecipeViewCount ยอดวิวของสูตรอาหารในช่วงเวลาหนึ่ง (ชั่วโมงหรือวัน)
+type RecipeViewCount struct {
+	FoodRecipeID uint
+	Bucket       time.Time
+	Views        int
+}
+
+// Rollup รวมยอดวิวตามสูตรอาหารและช่วงเวลาที่ได้จาก bucket
+func (views RecipeViews) Rollup(bucket func(time.Time) time.Time) []RecipeViewCount {
+	var (
+		results = make([]RecipeViewCount, 0)
+		index   = make(map[RecipeViewCount]int)
+	)
+
+	for _, view := range views {
+		k := RecipeViewCount{FoodRecipeID: view.FoodRecipeID, Bucket: bucket(view.ViewedAt)}
+		if position, ok := index[k]; ok {
+			results[position].Views++
+			continue
+		}
+
+		index[k] = len(results)
+		k.Views = 1
+		results = append(results, k)
+	}
+
+	// เรียงให้คงที่ เพื่อให้ upsert หลาย transaction lock แถวตามลำดับเดียวกัน
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].FoodRecipeID != results[j].FoodRecipeID {
+			return results[i].FoodRecipeID < results[j].FoodRecipeID
+		}
+		return results[i].Bucket.Before(results[j].Bucket)
+	})
+
+	return results
+}
+
+// ViewHour และ ViewDay แบ่งช่วงตามเวลา UTC
+func ViewHour(viewedAt time.Time) time.Time {
+	return viewedAt.UTC().Truncate(time.Hour)
+}
+
+func ViewDay(viewedAt time.Time) time.Time {
+	year, month, day := viewedAt.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// TrendingQuery Limit มาจาก query string ส่วนที่เหลือมาจาก config.Trending และ config.Rating
+type TrendingQuery struct {
+	Limit             int           `form:"limit" binding:"omitempty,min=1,max=50"`
+	HalfLife          time.Duration `form:"-"`
+	Window            time.Duration `form:"-"`
+	FavoriteWeight    float64       `form:"-"`
+	RatingWeight      float64       `form:"-"`
+	RatingPriorWeight float64       `form:"-"`
+}
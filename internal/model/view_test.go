@@ -0,0 +1,63 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecipeViewWindowStart(t *testing.T) {
+	t.Run("ShouldTruncateToWindowInUTC", func(t *testing.T) {
+		bangkok := time.FixedZone("ICT", 7*60*60)
+		view := model.RecipeView{ViewedAt: time.Date(2026, 10, 19, 17, 45, 10, 0, bangkok)}
+
+		assert.Equal(t, time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC), view.WindowStart(30*time.Minute))
+	})
+}
+
+func TestRecipeViewsDedup(t *testing.T) {
+	t.Run("ShouldKeepFirstViewPerViewerPerWindow", func(t *testing.T) {
+		start := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+		views := model.RecipeViews{
+			{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: start},
+			{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: start.Add(10 * time.Minute)},
+			{FoodRecipeID: 1, Viewer: "u:b", ViewedAt: start.Add(10 * time.Minute)},
+			{FoodRecipeID: 2, Viewer: "u:a", ViewedAt: start.Add(20 * time.Minute)},
+			{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: start.Add(40 * time.Minute)},
+		}
+
+		assert.Equal(t, model.RecipeViews{views[0], views[2], views[3], views[4]}, views.Dedup(30*time.Minute))
+	})
+}
+
+func TestRecipeViewsRollup(t *testing.T) {
+	t.Run("ShouldCountViewsPerRecipePerBucket", func(t *testing.T) {
+		start := time.Date(2026, 10, 19, 23, 30, 0, 0, time.UTC)
+		views := model.RecipeViews{
+			{FoodRecipeID: 2, ViewedAt: start},
+			{FoodRecipeID: 1, ViewedAt: start.Add(40 * time.Minute)},
+			{FoodRecipeID: 1, ViewedAt: start},
+			{FoodRecipeID: 1, ViewedAt: start.Add(10 * time.Minute)},
+		}
+
+		hour := time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC)
+		assert.Equal(t, []model.RecipeViewCount{
+			{FoodRecipeID: 1, Bucket: hour, Views: 2},
+			{FoodRecipeID: 1, Bucket: hour.Add(time.Hour), Views: 1},
+			{FoodRecipeID: 2, Bucket: hour, Views: 1},
+		}, views.Rollup(model.ViewHour))
+
+		day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, []model.RecipeViewCount{
+			{FoodRecipeID: 1, Bucket: day, Views: 2},
+			{FoodRecipeID: 1, Bucket: day.AddDate(0, 0, 1), Views: 1},
+			{FoodRecipeID: 2, Bucket: day, Views: 1},
+		}, views.Rollup(model.ViewDay))
+	})
+
+	t.Run("ShouldReturnEmptyWhenNoViews", func(t *testing.T) {
+		assert.Empty(t, model.RecipeViews{}.Rollup(model.ViewHour))
+	})
+}
@@ -0,0 +1,221 @@
+package notification
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	GetMine(ctx *gin.Context)
+	CountUnread(ctx *gin.Context)
+	MarkRead(ctx *gin.Context)
+	MarkAllRead(ctx *gin.Context)
+	GetPreferences(ctx *gin.Context)
+	UpdatePreferences(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// GetMine godoc
+// @Summary Get my notifications
+// @Description Get the caller's notifications, newest first
+// @Tags notifications
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param unread query bool false "Only unread notifications"
+// @Success 200 {object} dto.NotificationsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/notifications [get]
+func (handler Handler) GetMine(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	query := model.NotificationQuery{Page: 1, Limit: 20}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	notifications, total, err := handler.Service.Get(query, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notifications.ToResponse(total))
+}
+
+// CountUnread godoc
+// @Summary Count my unread notifications
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} dto.NotificationUnreadCountResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/notifications/unread-count [get]
+func (handler Handler) CountUnread(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	count, err := handler.Service.CountUnread(claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.NotificationUnreadCountResponse{Count: count})
+}
+
+// MarkRead godoc
+// @Summary Mark a notification as read
+// @Description Repeating the request keeps the first read time
+// @Tags notifications
+// @Param notificationId path int true "Notification ID"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/notifications/{notificationId}/read [put]
+func (handler Handler) MarkRead(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.MarkRead(pathParamID(ctx, "notificationId"), claims); err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// MarkAllRead godoc
+// @Summary Mark all my notifications as read
+// @Tags notifications
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/notifications/read-all [put]
+func (handler Handler) MarkAllRead(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.MarkAllRead(claims); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetPreferences godoc
+// @Summary Get my notification preferences
+// @Description true means notifications of that type are delivered
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/notification-preferences [get]
+func (handler Handler) GetPreferences(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	preferences, err := handler.Service.GetPreferences(claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preferences.ToResponse())
+}
+
+// UpdatePreferences godoc
+// @Summary Update my notification preferences
+// @Description Set false to mute a type. Types left out of the body are unchanged
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param preferences body dto.NotificationPreferencesRequest true "Preferences"
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/notification-preferences [put]
+func (handler Handler) UpdatePreferences(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.NotificationPreferencesRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	preferences, err := handler.Service.UpdatePreferences(request, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preferences.ToResponse())
+}
+
+func pathParamID(ctx *gin.Context, name string) int {
+	var id int
+
+	pathParam := ctx.Param(name)
+	if pathParam != "" {
+		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
+			id = parsed
+		}
+	}
+
+	return id
+}
@@ -0,0 +1,351 @@
+package notification_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/notification"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := notification.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler notification.IHandler
+	service *MockIService
+
+	// Helper
+	server func(method string, url string, body string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = notification.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, body string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/users/self/notifications", suite.handler.GetMine)
+		router.GET("/api/v1/users/self/notifications/unread-count", suite.handler.CountUnread)
+		router.PUT("/api/v1/users/self/notifications/read-all", suite.handler.MarkAllRead)
+		router.PUT("/api/v1/users/self/notifications/:notificationId/read", suite.handler.MarkRead)
+		router.GET("/api/v1/users/self/notification-preferences", suite.handler.GetPreferences)
+		router.PUT("/api/v1/users/self/notification-preferences", suite.handler.UpdatePreferences)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, strings.NewReader(body))
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+type HandlerGetMineTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respServiceGet  model.Notifications
+	totalServiceGet int64
+	errServiceGet   error
+}
+
+func (suite *HandlerGetMineTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.respServiceGet = model.Notifications{
+		{ID: 1, Type: model.NotificationFollow, Actor: model.User{ID: "Actor"}},
+	}
+	suite.totalServiceGet = 1
+	suite.errServiceGet = nil
+
+	suite.service.On("Get", mock.Anything, mock.Anything).Return(func(model.NotificationQuery, model.Claims) (model.Notifications, int64, error) {
+		return suite.respServiceGet, suite.totalServiceGet, suite.errServiceGet
+	})
+}
+
+func (suite *HandlerGetMineTestSuite) TestResponseNotifications() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications?page=2&limit=5&unread=true", "", &claims)
+
+	expectedJson, _ := json.Marshal(suite.respServiceGet.ToResponse(1))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Get", model.NotificationQuery{Page: 2, Limit: 5, Unread: true}, claims)
+}
+
+func (suite *HandlerGetMineTestSuite) TestDefaultQuery() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications", "", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", model.NotificationQuery{Page: 1, Limit: 20}, claims)
+}
+
+func (suite *HandlerGetMineTestSuite) TestResponseStatusCode400WhenQueryInvalid() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications?limit=101", "", &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerGetMineTestSuite) TestResponseStatusCode401WhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications", "", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func (suite *HandlerGetMineTestSuite) TestResponseErrorWhenGet() {
+	suite.errServiceGet = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerGetMine(t *testing.T) {
+	suite.Run(t, new(HandlerGetMineTestSuite))
+}
+
+type HandlerCountUnreadTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respServiceCountUnread int64
+	errServiceCountUnread  error
+}
+
+func (suite *HandlerCountUnreadTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.respServiceCountUnread = 3
+	suite.errServiceCountUnread = nil
+
+	suite.service.On("CountUnread", mock.Anything).Return(func(model.Claims) (int64, error) {
+		return suite.respServiceCountUnread, suite.errServiceCountUnread
+	})
+}
+
+func (suite *HandlerCountUnreadTestSuite) TestResponseCount() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications/unread-count", "", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"count":3}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "CountUnread", claims)
+}
+
+func (suite *HandlerCountUnreadTestSuite) TestResponseErrorWhenCount() {
+	suite.errServiceCountUnread = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notifications/unread-count", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerCountUnread(t *testing.T) {
+	suite.Run(t, new(HandlerCountUnreadTestSuite))
+}
+
+type HandlerMarkReadTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceMarkRead    error
+	errServiceMarkAllRead error
+}
+
+func (suite *HandlerMarkReadTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceMarkRead = nil
+	suite.errServiceMarkAllRead = nil
+
+	suite.service.On("MarkRead", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceMarkRead
+	})
+	suite.service.On("MarkAllRead", mock.Anything).Return(func(model.Claims) error {
+		return suite.errServiceMarkAllRead
+	})
+}
+
+func (suite *HandlerMarkReadTestSuite) TestResponseStatusCode204WhenMarkRead() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notifications/7/read", "", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "MarkRead", 7, claims)
+}
+
+func (suite *HandlerMarkReadTestSuite) TestResponseStatusCode404WhenNotFound() {
+	suite.errServiceMarkRead = errors.Wrap(gorm.ErrRecordNotFound, "mark notification read")
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notifications/7/read", "", &claims)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerMarkReadTestSuite) TestResponseErrorWhenMarkRead() {
+	suite.errServiceMarkRead = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notifications/7/read", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerMarkReadTestSuite) TestResponseStatusCode204WhenMarkAllRead() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notifications/read-all", "", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "MarkAllRead", claims)
+	suite.service.AssertNotCalled(suite.T(), "MarkRead", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerMarkReadTestSuite) TestResponseErrorWhenMarkAllRead() {
+	suite.errServiceMarkAllRead = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notifications/read-all", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerMarkRead(t *testing.T) {
+	suite.Run(t, new(HandlerMarkReadTestSuite))
+}
+
+type HandlerPreferencesTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respServicePreferences model.NotificationPreferences
+	errServiceGet          error
+	errServiceUpdate       error
+}
+
+func (suite *HandlerPreferencesTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.respServicePreferences = model.NotificationPreferences{Rating: true, Favorite: false, Comment: true, Follow: true, EmailDigest: true, EmailLocale: model.LocaleEnglish}
+	suite.errServiceGet = nil
+	suite.errServiceUpdate = nil
+
+	suite.service.On("GetPreferences", mock.Anything).Return(func(model.Claims) (model.NotificationPreferences, error) {
+		return suite.respServicePreferences, suite.errServiceGet
+	})
+	suite.service.On("UpdatePreferences", mock.Anything, mock.Anything).Return(func(dto.NotificationPreferencesRequest, model.Claims) (model.NotificationPreferences, error) {
+		return suite.respServicePreferences, suite.errServiceUpdate
+	})
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponsePreferences() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notification-preferences", "", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"rating":true,"favorite":false,"comment":true,"follow":true,"emailDigest":true,"emailLocale":"en"}`, response.Body.String())
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseErrorWhenGetPreferences() {
+	suite.errServiceGet = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/notification-preferences", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestUpdatePreferences() {
+	claims := model.Claims{ID: "UID"}
+	disabled := false
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notification-preferences", `{"favorite":false}`, &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"rating":true,"favorite":false,"comment":true,"follow":true,"emailDigest":true,"emailLocale":"en"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "UpdatePreferences", dto.NotificationPreferencesRequest{Favorite: &disabled}, claims)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseStatusCode400WhenBodyInvalid() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notification-preferences", `{"favorite":"no"}`, &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "UpdatePreferences", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseStatusCode400WhenLocaleUnsupported() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notification-preferences", `{"emailDigest":true,"emailLocale":"fr"}`, &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "UpdatePreferences", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseErrorWhenUpdatePreferences() {
+	suite.errServiceUpdate = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/notification-preferences", `{"favorite":false}`, &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerPreferences(t *testing.T) {
+	suite.Run(t, new(HandlerPreferencesTestSuite))
+}
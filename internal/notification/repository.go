@@ -0,0 +1,157 @@
+package notification
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(userID string, query model.NotificationQuery) (model.Notifications, error)
+	Count(userID string, unread bool) (int64, error)
+	// MarkRead อ่านซ้ำไม่เปลี่ยน read_at เดิม
+	MarkRead(userID string, id int) error
+	MarkAllRead(userID string) error
+	GetMutes(userID string) ([]string, error)
+	SetMutes(userID string, mutes map[string]bool) error
+	// GetDigest คืน nil เมื่อไม่ได้สมัครรับ digest
+	GetDigest(userID string) (*model.DigestSubscription, error)
+	// SaveDigest nil คือยกเลิก ถ้าสมัครอยู่แล้วเปลี่ยนแค่ภาษา
+	SaveDigest(userID string, subscription *model.DigestSubscription) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Notify ให้ repository อื่นเรียกภายใน transaction ของการกระทำที่เป็นต้นเหตุ
+// การแจ้งเตือนจึงเกิดขึ้นก็ต่อเมื่อการกระทำนั้นสำเร็จ
+// ไม่แจ้งเตือนการกระทำของตัวเอง และไม่เขียนถ้าผู้รับปิดประเภทนั้นไว้
+func Notify(tx *gorm.DB, notice *model.Notification) error {
+	if notice == nil || notice.RecipientID == "" || notice.RecipientID == notice.ActorID {
+		return nil
+	}
+
+	var muted int64
+	if err := tx.Model(&model.NotificationMute{}).Where("user_id = ? AND type = ?", notice.RecipientID, notice.Type).Count(&muted).Error; err != nil {
+		return err
+	}
+	if muted > 0 {
+		return nil
+	}
+
+	return tx.Create(notice).Error
+}
+
+func (repo Repository) byUser(userID string, unread bool) *gorm.DB {
+	db := repo.DB.Model(&model.Notification{}).Where("recipient_id = ?", userID)
+
+	if unread {
+		db = db.Where("read_at IS NULL")
+	}
+
+	return db
+}
+
+func (repo Repository) Get(userID string, query model.NotificationQuery) (model.Notifications, error) {
+	var notifications = make(model.Notifications, 0)
+
+	offset := (query.Page - 1) * query.Limit
+
+	err := repo.byUser(userID, query.Unread).
+		Preload("Actor").
+		Order("created_at desc, id desc").
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (repo Repository) Count(userID string, unread bool) (int64, error) {
+	var count int64
+
+	if err := repo.byUser(userID, unread).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo Repository) MarkRead(userID string, id int) error {
+	var notice model.Notification
+	if err := repo.byUser(userID, false).First(&notice, id).Error; err != nil {
+		return err
+	}
+
+	return repo.byUser(userID, true).Where("id = ?", id).Update("read_at", time.Now()).Error
+}
+
+func (repo Repository) MarkAllRead(userID string) error {
+	return repo.byUser(userID, true).Update("read_at", time.Now()).Error
+}
+
+func (repo Repository) GetMutes(userID string) ([]string, error) {
+	var mutes = make([]string, 0)
+
+	if err := repo.DB.Model(&model.NotificationMute{}).Where("user_id = ?", userID).Pluck("type", &mutes).Error; err != nil {
+		return nil, err
+	}
+
+	return mutes, nil
+}
+
+// SetMutes true คือปิดประเภทนั้น false คือเปิดกลับ
+func (repo Repository) SetMutes(userID string, mutes map[string]bool) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		for notificationType, muted := range mutes {
+			var err error
+			if muted {
+				err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.NotificationMute{UserID: userID, Type: notificationType}).Error
+			} else {
+				err = tx.Where("user_id = ? AND type = ?", userID, notificationType).Delete(&model.NotificationMute{}).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (repo Repository) GetDigest(userID string) (*model.DigestSubscription, error) {
+	var subscriptions []model.DigestSubscription
+
+	if err := repo.DB.Where("user_id = ?", userID).Limit(1).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+
+	return &subscriptions[0], nil
+}
+
+func (repo Repository) SaveDigest(userID string, subscription *model.DigestSubscription) error {
+	if subscription == nil {
+		return repo.DB.Where("user_id = ?", userID).Delete(&model.DigestSubscription{}).Error
+	}
+
+	return repo.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"locale"}),
+	}).Omit("User").Create(&model.DigestSubscription{UserID: userID, Locale: subscription.Locale}).Error
+}
@@ -0,0 +1,210 @@
+package notification_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/notification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := notification.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository notification.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &notification.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM notifications")
+	suite.db.Exec("DELETE FROM notification_mutes")
+	suite.db.Exec("DELETE FROM digest_subscriptions")
+
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+type RepositoryNotificationTestSuite struct {
+	RepositoryTestSuite
+}
+
+const (
+	recipient = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	actor     = "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+)
+
+func (suite *RepositoryNotificationTestSuite) notify(notificationType string) {
+	suite.NoError(notification.Notify(suite.db, &model.Notification{
+		RecipientID: recipient,
+		ActorID:     actor,
+		Type:        notificationType,
+	}))
+}
+
+func (suite *RepositoryNotificationTestSuite) TestNotifySkipSelfAndMuted() {
+	suite.NoError(notification.Notify(suite.db, nil))
+	suite.NoError(notification.Notify(suite.db, &model.Notification{RecipientID: recipient, ActorID: recipient, Type: model.NotificationFollow}))
+
+	suite.NoError(suite.repository.SetMutes(recipient, map[string]bool{model.NotificationFollow: true}))
+	suite.notify(model.NotificationFollow)
+
+	count, err := suite.repository.Count(recipient, false)
+	suite.NoError(err)
+	suite.Equal(int64(0), count)
+
+	suite.NoError(suite.repository.SetMutes(recipient, map[string]bool{model.NotificationFollow: false}))
+	suite.notify(model.NotificationFollow)
+
+	count, err = suite.repository.Count(recipient, false)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+}
+
+func (suite *RepositoryNotificationTestSuite) TestGetNewestFirstWithActor() {
+	suite.notify(model.NotificationFollow)
+	suite.notify(model.NotificationFavorite)
+
+	notifications, err := suite.repository.Get(recipient, model.NotificationQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+
+	suite.Len(notifications, 2)
+	suite.Equal(model.NotificationFavorite, notifications[0].Type)
+	suite.Equal(actor, notifications[0].Actor.ID)
+
+	others, err := suite.repository.Get(actor, model.NotificationQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+	suite.Empty(others)
+}
+
+func (suite *RepositoryNotificationTestSuite) TestMarkRead() {
+	suite.notify(model.NotificationFollow)
+	suite.notify(model.NotificationFavorite)
+
+	notifications, err := suite.repository.Get(recipient, model.NotificationQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+	id := int(notifications[0].ID)
+
+	// ผู้ใช้อื่นอ่านแทนไม่ได้
+	suite.ErrorIs(suite.repository.MarkRead(actor, id), gorm.ErrRecordNotFound)
+
+	suite.NoError(suite.repository.MarkRead(recipient, id))
+	suite.NoError(suite.repository.MarkRead(recipient, id))
+
+	unread, err := suite.repository.Count(recipient, true)
+	suite.NoError(err)
+	suite.Equal(int64(1), unread)
+
+	suite.NoError(suite.repository.MarkAllRead(recipient))
+
+	unread, err = suite.repository.Count(recipient, true)
+	suite.NoError(err)
+	suite.Equal(int64(0), unread)
+
+	unreadOnly, err := suite.repository.Get(recipient, model.NotificationQuery{Page: 1, Limit: 10, Unread: true})
+	suite.NoError(err)
+	suite.Empty(unreadOnly)
+}
+
+func (suite *RepositoryNotificationTestSuite) TestSetMutesIsIdempotent() {
+	mutes := map[string]bool{model.NotificationRating: true, model.NotificationComment: true}
+
+	suite.NoError(suite.repository.SetMutes(recipient, mutes))
+	suite.NoError(suite.repository.SetMutes(recipient, mutes))
+
+	result, err := suite.repository.GetMutes(recipient)
+	suite.NoError(err)
+	suite.ElementsMatch([]string{model.NotificationRating, model.NotificationComment}, result)
+}
+
+func (suite *RepositoryNotificationTestSuite) TestSaveDigest() {
+	subscription, err := suite.repository.GetDigest(recipient)
+	suite.NoError(err)
+	suite.Nil(subscription)
+
+	suite.NoError(suite.repository.SaveDigest(recipient, &model.DigestSubscription{Locale: model.LocaleThai}))
+	suite.NoError(suite.db.Exec("UPDATE digest_subscriptions SET last_sent_at = NOW() WHERE user_id = ?", recipient).Error)
+
+	// สมัครซ้ำเปลี่ยนแค่ภาษา รอบการส่งเดิมยังอยู่
+	suite.NoError(suite.repository.SaveDigest(recipient, &model.DigestSubscription{Locale: model.LocaleEnglish}))
+
+	subscription, err = suite.repository.GetDigest(recipient)
+	suite.NoError(err)
+	suite.Equal(model.LocaleEnglish, subscription.Locale)
+	suite.NotNil(subscription.LastSentAt)
+
+	suite.NoError(suite.repository.SaveDigest(recipient, nil))
+
+	subscription, err = suite.repository.GetDigest(recipient)
+	suite.NoError(err)
+	suite.Nil(subscription)
+}
+
+func TestRepositoryNotification(t *testing.T) {
+	suite.Run(t, new(RepositoryNotificationTestSuite))
+}
@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.NotificationQuery, claims model.Claims) (model.Notifications, int64, error)
+	CountUnread(claims model.Claims) (int64, error)
+	MarkRead(id int, claims model.Claims) error
+	MarkAllRead(claims model.Claims) error
+	GetPreferences(claims model.Claims) (model.NotificationPreferences, error)
+	UpdatePreferences(request dto.NotificationPreferencesRequest, claims model.Claims) (model.NotificationPreferences, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.NotificationQuery, claims model.Claims) (model.Notifications, int64, error) {
+	total, err := service.Repository.Count(claims.ID, query.Unread)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "count notifications")
+	}
+
+	notifications, err := service.Repository.Get(claims.ID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "find notifications")
+	}
+
+	return notifications, total, nil
+}
+
+func (service Service) CountUnread(claims model.Claims) (int64, error) {
+	count, err := service.Repository.Count(claims.ID, true)
+	if err != nil {
+		return 0, errors.Wrap(err, "count notifications")
+	}
+
+	return count, nil
+}
+
+func (service Service) MarkRead(id int, claims model.Claims) error {
+	if err := service.Repository.MarkRead(claims.ID, id); err != nil {
+		return errors.Wrap(err, "mark notification read")
+	}
+
+	return nil
+}
+
+func (service Service) MarkAllRead(claims model.Claims) error {
+	if err := service.Repository.MarkAllRead(claims.ID); err != nil {
+		return errors.Wrap(err, "mark notifications read")
+	}
+
+	return nil
+}
+
+func (service Service) GetPreferences(claims model.Claims) (model.NotificationPreferences, error) {
+	mutes, err := service.Repository.GetMutes(claims.ID)
+	if err != nil {
+		return model.NotificationPreferences{}, errors.Wrap(err, "find preferences")
+	}
+
+	subscription, err := service.Repository.GetDigest(claims.ID)
+	if err != nil {
+		return model.NotificationPreferences{}, errors.Wrap(err, "find digest subscription")
+	}
+
+	return model.NotificationPreferences{}.FromMutes(mutes).WithDigest(subscription), nil
+}
+
+func (service Service) UpdatePreferences(request dto.NotificationPreferencesRequest, claims model.Claims) (model.NotificationPreferences, error) {
+	mutes := model.NotificationPreferences{}.MutesFromRequest(request)
+
+	if err := service.Repository.SetMutes(claims.ID, mutes); err != nil {
+		return model.NotificationPreferences{}, errors.Wrap(err, "save preferences")
+	}
+
+	if request.EmailDigest != nil || request.EmailLocale != nil {
+		current, err := service.Repository.GetDigest(claims.ID)
+		if err != nil {
+			return model.NotificationPreferences{}, errors.Wrap(err, "find digest subscription")
+		}
+
+		subscription := model.NotificationPreferences{}.DigestFromRequest(request, claims.ID, current)
+		if err := service.Repository.SaveDigest(claims.ID, subscription); err != nil {
+			return model.NotificationPreferences{}, errors.Wrap(err, "save digest subscription")
+		}
+	}
+
+	return service.GetPreferences(claims)
+}
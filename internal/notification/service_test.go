@@ -0,0 +1,297 @@
+package notification_test
+
+import (
+	"reflect"
+	"testing"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/notification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := notification.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service notification.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGet   model.Notifications
+	errGet    error
+	respCount int64
+	errCount  error
+}
+
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &notification.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGet = model.Notifications{{ID: 1, Type: model.NotificationRating}}
+	suite.errGet = nil
+	suite.respCount = 1
+	suite.errCount = nil
+
+	suite.repo.On("Get", mock.Anything, mock.Anything).Return(func(string, model.NotificationQuery) (model.Notifications, error) {
+		return suite.respGet, suite.errGet
+	})
+	suite.repo.On("Count", mock.Anything, mock.Anything).Return(func(string, bool) (int64, error) {
+		return suite.respCount, suite.errCount
+	})
+}
+
+func (suite *ServiceGetTestSuite) TestGetCallerNotifications() {
+	query := model.NotificationQuery{Page: 1, Limit: 20, Unread: true}
+
+	notifications, total, err := suite.service.Get(query, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respGet, notifications)
+	suite.Equal(int64(1), total)
+	suite.repo.AssertCalled(suite.T(), "Get", "UID", query)
+	suite.repo.AssertCalled(suite.T(), "Count", "UID", true)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenCount() {
+	suite.errCount = assert.AnError
+
+	_, _, err := suite.service.Get(model.NotificationQuery{Page: 1, Limit: 20}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.repo.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceGetTestSuite) TestErrorWhenGet() {
+	suite.errGet = assert.AnError
+
+	_, _, err := suite.service.Get(model.NotificationQuery{Page: 1, Limit: 20}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceGetTestSuite) TestCountUnreadOnly() {
+	count, err := suite.service.CountUnread(model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+	suite.repo.AssertCalled(suite.T(), "Count", "UID", true)
+}
+
+func TestServiceGet(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
+
+type ServiceMarkReadTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service notification.IService
+	repo    *MockIRepository
+
+	// Mock data
+	errMarkRead    error
+	errMarkAllRead error
+}
+
+func (suite *ServiceMarkReadTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &notification.Service{
+		Repository: suite.repo,
+	}
+
+	suite.errMarkRead = nil
+	suite.errMarkAllRead = nil
+
+	suite.repo.On("MarkRead", mock.Anything, mock.Anything).Return(func(string, int) error {
+		return suite.errMarkRead
+	})
+	suite.repo.On("MarkAllRead", mock.Anything).Return(func(string) error {
+		return suite.errMarkAllRead
+	})
+}
+
+func (suite *ServiceMarkReadTestSuite) TestMarkReadScopedToCaller() {
+	err := suite.service.MarkRead(7, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "MarkRead", "UID", 7)
+}
+
+func (suite *ServiceMarkReadTestSuite) TestErrorWhenMarkRead() {
+	suite.errMarkRead = gorm.ErrRecordNotFound
+
+	err := suite.service.MarkRead(7, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceMarkReadTestSuite) TestMarkAllRead() {
+	err := suite.service.MarkAllRead(model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "MarkAllRead", "UID")
+}
+
+func (suite *ServiceMarkReadTestSuite) TestErrorWhenMarkAllRead() {
+	suite.errMarkAllRead = assert.AnError
+
+	err := suite.service.MarkAllRead(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceMarkRead(t *testing.T) {
+	suite.Run(t, new(ServiceMarkReadTestSuite))
+}
+
+type ServicePreferencesTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service notification.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetMutes  []string
+	errGetMutes   error
+	errSetMutes   error
+	respGetDigest *model.DigestSubscription
+	errGetDigest  error
+	errSaveDigest error
+}
+
+func (suite *ServicePreferencesTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &notification.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetMutes = []string{model.NotificationComment}
+	suite.errGetMutes = nil
+	suite.errSetMutes = nil
+	suite.respGetDigest = nil
+	suite.errGetDigest = nil
+	suite.errSaveDigest = nil
+
+	suite.repo.On("GetDigest", mock.Anything).Return(func(string) (*model.DigestSubscription, error) {
+		return suite.respGetDigest, suite.errGetDigest
+	})
+	suite.repo.On("SaveDigest", mock.Anything, mock.Anything).Return(func(string, *model.DigestSubscription) error {
+		return suite.errSaveDigest
+	})
+	suite.repo.On("GetMutes", mock.Anything).Return(func(string) ([]string, error) {
+		return suite.respGetMutes, suite.errGetMutes
+	})
+	suite.repo.On("SetMutes", mock.Anything, mock.Anything).Return(func(string, map[string]bool) error {
+		return suite.errSetMutes
+	})
+}
+
+func (suite *ServicePreferencesTestSuite) TestGetPreferencesFromMutes() {
+	preferences, err := suite.service.GetPreferences(model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal(model.NotificationPreferences{Rating: true, Favorite: true, Comment: false, Follow: true, EmailLocale: model.LocaleThai}, preferences)
+	suite.repo.AssertCalled(suite.T(), "GetMutes", "UID")
+	suite.repo.AssertCalled(suite.T(), "GetDigest", "UID")
+}
+
+func (suite *ServicePreferencesTestSuite) TestGetPreferencesWithDigest() {
+	suite.respGetDigest = &model.DigestSubscription{UserID: "UID", Locale: model.LocaleEnglish}
+
+	preferences, err := suite.service.GetPreferences(model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.True(preferences.EmailDigest)
+	suite.Equal(model.LocaleEnglish, preferences.EmailLocale)
+}
+
+func (suite *ServicePreferencesTestSuite) TestErrorWhenGetDigest() {
+	suite.errGetDigest = assert.AnError
+
+	_, err := suite.service.GetPreferences(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServicePreferencesTestSuite) TestErrorWhenGetMutes() {
+	suite.errGetMutes = assert.AnError
+
+	_, err := suite.service.GetPreferences(model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServicePreferencesTestSuite) TestUpdatePreferences() {
+	disabled := false
+
+	preferences, err := suite.service.UpdatePreferences(dto.NotificationPreferencesRequest{Comment: &disabled}, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.False(preferences.Comment)
+	suite.repo.AssertCalled(suite.T(), "SetMutes", "UID", map[string]bool{model.NotificationComment: true})
+	// ไม่ได้ส่งช่อง digest มา ไม่แตะการสมัคร
+	suite.repo.AssertNotCalled(suite.T(), "SaveDigest", mock.Anything, mock.Anything)
+}
+
+func (suite *ServicePreferencesTestSuite) TestSubscribeDigest() {
+	enabled := true
+	english := model.LocaleEnglish
+
+	_, err := suite.service.UpdatePreferences(dto.NotificationPreferencesRequest{EmailDigest: &enabled, EmailLocale: &english}, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "SaveDigest", "UID", &model.DigestSubscription{UserID: "UID", Locale: model.LocaleEnglish})
+}
+
+func (suite *ServicePreferencesTestSuite) TestUnsubscribeDigest() {
+	suite.respGetDigest = &model.DigestSubscription{UserID: "UID", Locale: model.LocaleThai}
+	disabled := false
+
+	_, err := suite.service.UpdatePreferences(dto.NotificationPreferencesRequest{EmailDigest: &disabled}, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "SaveDigest", "UID", (*model.DigestSubscription)(nil))
+}
+
+func (suite *ServicePreferencesTestSuite) TestErrorWhenSaveDigest() {
+	suite.errSaveDigest = assert.AnError
+	enabled := true
+
+	_, err := suite.service.UpdatePreferences(dto.NotificationPreferencesRequest{EmailDigest: &enabled}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServicePreferencesTestSuite) TestErrorWhenSetMutes() {
+	suite.errSetMutes = assert.AnError
+	disabled := false
+
+	_, err := suite.service.UpdatePreferences(dto.NotificationPreferencesRequest{Comment: &disabled}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.repo.AssertNotCalled(suite.T(), "GetMutes", mock.Anything)
+}
+
+func TestServicePreferences(t *testing.T) {
+	suite.Run(t, new(ServicePreferencesTestSuite))
+}
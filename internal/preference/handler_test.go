@@ -0,0 +1,193 @@
+package preference_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/preference"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := preference.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type HandlerPreferencesTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler preference.IHandler
+	service *MockIService
+
+	// Helper
+	server func(method string, url string, body string, claims *model.Claims) *httptest.ResponseRecorder
+
+	// Mock data
+	respServicePreferences model.UserPreferences
+	errServiceGet          error
+	errServiceUpdate       error
+}
+
+func (suite *HandlerPreferencesTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerPreferencesTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = preference.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, body string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/users/self/preferences", suite.handler.Get)
+		router.PUT("/api/v1/users/self/preferences", suite.handler.Update)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, strings.NewReader(body))
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServicePreferences = model.UserPreferences{
+		UserID:              "UID",
+		Language:            model.LanguageEnglish,
+		Units:               model.UnitsMetric,
+		Diets:               model.Labels{model.DietVegan},
+		DislikedIngredients: model.Labels{"cilantro"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}
+	suite.errServiceGet = nil
+	suite.errServiceUpdate = nil
+
+	suite.service.On("Get", mock.Anything).Return(func(string) (model.UserPreferences, error) {
+		return suite.respServicePreferences, suite.errServiceGet
+	})
+	suite.service.On("Update", mock.Anything, mock.Anything).Return(func(dto.PreferencesRequest, model.Claims) (model.UserPreferences, error) {
+		return suite.respServicePreferences, suite.errServiceUpdate
+	})
+}
+
+const preferencesJson = `{"language":"en","units":"metric","diets":["vegan"],"dislikedIngredients":["cilantro"],"defaultSort":"rating"}`
+
+func (suite *HandlerPreferencesTestSuite) TestResponsePreferences() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/preferences", "", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(preferencesJson, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Get", "UID")
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseEmptyListsAsArrays() {
+	suite.respServicePreferences = model.DefaultPreferences("UID")
+	suite.respServicePreferences.Diets = nil
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/preferences", "", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"language":"th","units":"metric","diets":[],"dislikedIngredients":[],"defaultSort":""}`, response.Body.String())
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseStatusCode401WhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/preferences", "", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseErrorWhenGet() {
+	suite.errServiceGet = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/preferences", "", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestUpdatePreferences() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/preferences", preferencesJson, &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(preferencesJson, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Update", dto.PreferencesRequest{
+		Language:            model.LanguageEnglish,
+		Units:               model.UnitsMetric,
+		Diets:               []string{model.DietVegan},
+		DislikedIngredients: []string{"cilantro"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}, claims)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseStatusCode400WhenSchemaInvalid() {
+	claims := model.Claims{ID: "UID"}
+
+	for _, body := range []string{
+		`{"units":"metric"}`,
+		`{"language":"fr","units":"metric"}`,
+		`{"language":"en","units":"stone"}`,
+		`{"language":"en","units":"metric","diets":["keto"]}`,
+		`{"language":"en","units":"metric","diets":["vegan","vegan"]}`,
+		`{"language":"en","units":"metric","dislikedIngredients":[""]}`,
+		`{"language":"en","units":"metric","defaultSort":"newest"}`,
+		`{"language":"en","units":"metric","diets":"vegan"}`,
+	} {
+		response := suite.server(http.MethodPut, "/api/v1/users/self/preferences", body, &claims)
+
+		suite.Equal(http.StatusBadRequest, response.Code, body)
+	}
+
+	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseStatusCode401WhenUpdateWithoutClaims() {
+	response := suite.server(http.MethodPut, "/api/v1/users/self/preferences", preferencesJson, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func (suite *HandlerPreferencesTestSuite) TestResponseErrorWhenUpdate() {
+	suite.errServiceUpdate = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/preferences", preferencesJson, &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerPreferences(t *testing.T) {
+	suite.Run(t, new(HandlerPreferencesTestSuite))
+}
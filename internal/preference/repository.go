@@ -0,0 +1,46 @@
+package preference
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	// Get คืน nil เมื่อผู้ใช้ยังไม่เคยบันทึก preferences
+	Get(userID string) (*model.UserPreferences, error)
+	Save(preferences *model.UserPreferences) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) Get(userID string) (*model.UserPreferences, error) {
+	var preferences []model.UserPreferences
+
+	if err := repo.DB.Where("user_id = ?", userID).Limit(1).Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+
+	if len(preferences) == 0 {
+		return nil, nil
+	}
+
+	return &preferences[0], nil
+}
+
+// Save แทนที่เอกสารเดิมทั้งหมด
+func (repo Repository) Save(preferences *model.UserPreferences) error {
+	return repo.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		UpdateAll: true,
+	}).Create(preferences).Error
+}
@@ -0,0 +1,139 @@
+package preference_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/preference"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := preference.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository preference.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &preference.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM user_preferences")
+
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+type RepositoryPreferencesTestSuite struct {
+	RepositoryTestSuite
+}
+
+const userID = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+func (suite *RepositoryPreferencesTestSuite) TestGetNilWhenNeverSaved() {
+	preferences, err := suite.repository.Get(userID)
+
+	suite.NoError(err)
+	suite.Nil(preferences)
+}
+
+func (suite *RepositoryPreferencesTestSuite) TestSaveReplacesDocument() {
+	suite.NoError(suite.repository.Save(&model.UserPreferences{
+		UserID:              userID,
+		Language:            model.LanguageEnglish,
+		Units:               model.UnitsImperial,
+		Diets:               model.Labels{model.DietVegan},
+		DislikedIngredients: model.Labels{"cilantro"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}))
+
+	suite.NoError(suite.repository.Save(&model.UserPreferences{
+		UserID:   userID,
+		Language: model.LanguageThai,
+		Units:    model.UnitsMetric,
+	}))
+
+	preferences, err := suite.repository.Get(userID)
+
+	suite.NoError(err)
+	suite.Require().NotNil(preferences)
+	suite.Equal(model.LanguageThai, preferences.Language)
+	suite.Equal(model.UnitsMetric, preferences.Units)
+	suite.Empty(preferences.Diets)
+	suite.Empty(preferences.DislikedIngredients)
+	suite.Empty(preferences.DefaultSort)
+}
+
+func (suite *RepositoryPreferencesTestSuite) TestErrorWhenLanguageUnsupported() {
+	err := suite.repository.Save(&model.UserPreferences{UserID: userID, Language: "fr", Units: model.UnitsMetric})
+
+	suite.Error(err)
+}
+
+func TestRepositoryPreferences(t *testing.T) {
+	suite.Run(t, new(RepositoryPreferencesTestSuite))
+}
@@ -0,0 +1,48 @@
+package preference
+
+import (
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	// Get ผู้ใช้ที่ยังไม่เคยบันทึกได้ค่าเริ่มต้น
+	Get(userID string) (model.UserPreferences, error)
+	Update(request dto.PreferencesRequest, claims model.Claims) (model.UserPreferences, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(userID string) (model.UserPreferences, error) {
+	preferences, err := service.Repository.Get(userID)
+	if err != nil {
+		return model.UserPreferences{}, errors.Wrap(err, "find preferences")
+	}
+
+	if preferences == nil {
+		return model.DefaultPreferences(userID), nil
+	}
+
+	return *preferences, nil
+}
+
+func (service Service) Update(request dto.PreferencesRequest, claims model.Claims) (model.UserPreferences, error) {
+	preferences := model.UserPreferences{}.FromRequest(request, claims.ID)
+
+	if err := service.Repository.Save(&preferences); err != nil {
+		return model.UserPreferences{}, errors.Wrap(err, "save preferences")
+	}
+
+	return preferences, nil
+}
@@ -0,0 +1,122 @@
+package preference_test
+
+import (
+	"reflect"
+	"testing"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/preference"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := preference.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type ServicePreferencesTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service preference.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGet *model.UserPreferences
+	errGet  error
+	errSave error
+}
+
+func (suite *ServicePreferencesTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &preference.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGet = nil
+	suite.errGet = nil
+	suite.errSave = nil
+
+	suite.repo.On("Get", mock.Anything).Return(func(string) (*model.UserPreferences, error) {
+		return suite.respGet, suite.errGet
+	})
+	suite.repo.On("Save", mock.Anything).Return(func(*model.UserPreferences) error {
+		return suite.errSave
+	})
+}
+
+func (suite *ServicePreferencesTestSuite) TestGetDefaultsWhenNeverSaved() {
+	preferences, err := suite.service.Get("UID")
+
+	suite.NoError(err)
+	suite.Equal(model.DefaultPreferences("UID"), preferences)
+	suite.Equal(model.LanguageThai, preferences.Language)
+	suite.Equal(model.UnitsMetric, preferences.Units)
+}
+
+func (suite *ServicePreferencesTestSuite) TestGetSavedPreferences() {
+	suite.respGet = &model.UserPreferences{UserID: "UID", Language: model.LanguageEnglish, Units: model.UnitsImperial}
+
+	preferences, err := suite.service.Get("UID")
+
+	suite.NoError(err)
+	suite.Equal(*suite.respGet, preferences)
+	suite.repo.AssertCalled(suite.T(), "Get", "UID")
+}
+
+func (suite *ServicePreferencesTestSuite) TestErrorWhenGet() {
+	suite.errGet = assert.AnError
+
+	_, err := suite.service.Get("UID")
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServicePreferencesTestSuite) TestUpdateNormalizesDislikedIngredients() {
+	request := dto.PreferencesRequest{
+		Language:            model.LanguageEnglish,
+		Units:               model.UnitsImperial,
+		Diets:               []string{model.DietHalal},
+		DislikedIngredients: []string{" Cilantro", "cilantro", "Durian"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}
+
+	preferences, err := suite.service.Update(request, model.Claims{ID: "UID"})
+
+	expected := model.UserPreferences{
+		UserID:              "UID",
+		Language:            model.LanguageEnglish,
+		Units:               model.UnitsImperial,
+		Diets:               model.Labels{model.DietHalal},
+		DislikedIngredients: model.Labels{"cilantro", "durian"},
+		DefaultSort:         model.FoodRecipeSortRating,
+	}
+
+	suite.NoError(err)
+	suite.Equal(expected, preferences)
+	suite.repo.AssertCalled(suite.T(), "Save", &expected)
+}
+
+func (suite *ServicePreferencesTestSuite) TestErrorWhenSave() {
+	suite.errSave = assert.AnError
+
+	_, err := suite.service.Update(dto.PreferencesRequest{Language: model.LanguageThai, Units: model.UnitsMetric}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServicePreferences(t *testing.T) {
+	suite.Run(t, new(ServicePreferencesTestSuite))
+}
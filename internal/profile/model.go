@@ -1,8 +0,0 @@
-package profile
-
-type User struct {
-    ID       string `gorm:"primaryKey" json:"id"`
-    Name     string `json:"name"`
-    Nickname string `json:"nickname"`
-    Avatar   string `json:"avatar"`
-}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"wongnok/internal/global"
 	"wongnok/internal/helper"
+	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
 	"github.com/gin-gonic/gin"
@@ -15,6 +17,11 @@ import (
 type IHandler interface {
 	Get(ctx *gin.Context)
 	Create(ctx *gin.Context)
+	GetMine(ctx *gin.Context)
+	UpdateMine(ctx *gin.Context)
+	DeleteMine(ctx *gin.Context)
+	Vote(ctx *gin.Context)
+	Unvote(ctx *gin.Context)
 }
 
 type Handler struct {
@@ -28,27 +35,32 @@ func NewHandler(db *gorm.DB) *Handler {
 }
 
 // Get godoc
-// @Summary Get ratings
-// @Description Get ratings for a food recipe by ID
+// @Summary Get reviews
+// @Description Get ratings and reviews for a food recipe by ID. Signed-in callers don't see ratings of users they muted or share a block with
 // @Tags ratings
 // @Accept json
 // @Produce json
 // @Param id path string false "Food Recipe ID"
-// @Success 200 {object} dto.FoodRecipesResponse
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param sort query string false "Sort order" Enums(newest, highest, lowest, helpful) default(newest)
+// @Success 200 {object} dto.RatingsResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Router /api/v1/food-recipes/{id}/ratings [get]
 func (handler Handler) Get(ctx *gin.Context) {
-	var id int
+	query := model.RatingQuery{Page: 1, Limit: 20, Sort: model.RatingSortNewest}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
 
-	pathParam := ctx.Param("id")
-	if pathParam != "" {
-		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
-			id = parsed
-		}
+	// ไม่ login ก็ดูได้
+	if claims, err := helper.DecodeClaims(ctx); err == nil {
+		query.ViewerID = claims.ID
 	}
 
-	ratings, err := handler.Service.Get(id)
+	ratings, total, err := handler.Service.Get(pathParamID(ctx, "id"), query)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Rating not found"})
@@ -58,34 +70,119 @@ func (handler Handler) Get(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, ratings.ToResponse())
+	ctx.JSON(http.StatusOK, ratings.ToResponse(total))
 }
 
 // Create godoc
-// @Summary Create a rating
-// @Description Create a new rating for a food recipe by ID
+// @Summary Rate a food recipe
+// @Description Rate a food recipe by ID, replacing the caller's previous rating if any
 // @Tags ratings
 // @Accept json
 // @Produce json
 // @Param id path string true "Food Recipe ID"
 // @Param request body dto.RatingRequest true "Rating Request"
+// @Success 200 {object} dto.RatingResponse
 // @Success 201 {object} dto.RatingResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Security BearerAuth
 // @Router /api/v1/food-recipes/{id}/ratings [post]
 func (handler Handler) Create(ctx *gin.Context) {
-	var request dto.RatingRequest
-	var id int
+	handler.upsert(ctx)
+}
 
-	pathParam := ctx.Param("id")
-	if pathParam != "" {
-		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
-			id = parsed
+// GetMine godoc
+// @Summary Get my rating
+// @Description Get the caller's rating for a food recipe by ID
+// @Tags ratings
+// @Produce json
+// @Param id path string true "Food Recipe ID"
+// @Success 200 {object} dto.RatingResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/me [get]
+func (handler Handler) GetMine(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	rating, err := handler.Service.GetByUser(pathParamID(ctx, "id"), claims)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Rating not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
+
+// UpdateMine godoc
+// @Summary Set my rating
+// @Description Create or replace the caller's rating for a food recipe by ID
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param id path string true "Food Recipe ID"
+// @Param request body dto.RatingRequest true "Rating Request"
+// @Success 200 {object} dto.RatingResponse
+// @Success 201 {object} dto.RatingResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/me [put]
+func (handler Handler) UpdateMine(ctx *gin.Context) {
+	handler.upsert(ctx)
+}
+
+// DeleteMine godoc
+// @Summary Delete my rating
+// @Description Delete the caller's rating for a food recipe by ID
+// @Tags ratings
+// @Param id path string true "Food Recipe ID"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/me [delete]
+func (handler Handler) DeleteMine(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(pathParamID(ctx, "id"), claims); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Rating not found"})
+			return
 		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
 	}
 
+	ctx.Status(http.StatusNoContent)
+}
+
+// upsert POST กับ PUT ทำงานเหมือนกัน ตอบ 201 เมื่อเป็น rating ใหม่ และ 200 เมื่อแก้ของเดิม
+func (handler Handler) upsert(ctx *gin.Context) {
+	var request dto.RatingRequest
+
+	id := pathParamID(ctx, "id")
+
 	if err := ctx.BindJSON(&request); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
 		return
@@ -97,17 +194,112 @@ func (handler Handler) Create(ctx *gin.Context) {
 		return
 	}
 
-	rating, err := handler.Service.Create(request, id, claims)
+	rating, created, err := handler.Service.Upsert(request, id, claims)
 
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		if errors.As(err, &validator.ValidationErrors{}) {
-			statusCode = http.StatusBadRequest
-		}
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	statusCode := http.StatusOK
+	if created {
+		statusCode = http.StatusCreated
+	}
+
+	ctx.JSON(statusCode, rating.ToResponse())
+}
+
+// Vote godoc
+// @Summary Vote on a review
+// @Description Mark another user's rating as helpful or unhelpful. Repeating the same vote changes nothing
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param id path string true "Food Recipe ID"
+// @Param ratingId path string true "Rating ID"
+// @Param request body dto.RatingVoteRequest true "Vote Request"
+// @Success 200 {object} dto.RatingResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/{ratingId}/vote [put]
+func (handler Handler) Vote(ctx *gin.Context) {
+	var request dto.RatingVoteRequest
+
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
 
-		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+	rating, err := handler.Service.Vote(request, pathParamID(ctx, "id"), pathParamID(ctx, "ratingId"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, rating.ToResponse())
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
+
+// Unvote godoc
+// @Summary Remove my vote on a review
+// @Description Remove the caller's vote on a rating. Succeeds even when there is no vote
+// @Tags ratings
+// @Produce json
+// @Param id path string true "Food Recipe ID"
+// @Param ratingId path string true "Rating ID"
+// @Success 200 {object} dto.RatingResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/{ratingId}/vote [delete]
+func (handler Handler) Unvote(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	rating, err := handler.Service.Unvote(pathParamID(ctx, "id"), pathParamID(ctx, "ratingId"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden), errors.Is(err, global.ErrBlocked):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func pathParamID(ctx *gin.Context, name string) int {
+	var id int
+
+	pathParam := ctx.Param(name)
+	if pathParam != "" {
+		if parsed, err := strconv.Atoi(pathParam); err == nil && parsed > 0 {
+			id = parsed
+		}
+	}
+
+	return id
 }
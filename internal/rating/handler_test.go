@@ -8,12 +8,14 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/rating"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -47,8 +49,13 @@ type HandlerCreateRatingTestSuite struct {
 	claims model.Claims
 
 	respServiceCreate model.Rating
+	respCreated       bool
 	errServiceCreate  error
 
+	// Request
+	method string
+	url    string
+
 	// Helper
 	server func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
 }
@@ -78,14 +85,15 @@ func (suite *HandlerCreateRatingTestSuite) SetupTest() {
 		})
 
 		router.POST("/api/v1/food-recipes/:id/ratings", suite.handler.Create)
+		router.PUT("/api/v1/food-recipes/:id/ratings/me", suite.handler.UpdateMine)
 
 		// ใช้ httptest.NewRecorder() จำลอง server และ request เพื่อตรวจสอบ HTTP response จริงๆ
 		recorder := httptest.NewRecorder()
 
 		// Create request
 		request, err := http.NewRequest(
-			http.MethodPost,
-			"/api/v1/food-recipes/1/ratings",
+			suite.method,
+			suite.url,
 			payload,
 		)
 
@@ -102,18 +110,21 @@ func (suite *HandlerCreateRatingTestSuite) SetupTest() {
 		FoodRecipeID: 1,
 	}
 
+	suite.respCreated = true
 	suite.errServiceCreate = nil
-	suite.service.On("Create",
+	suite.method = http.MethodPost
+	suite.url = "/api/v1/food-recipes/1/ratings"
+	suite.service.On("Upsert",
 		mock.AnythingOfType("dto.RatingRequest"),
 		mock.AnythingOfType("int"),
 
 		mock.AnythingOfType("model.Claims"),
-	).Return(func(request dto.RatingRequest, id int, claim model.Claims) (model.Rating, error) {
+	).Return(func(request dto.RatingRequest, id int, claim model.Claims) (model.Rating, bool, error) {
 
 		if id == 1 {
-			return suite.respServiceCreate, suite.errServiceCreate
+			return suite.respServiceCreate, suite.respCreated, suite.errServiceCreate
 		}
-		return model.Rating{}, assert.AnError
+		return model.Rating{}, false, assert.AnError
 	})
 }
 
@@ -136,13 +147,39 @@ func (suite *HandlerCreateRatingTestSuite) TestResponseRatingWithStatusCode201()
 
 	suite.Equal(http.StatusCreated, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
-	suite.service.AssertCalled(suite.T(), "Create", dto.RatingRequest{
+	suite.service.AssertCalled(suite.T(), "Upsert", dto.RatingRequest{
 		Score: 5,
 	}, 1, model.Claims{
 		ID: "UID",
 	})
 }
 
+func (suite *HandlerCreateRatingTestSuite) TestResponseStatusCode200WhenRatingReplaced() {
+	suite.respCreated = false
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 5}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+}
+
+func (suite *HandlerCreateRatingTestSuite) TestUpdateMineWithSameUpsert() {
+	suite.method = http.MethodPut
+	suite.url = "/api/v1/food-recipes/1/ratings/me"
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 4}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.service.AssertCalled(suite.T(), "Upsert", dto.RatingRequest{Score: 4}, 1, claims)
+}
+
 func (suite *HandlerCreateRatingTestSuite) TestResponseErrorWhenRequestInvalid() {
 	payload := strings.NewReader(``)
 
@@ -154,7 +191,7 @@ func (suite *HandlerCreateRatingTestSuite) TestResponseErrorWhenRequestInvalid()
 
 	suite.Equal(http.StatusBadRequest, response.Code)
 	suite.Equal(`{"message":"EOF"}`, response.Body.String())
-	suite.service.AssertNotCalled(suite.T(), "Create", mock.AnythingOfType("dto.RatingRequest"), mock.AnythingOfType("int"))
+	suite.service.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *HandlerCreateRatingTestSuite) TestValidationErrorsWhenServiceCreateRating() {
@@ -173,6 +210,48 @@ func (suite *HandlerCreateRatingTestSuite) TestValidationErrorsWhenServiceCreate
 	suite.Equal(`{"message":""}`, response.Body.String())
 }
 
+func (suite *HandlerCreateRatingTestSuite) TestResponseStatusCode404WhenRecipeNotFound() {
+	suite.errServiceCreate = errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 4.5}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+	suite.Equal(`{"message":"find recipe: record not found"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Upsert", dto.RatingRequest{Score: 4.5}, 1, claims)
+}
+
+func (suite *HandlerCreateRatingTestSuite) TestResponseStatusCode403WhenBlocked() {
+	suite.errServiceCreate = global.ErrBlocked
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 5}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.Equal(`{"message":"blocked by or blocking this user"}`, response.Body.String())
+}
+
+func (suite *HandlerCreateRatingTestSuite) TestUpdateMineStatusCode403WhenBlocked() {
+	suite.errServiceCreate = global.ErrBlocked
+	suite.method = http.MethodPut
+	suite.url = "/api/v1/food-recipes/1/ratings/me"
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 4}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.Equal(`{"message":"blocked by or blocking this user"}`, response.Body.String())
+}
+
 func (suite *HandlerCreateRatingTestSuite) TestResponseErrorStatusCode400() {
 	payload := strings.NewReader(`{"score": 5}`)
 	response := suite.server(payload, nil)
@@ -199,8 +278,13 @@ type HandlerGetRatingsTestSuite struct {
 
 	// Mock data
 	respServiceGet model.Ratings
+	respTotal      int64
 	errServiceGet  error
 
+	// Request
+	url    string
+	claims *model.Claims
+
 	// Helper
 	server func(payload io.Reader) *httptest.ResponseRecorder
 }
@@ -221,6 +305,11 @@ func (suite *HandlerGetRatingsTestSuite) SetupTest() {
 	suite.server = func(payload io.Reader) *httptest.ResponseRecorder {
 		// Create router
 		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if suite.claims != nil {
+				ctx.Set("claims", *suite.claims)
+			}
+		})
 		router.GET("/api/v1/food-recipes/:id/ratings", suite.handler.Get)
 
 		// Recoder
@@ -229,7 +318,7 @@ func (suite *HandlerGetRatingsTestSuite) SetupTest() {
 		// Create request
 		request, err := http.NewRequest(
 			http.MethodGet,
-			"/api/v1/food-recipes/1/ratings",
+			suite.url,
 			payload,
 		)
 		suite.NoError(err)
@@ -255,12 +344,15 @@ func (suite *HandlerGetRatingsTestSuite) SetupTest() {
 		},
 	}
 
+	suite.respTotal = 2
 	suite.errServiceGet = nil
-	suite.service.On("Get", mock.AnythingOfType("int")).Return(func(id int) (model.Ratings, error) {
+	suite.url = "/api/v1/food-recipes/1/ratings"
+	suite.claims = nil
+	suite.service.On("Get", mock.AnythingOfType("int"), mock.AnythingOfType("model.RatingQuery")).Return(func(id int, query model.RatingQuery) (model.Ratings, int64, error) {
 		if id == 1 {
-			return suite.respServiceGet, suite.errServiceGet
+			return suite.respServiceGet, suite.respTotal, suite.errServiceGet
 		}
-		return model.Ratings{}, assert.AnError
+		return model.Ratings{}, 0, assert.AnError
 	})
 }
 
@@ -272,6 +364,7 @@ func (suite *HandlerGetRatingsTestSuite) TestResponseRatingsWithStatusCode200()
 	defer body.Close()
 
 	expectedResponse := dto.RatingsResponse{
+		Total: 2,
 		Results: []dto.RatingResponse{
 
 			{Score: 5, FoodRecipeID: 1, UserID: "1a"},
@@ -283,7 +376,43 @@ func (suite *HandlerGetRatingsTestSuite) TestResponseRatingsWithStatusCode200()
 
 	suite.Equal(http.StatusOK, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
-	suite.service.AssertCalled(suite.T(), "Get", 1)
+	suite.service.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{Page: 1, Limit: 20, Sort: model.RatingSortNewest})
+}
+
+func (suite *HandlerGetRatingsTestSuite) TestPassQueryToService() {
+	suite.url = "/api/v1/food-recipes/1/ratings?page=2&limit=5&sort=helpful"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{Page: 2, Limit: 5, Sort: model.RatingSortHelpful})
+}
+
+func (suite *HandlerGetRatingsTestSuite) TestPassViewerToService() {
+	suite.claims = &model.Claims{ID: "Viewer"}
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{Page: 1, Limit: 20, Sort: model.RatingSortNewest, ViewerID: "Viewer"})
+}
+
+func (suite *HandlerGetRatingsTestSuite) TestResponseStatusCode400WhenSortInvalid() {
+	suite.url = "/api/v1/food-recipes/1/ratings?sort=oldest"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
 }
 
 func (suite *HandlerGetRatingsTestSuite) TestResponseErrorWhenRecipeNotFound() {
@@ -302,3 +431,236 @@ func (suite *HandlerGetRatingsTestSuite) TestResponseErrorWhenRecipeNotFound() {
 func TestHandlerGetRatings(t *testing.T) {
 	suite.Run(t, new(HandlerGetRatingsTestSuite))
 }
+
+type HandlerMyRatingTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler rating.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceGetByUser model.Rating
+	errServiceGetByUser  error
+	errServiceDelete     error
+
+	// Helper
+	server func(method string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerMyRatingTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerMyRatingTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = rating.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+		router.GET("/api/v1/food-recipes/:id/ratings/me", suite.handler.GetMine)
+		router.DELETE("/api/v1/food-recipes/:id/ratings/me", suite.handler.DeleteMine)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, "/api/v1/food-recipes/1/ratings/me", nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceGetByUser = model.Rating{Score: 4, FoodRecipeID: 1, UserID: "UID"}
+	suite.errServiceGetByUser = nil
+	suite.errServiceDelete = nil
+
+	suite.service.On("GetByUser", mock.Anything, mock.Anything).Return(func(int, model.Claims) (model.Rating, error) {
+		return suite.respServiceGetByUser, suite.errServiceGetByUser
+	})
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerMyRatingTestSuite) TestGetMineWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodGet, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(suite.respServiceGetByUser.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "GetByUser", 1, claims)
+}
+
+func (suite *HandlerMyRatingTestSuite) TestGetMineStatusCode404WhenNotRated() {
+	suite.errServiceGetByUser = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodGet, &model.Claims{ID: "UID"})
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+	suite.Equal(`{"message":"Rating not found"}`, response.Body.String())
+}
+
+func (suite *HandlerMyRatingTestSuite) TestDeleteMineWithStatusCode204() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodDelete, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "Delete", 1, claims)
+}
+
+func (suite *HandlerMyRatingTestSuite) TestDeleteMineStatusCode404WhenNotRated() {
+	suite.errServiceDelete = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodDelete, &model.Claims{ID: "UID"})
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerMyRatingTestSuite) TestStatusCode401WhenNoClaims() {
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		response := suite.server(method, nil)
+
+		suite.Equal(http.StatusUnauthorized, response.Code)
+	}
+	suite.service.AssertNotCalled(suite.T(), "GetByUser", mock.Anything, mock.Anything)
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func TestHandlerMyRating(t *testing.T) {
+	suite.Run(t, new(HandlerMyRatingTestSuite))
+}
+
+type HandlerVoteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler rating.IHandler
+	service *MockIService
+
+	// Mock data
+	respService model.Rating
+	errService  error
+
+	// Helper
+	server func(method string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerVoteTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerVoteTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = rating.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+		// ลงทะเบียนคู่กับ /ratings/me เหมือน main.go เพื่อให้แน่ใจว่า path ไม่ชนกัน
+		router.GET("/api/v1/food-recipes/:id/ratings/me", suite.handler.GetMine)
+		router.PUT("/api/v1/food-recipes/:id/ratings/:ratingId/vote", suite.handler.Vote)
+		router.DELETE("/api/v1/food-recipes/:id/ratings/:ratingId/vote", suite.handler.Unvote)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, "/api/v1/food-recipes/1/ratings/7/vote", payload)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respService = model.Rating{Model: gorm.Model{ID: 7}, Score: 4, FoodRecipeID: 1, UserID: "Author", HelpfulCount: 1}
+	suite.errService = nil
+
+	suite.service.On("Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(func(dto.RatingVoteRequest, int, int, model.Claims) (model.Rating, error) {
+		return suite.respService, suite.errService
+	})
+	suite.service.On("Unvote", mock.Anything, mock.Anything, mock.Anything).Return(func(int, int, model.Claims) (model.Rating, error) {
+		return suite.respService, suite.errService
+	})
+}
+
+func (suite *HandlerVoteTestSuite) TestVoteWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPut, strings.NewReader(`{"helpful":true}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.respService.ToResponse())
+
+	helpful := true
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Vote", dto.RatingVoteRequest{Helpful: &helpful}, 1, 7, claims)
+}
+
+func (suite *HandlerVoteTestSuite) TestVoteStatusCode400WhenHelpfulMissing() {
+	suite.errService = errors.Wrap(validator.ValidationErrors{}, "request invalid")
+
+	response := suite.server(http.MethodPut, strings.NewReader(`{}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (suite *HandlerVoteTestSuite) TestVoteStatusCode403WhenOwnRating() {
+	suite.errService = errors.Wrap(global.ErrForbidden, "vote own rating")
+
+	response := suite.server(http.MethodPut, strings.NewReader(`{"helpful":false}`), &model.Claims{ID: "Author"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerVoteTestSuite) TestVoteStatusCode404WhenRatingNotFound() {
+	suite.errService = errors.Wrap(gorm.ErrRecordNotFound, "find rating")
+
+	response := suite.server(http.MethodPut, strings.NewReader(`{"helpful":true}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerVoteTestSuite) TestUnvoteWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodDelete, nil, &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Unvote", 1, 7, claims)
+}
+
+func (suite *HandlerVoteTestSuite) TestStatusCode401WhenNoClaims() {
+	suite.Equal(http.StatusUnauthorized, suite.server(http.MethodPut, strings.NewReader(`{"helpful":true}`), nil).Code)
+	suite.Equal(http.StatusUnauthorized, suite.server(http.MethodDelete, nil, nil).Code)
+	suite.service.AssertNotCalled(suite.T(), "Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.service.AssertNotCalled(suite.T(), "Unvote", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlerVote(t *testing.T) {
+	suite.Run(t, new(HandlerVoteTestSuite))
+}
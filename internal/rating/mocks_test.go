@@ -79,6 +79,46 @@ func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// DeleteMine provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) DeleteMine(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_DeleteMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMine'
+type MockIHandler_DeleteMine_Call struct {
+	*mock.Call
+}
+
+// DeleteMine is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) DeleteMine(ctx interface{}) *MockIHandler_DeleteMine_Call {
+	return &MockIHandler_DeleteMine_Call{Call: _e.mock.On("DeleteMine", ctx)}
+}
+
+func (_c *MockIHandler_DeleteMine_Call) Run(run func(ctx *gin.Context)) *MockIHandler_DeleteMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_DeleteMine_Call) Return() *MockIHandler_DeleteMine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_DeleteMine_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_DeleteMine_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) Get(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -109,23 +149,2755 @@ func (_c *MockIHandler_Get_Call) Run(run func(ctx *gin.Context)) *MockIHandler_G
 	return _c
 }
 
-func (_c *MockIHandler_Get_Call) Return() *MockIHandler_Get_Call {
-	_c.Call.Return()
+func (_c *MockIHandler_Get_Call) Return() *MockIHandler_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetMine provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetMine(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMine'
+type MockIHandler_GetMine_Call struct {
+	*mock.Call
+}
+
+// GetMine is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetMine(ctx interface{}) *MockIHandler_GetMine_Call {
+	return &MockIHandler_GetMine_Call{Call: _e.mock.On("GetMine", ctx)}
+}
+
+func (_c *MockIHandler_GetMine_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetMine_Call) Return() *MockIHandler_GetMine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetMine_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetMine_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Unvote provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Unvote(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Unvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unvote'
+type MockIHandler_Unvote_Call struct {
+	*mock.Call
+}
+
+// Unvote is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Unvote(ctx interface{}) *MockIHandler_Unvote_Call {
+	return &MockIHandler_Unvote_Call{Call: _e.mock.On("Unvote", ctx)}
+}
+
+func (_c *MockIHandler_Unvote_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Unvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Unvote_Call) Return() *MockIHandler_Unvote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Unvote_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Unvote_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateMine provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) UpdateMine(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_UpdateMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMine'
+type MockIHandler_UpdateMine_Call struct {
+	*mock.Call
+}
+
+// UpdateMine is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) UpdateMine(ctx interface{}) *MockIHandler_UpdateMine_Call {
+	return &MockIHandler_UpdateMine_Call{Call: _e.mock.On("UpdateMine", ctx)}
+}
+
+func (_c *MockIHandler_UpdateMine_Call) Run(run func(ctx *gin.Context)) *MockIHandler_UpdateMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_UpdateMine_Call) Return() *MockIHandler_UpdateMine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_UpdateMine_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_UpdateMine_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Vote provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Vote(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockIHandler_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Vote(ctx interface{}) *MockIHandler_Vote_Call {
+	return &MockIHandler_Vote_Call{Call: _e.mock.On("Vote", ctx)}
+}
+
+func (_c *MockIHandler_Vote_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Vote_Call) Return() *MockIHandler_Vote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Vote_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Vote_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRepository {
+	mock := &MockIRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIRepository is an autogenerated mock type for the IRepository type
+type MockIRepository struct {
+	mock.Mock
+}
+
+type MockIRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Count(recipeID int, query model.RatingQuery) (int64, error) {
+	ret := _mock.Called(recipeID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) (int64, error)); ok {
+		return returnFunc(recipeID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) int64); ok {
+		r0 = returnFunc(recipeID, query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.RatingQuery) error); ok {
+		r1 = returnFunc(recipeID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockIRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - recipeID int
+//   - query model.RatingQuery
+func (_e *MockIRepository_Expecter) Count(recipeID interface{}, query interface{}) *MockIRepository_Count_Call {
+	return &MockIRepository_Count_Call{Call: _e.mock.On("Count", recipeID, query)}
+}
+
+func (_c *MockIRepository_Count_Call) Run(run func(recipeID int, query model.RatingQuery)) *MockIRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.RatingQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.RatingQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) RunAndReturn(run func(recipeID int, query model.RatingQuery) (int64, error)) *MockIRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(recipeID int, userID string) error {
+	ret := _mock.Called(recipeID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(recipeID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - recipeID int
+//   - userID string
+func (_e *MockIRepository_Expecter) Delete(recipeID interface{}, userID interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", recipeID, userID)}
+}
+
+func (_c *MockIRepository_Delete_Call) Run(run func(recipeID int, userID string)) *MockIRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(recipeID int, userID string) error) *MockIRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Get(recipeID int, query model.RatingQuery) (model.Ratings, error) {
+	ret := _mock.Called(recipeID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Ratings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) (model.Ratings, error)); ok {
+		return returnFunc(recipeID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) model.Ratings); ok {
+		r0 = returnFunc(recipeID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Ratings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.RatingQuery) error); ok {
+		r1 = returnFunc(recipeID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - recipeID int
+//   - query model.RatingQuery
+func (_e *MockIRepository_Expecter) Get(recipeID interface{}, query interface{}) *MockIRepository_Get_Call {
+	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", recipeID, query)}
+}
+
+func (_c *MockIRepository_Get_Call) Run(run func(recipeID int, query model.RatingQuery)) *MockIRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.RatingQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.RatingQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) Return(ratings model.Ratings, err error) *MockIRepository_Get_Call {
+	_c.Call.Return(ratings, err)
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) RunAndReturn(run func(recipeID int, query model.RatingQuery) (model.Ratings, error)) *MockIRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(recipeID int, ratingID int) (model.Rating, error) {
+	ret := _mock.Called(recipeID, ratingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (model.Rating, error)); ok {
+		return returnFunc(recipeID, ratingID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) model.Rating); ok {
+		r0 = returnFunc(recipeID, ratingID)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(recipeID, ratingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - recipeID int
+//   - ratingID int
+func (_e *MockIRepository_Expecter) GetByID(recipeID interface{}, ratingID interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", recipeID, ratingID)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(recipeID int, ratingID int)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(rating model.Rating, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(recipeID int, ratingID int) (model.Rating, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUser provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByUser(recipeID int, userID string) (model.Rating, error) {
+	ret := _mock.Called(recipeID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUser")
+	}
+
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (model.Rating, error)); ok {
+		return returnFunc(recipeID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) model.Rating); ok {
+		r0 = returnFunc(recipeID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(recipeID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockIRepository_GetByUser_Call struct {
+	*mock.Call
+}
+
+// GetByUser is a helper method to define mock.On call
+//   - recipeID int
+//   - userID string
+func (_e *MockIRepository_Expecter) GetByUser(recipeID interface{}, userID interface{}) *MockIRepository_GetByUser_Call {
+	return &MockIRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", recipeID, userID)}
+}
+
+func (_c *MockIRepository_GetByUser_Call) Run(run func(recipeID int, userID string)) *MockIRepository_GetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) Return(rating model.Rating, err error) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) RunAndReturn(run func(recipeID int, userID string) (model.Rating, error)) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepairSummaries provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) RepairSummaries() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepairSummaries")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_RepairSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairSummaries'
+type MockIRepository_RepairSummaries_Call struct {
+	*mock.Call
+}
+
+// RepairSummaries is a helper method to define mock.On call
+func (_e *MockIRepository_Expecter) RepairSummaries() *MockIRepository_RepairSummaries_Call {
+	return &MockIRepository_RepairSummaries_Call{Call: _e.mock.On("RepairSummaries")}
+}
+
+func (_c *MockIRepository_RepairSummaries_Call) Run(run func()) *MockIRepository_RepairSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIRepository_RepairSummaries_Call) Return(n int64, err error) *MockIRepository_RepairSummaries_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_RepairSummaries_Call) RunAndReturn(run func() (int64, error)) *MockIRepository_RepairSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unvote provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Unvote(ratingID uint, userID string) error {
+	ret := _mock.Called(ratingID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unvote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, string) error); ok {
+		r0 = returnFunc(ratingID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Unvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unvote'
+type MockIRepository_Unvote_Call struct {
+	*mock.Call
+}
+
+// Unvote is a helper method to define mock.On call
+//   - ratingID uint
+//   - userID string
+func (_e *MockIRepository_Expecter) Unvote(ratingID interface{}, userID interface{}) *MockIRepository_Unvote_Call {
+	return &MockIRepository_Unvote_Call{Call: _e.mock.On("Unvote", ratingID, userID)}
+}
+
+func (_c *MockIRepository_Unvote_Call) Run(run func(ratingID uint, userID string)) *MockIRepository_Unvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Unvote_Call) Return(err error) *MockIRepository_Unvote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Unvote_Call) RunAndReturn(run func(ratingID uint, userID string) error) *MockIRepository_Unvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Upsert(rating *model.Rating, notice *model.Notification) error {
+	ret := _mock.Called(rating, notice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Rating, *model.Notification) error); ok {
+		r0 = returnFunc(rating, notice)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockIRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - rating *model.Rating
+//   - notice *model.Notification
+func (_e *MockIRepository_Expecter) Upsert(rating interface{}, notice interface{}) *MockIRepository_Upsert_Call {
+	return &MockIRepository_Upsert_Call{Call: _e.mock.On("Upsert", rating, notice)}
+}
+
+func (_c *MockIRepository_Upsert_Call) Run(run func(rating *model.Rating, notice *model.Notification)) *MockIRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Rating
+		if args[0] != nil {
+			arg0 = args[0].(*model.Rating)
+		}
+		var arg1 *model.Notification
+		if args[1] != nil {
+			arg1 = args[1].(*model.Notification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) Return(err error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) RunAndReturn(run func(rating *model.Rating, notice *model.Notification) error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Vote provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Vote(vote *model.RatingVote) error {
+	ret := _mock.Called(vote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.RatingVote) error); ok {
+		r0 = returnFunc(vote)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockIRepository_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - vote *model.RatingVote
+func (_e *MockIRepository_Expecter) Vote(vote interface{}) *MockIRepository_Vote_Call {
+	return &MockIRepository_Vote_Call{Call: _e.mock.On("Vote", vote)}
+}
+
+func (_c *MockIRepository_Vote_Call) Run(run func(vote *model.RatingVote)) *MockIRepository_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.RatingVote
+		if args[0] != nil {
+			arg0 = args[0].(*model.RatingVote)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Vote_Call) Return(err error) *MockIRepository_Vote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Vote_Call) RunAndReturn(run func(vote *model.RatingVote) error) *MockIRepository_Vote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUserService {
+	mock := &MockIUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIUserService is an autogenerated mock type for the IUserService type
+type MockIUserService struct {
+	mock.Mock
+}
+
+type MockIUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
+	return &MockIUserService_Expecter{mock: &_m.Mock}
+}
+
+// Block provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Block(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockIUserService_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Block(userID interface{}, claims interface{}) *MockIUserService_Block_Call {
+	return &MockIUserService_Block_Call{Call: _e.mock.On("Block", userID, claims)}
+}
+
+func (_c *MockIUserService_Block_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Block_Call) Return(err error) *MockIUserService_Block_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Block_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeHandle provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) ChangeHandle(request dto.HandleRequest, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeHandle")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.HandleRequest, model.Claims) (model.User, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.HandleRequest, model.Claims) model.User); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.HandleRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_ChangeHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeHandle'
+type MockIUserService_ChangeHandle_Call struct {
+	*mock.Call
+}
+
+// ChangeHandle is a helper method to define mock.On call
+//   - request dto.HandleRequest
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) ChangeHandle(request interface{}, claims interface{}) *MockIUserService_ChangeHandle_Call {
+	return &MockIUserService_ChangeHandle_Call{Call: _e.mock.On("ChangeHandle", request, claims)}
+}
+
+func (_c *MockIUserService_ChangeHandle_Call) Run(run func(request dto.HandleRequest, claims model.Claims)) *MockIUserService_ChangeHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.HandleRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.HandleRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_ChangeHandle_Call) Return(user model.User, err error) *MockIUserService_ChangeHandle_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_ChangeHandle_Call) RunAndReturn(run func(request dto.HandleRequest, claims model.Claims) (model.User, error)) *MockIUserService_ChangeHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBlocked provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) CheckBlocked(userID string, otherID string) error {
+	ret := _mock.Called(userID, otherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlocked")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, otherID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_CheckBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBlocked'
+type MockIUserService_CheckBlocked_Call struct {
+	*mock.Call
+}
+
+// CheckBlocked is a helper method to define mock.On call
+//   - userID string
+//   - otherID string
+func (_e *MockIUserService_Expecter) CheckBlocked(userID interface{}, otherID interface{}) *MockIUserService_CheckBlocked_Call {
+	return &MockIUserService_CheckBlocked_Call{Call: _e.mock.On("CheckBlocked", userID, otherID)}
+}
+
+func (_c *MockIUserService_CheckBlocked_Call) Run(run func(userID string, otherID string)) *MockIUserService_CheckBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_CheckBlocked_Call) Return(err error) *MockIUserService_CheckBlocked_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_CheckBlocked_Call) RunAndReturn(run func(userID string, otherID string) error) *MockIUserService_CheckBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Create(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIUserService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Create(claims interface{}) *MockIUserService_Create_Call {
+	return &MockIUserService_Create_Call{Call: _e.mock.On("Create", claims)}
+}
+
+func (_c *MockIUserService_Create_Call) Run(run func(claims model.Claims)) *MockIUserService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) Return(user model.User, err error) *MockIUserService_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Follow(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockIUserService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Follow(userID interface{}, claims interface{}) *MockIUserService_Follow_Call {
+	return &MockIUserService_Follow_Call{Call: _e.mock.On("Follow", userID, claims)}
+}
+
+func (_c *MockIUserService_Follow_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Follow_Call) Return(err error) *MockIUserService_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Follow_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlocked provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetBlocked(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocked")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocked'
+type MockIUserService_GetBlocked_Call struct {
+	*mock.Call
+}
+
+// GetBlocked is a helper method to define mock.On call
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetBlocked(query interface{}, claims interface{}) *MockIUserService_GetBlocked_Call {
+	return &MockIUserService_GetBlocked_Call{Call: _e.mock.On("GetBlocked", query, claims)}
+}
+
+func (_c *MockIUserService_GetBlocked_Call) Run(run func(query model.FollowQuery, claims model.Claims)) *MockIUserService_GetBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FollowQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FollowQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetBlocked_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetBlocked_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetBlocked_Call) RunAndReturn(run func(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
+	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+}
+
+func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetFollowers(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockIUserService_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetFollowers(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetFollowers_Call {
+	return &MockIUserService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetFollowers_Call) Run(run func(userID string, query model.FollowQuery, claims model.Claims)) *MockIUserService_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowers_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetFollowers_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowers_Call) RunAndReturn(run func(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetFollowing(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type MockIUserService_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetFollowing(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetFollowing_Call {
+	return &MockIUserService_GetFollowing_Call{Call: _e.mock.On("GetFollowing", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetFollowing_Call) Run(run func(userID string, query model.FollowQuery, claims model.Claims)) *MockIUserService_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowing_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetFollowing_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetFollowing_Call) RunAndReturn(run func(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMuted provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetMuted(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMuted")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIUserService_GetMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMuted'
+type MockIUserService_GetMuted_Call struct {
+	*mock.Call
+}
+
+// GetMuted is a helper method to define mock.On call
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetMuted(query interface{}, claims interface{}) *MockIUserService_GetMuted_Call {
+	return &MockIUserService_GetMuted_Call{Call: _e.mock.On("GetMuted", query, claims)}
+}
+
+func (_c *MockIUserService_GetMuted_Call) Run(run func(query model.FollowQuery, claims model.Claims)) *MockIUserService_GetMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FollowQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FollowQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetMuted_Call) Return(users model.Users, n int64, err error) *MockIUserService_GetMuted_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetMuted_Call) RunAndReturn(run func(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIUserService_GetMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetProfile(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery, model.Claims) (model.Profile, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery, model.Claims) model.Profile); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		r0 = ret.Get(0).(model.Profile)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.ProfileQuery, model.Claims) error); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockIUserService_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - userID string
+//   - query model.ProfileQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetProfile(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetProfile_Call {
+	return &MockIUserService_GetProfile_Call{Call: _e.mock.On("GetProfile", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetProfile_Call) Run(run func(userID string, query model.ProfileQuery, claims model.Claims)) *MockIUserService_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.ProfileQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.ProfileQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetProfile_Call) Return(profile model.Profile, err error) *MockIUserService_GetProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetProfile_Call) RunAndReturn(run func(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error)) *MockIUserService_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(userID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIUserService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
+	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Mute(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockIUserService_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Mute(userID interface{}, claims interface{}) *MockIUserService_Mute_Call {
+	return &MockIUserService_Mute_Call{Call: _e.mock.On("Mute", userID, claims)}
+}
+
+func (_c *MockIUserService_Mute_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Mute_Call) Return(err error) *MockIUserService_Mute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Mute_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Patch(patch []byte, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(patch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) (model.User, error)); ok {
+		return returnFunc(patch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) model.User); ok {
+		r0 = returnFunc(patch, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, model.Claims) error); ok {
+		r1 = returnFunc(patch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIUserService_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - patch []byte
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Patch(patch interface{}, claims interface{}) *MockIUserService_Patch_Call {
+	return &MockIUserService_Patch_Call{Call: _e.mock.On("Patch", patch, claims)}
+}
+
+func (_c *MockIUserService_Patch_Call) Run(run func(patch []byte, claims model.Claims)) *MockIUserService_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Patch_Call) Return(user model.User, err error) *MockIUserService_Patch_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Patch_Call) RunAndReturn(run func(patch []byte, claims model.Claims) (model.User, error)) *MockIUserService_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Unblock(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockIUserService_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Unblock(userID interface{}, claims interface{}) *MockIUserService_Unblock_Call {
+	return &MockIUserService_Unblock_Call{Call: _e.mock.On("Unblock", userID, claims)}
+}
+
+func (_c *MockIUserService_Unblock_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Unblock_Call) Return(err error) *MockIUserService_Unblock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Unblock_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Unfollow(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockIUserService_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Unfollow(userID interface{}, claims interface{}) *MockIUserService_Unfollow_Call {
+	return &MockIUserService_Unfollow_Call{Call: _e.mock.On("Unfollow", userID, claims)}
+}
+
+func (_c *MockIUserService_Unfollow_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Unfollow_Call) Return(err error) *MockIUserService_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Unfollow_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Unmute(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserService_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockIUserService_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Unmute(userID interface{}, claims interface{}) *MockIUserService_Unmute_Call {
+	return &MockIUserService_Unmute_Call{Call: _e.mock.On("Unmute", userID, claims)}
+}
+
+func (_c *MockIUserService_Unmute_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Unmute_Call) Return(err error) *MockIUserService_Unmute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserService_Unmute_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIUserService_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIUserService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIUserService_Expecter) Update(user interface{}) *MockIUserService_Update_Call {
+	return &MockIUserService_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIUserService_Update_Call) Run(run func(user *model.User)) *MockIUserService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) Return(user model.User, err error) *MockIUserService_Update_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIUserService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertWithClaims provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) UpsertWithClaims(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithClaims")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
+type MockIUserService_UpsertWithClaims_Call struct {
+	*mock.Call
+}
+
+// UpsertWithClaims is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) UpsertWithClaims(claims interface{}) *MockIUserService_UpsertWithClaims_Call {
+	return &MockIUserService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIFoodRecipeService creates a new instance of MockIFoodRecipeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIFoodRecipeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIFoodRecipeService {
+	mock := &MockIFoodRecipeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIFoodRecipeService is an autogenerated mock type for the IFoodRecipeService type
+type MockIFoodRecipeService struct {
+	mock.Mock
+}
+
+type MockIFoodRecipeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIFoodRecipeService) EXPECT() *MockIFoodRecipeService_Expecter {
+	return &MockIFoodRecipeService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIFoodRecipeService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Create(request interface{}, claims interface{}) *MockIFoodRecipeService_Create_Call {
+	return &MockIFoodRecipeService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Delete(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ifMatch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ifMatch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ifMatch, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(id, ifMatch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIFoodRecipeService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - ifMatch model.IfMatch
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Delete(id interface{}, ifMatch interface{}, claims interface{}) *MockIFoodRecipeService_Delete_Call {
+	return &MockIFoodRecipeService_Delete_Call{Call: _e.mock.On("Delete", id, ifMatch, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Run(run func(id int, ifMatch model.IfMatch, claims model.Claims)) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.IfMatch
+		if args[1] != nil {
+			arg1 = args[1].(model.IfMatch)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) RunAndReturn(run func(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) int64); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
+		r2 = returnFunc(foodRecipeQuery)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIFoodRecipeService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIFoodRecipeService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIFoodRecipeService_Expecter) Get(foodRecipeQuery interface{}) *MockIFoodRecipeService_Get_Call {
+	return &MockIFoodRecipeService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery)}
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, err error) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(foodRecipes, n, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetByID(id int) (model.FoodRecipe, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.FoodRecipe, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.FoodRecipe); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIFoodRecipeService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIFoodRecipeService_Expecter) GetByID(id interface{}) *MockIFoodRecipeService_GetByID_Call {
+	return &MockIFoodRecipeService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIFoodRecipeService_GetByID_Call) Run(run func(id int)) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetByID_Call) RunAndReturn(run func(id int) (model.FoodRecipe, error)) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDWithRating provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ratingPriorWeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithRating")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, float64) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ratingPriorWeight)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, float64) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ratingPriorWeight)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, float64) error); ok {
+		r1 = returnFunc(id, ratingPriorWeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetByIDWithRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithRating'
+type MockIFoodRecipeService_GetByIDWithRating_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithRating is a helper method to define mock.On call
+//   - id int
+//   - ratingPriorWeight float64
+func (_e *MockIFoodRecipeService_Expecter) GetByIDWithRating(id interface{}, ratingPriorWeight interface{}) *MockIFoodRecipeService_GetByIDWithRating_Call {
+	return &MockIFoodRecipeService_GetByIDWithRating_Call{Call: _e.mock.On("GetByIDWithRating", id, ratingPriorWeight)}
+}
+
+func (_c *MockIFoodRecipeService_GetByIDWithRating_Call) Run(run func(id int, ratingPriorWeight float64)) *MockIFoodRecipeService_GetByIDWithRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 float64
+		if args[1] != nil {
+			arg1 = args[1].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetByIDWithRating_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_GetByIDWithRating_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetByIDWithRating_Call) RunAndReturn(run func(id int, ratingPriorWeight float64) (model.FoodRecipe, error)) *MockIFoodRecipeService_GetByIDWithRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCacheValidator provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetCacheValidator() (model.CacheValidator, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheValidator")
+	}
+
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (model.CacheValidator, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() model.CacheValidator); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(model.CacheValidator)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetCacheValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidator'
+type MockIFoodRecipeService_GetCacheValidator_Call struct {
+	*mock.Call
+}
+
+// GetCacheValidator is a helper method to define mock.On call
+func (_e *MockIFoodRecipeService_Expecter) GetCacheValidator() *MockIFoodRecipeService_GetCacheValidator_Call {
+	return &MockIFoodRecipeService_GetCacheValidator_Call{Call: _e.mock.On("GetCacheValidator")}
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidator_Call) Run(run func()) *MockIFoodRecipeService_GetCacheValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidator_Call) Return(cacheValidator model.CacheValidator, err error) *MockIFoodRecipeService_GetCacheValidator_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidator_Call) RunAndReturn(run func() (model.CacheValidator, error)) *MockIFoodRecipeService_GetCacheValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCacheValidatorByID provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetCacheValidatorByID(id int) (model.CacheValidator, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheValidatorByID")
+	}
+
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CacheValidator, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CacheValidator); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CacheValidator)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetCacheValidatorByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidatorByID'
+type MockIFoodRecipeService_GetCacheValidatorByID_Call struct {
+	*mock.Call
+}
+
+// GetCacheValidatorByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIFoodRecipeService_Expecter) GetCacheValidatorByID(id interface{}) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	return &MockIFoodRecipeService_GetCacheValidatorByID_Call{Call: _e.mock.On("GetCacheValidatorByID", id)}
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidatorByID_Call) Run(run func(id int)) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidatorByID_Call) Return(cacheValidator model.CacheValidator, err error) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidatorByID_Call) RunAndReturn(run func(id int) (model.CacheValidator, error)) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrending provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetTrending(query model.TrendingQuery) (model.FoodRecipes, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrending")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) (model.FoodRecipes, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.TrendingQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetTrending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrending'
+type MockIFoodRecipeService_GetTrending_Call struct {
+	*mock.Call
+}
+
+// GetTrending is a helper method to define mock.On call
+//   - query model.TrendingQuery
+func (_e *MockIFoodRecipeService_Expecter) GetTrending(query interface{}) *MockIFoodRecipeService_GetTrending_Call {
+	return &MockIFoodRecipeService_GetTrending_Call{Call: _e.mock.On("GetTrending", query)}
+}
+
+func (_c *MockIFoodRecipeService_GetTrending_Call) Run(run func(query model.TrendingQuery)) *MockIFoodRecipeService_GetTrending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.TrendingQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.TrendingQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetTrending_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIFoodRecipeService_GetTrending_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetTrending_Call) RunAndReturn(run func(query model.TrendingQuery) (model.FoodRecipes, error)) *MockIFoodRecipeService_GetTrending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Patch(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(patch, id, ifMatch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(patch, id, ifMatch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(patch, id, ifMatch, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(patch, id, ifMatch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIFoodRecipeService_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - patch []byte
+//   - id int
+//   - ifMatch model.IfMatch
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Patch(patch interface{}, id interface{}, ifMatch interface{}, claims interface{}) *MockIFoodRecipeService_Patch_Call {
+	return &MockIFoodRecipeService_Patch_Call{Call: _e.mock.On("Patch", patch, id, ifMatch, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Patch_Call) Run(run func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims)) *MockIFoodRecipeService_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.IfMatch
+		if args[2] != nil {
+			arg2 = args[2].(model.IfMatch)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Patch_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Patch_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Patch_Call) RunAndReturn(run func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Update(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, id, ifMatch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, id, ifMatch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, id, ifMatch, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(request, id, ifMatch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIFoodRecipeService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - id int
+//   - ifMatch model.IfMatch
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Update(request interface{}, id interface{}, ifMatch interface{}, claims interface{}) *MockIFoodRecipeService_Update_Call {
+	return &MockIFoodRecipeService_Update_Call{Call: _e.mock.On("Update", request, id, ifMatch, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Update_Call) Run(run func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims)) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.IfMatch
+		if args[2] != nil {
+			arg2 = args[2].(model.IfMatch)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Update_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Update_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithFavorites provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) WithFavorites(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(recipes, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipes, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(recipes, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipes, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(recipes, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipes, model.Claims) error); ok {
+		r1 = returnFunc(recipes, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_WithFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithFavorites'
+type MockIFoodRecipeService_WithFavorites_Call struct {
+	*mock.Call
+}
+
+// WithFavorites is a helper method to define mock.On call
+//   - recipes model.FoodRecipes
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) WithFavorites(recipes interface{}, claims interface{}) *MockIFoodRecipeService_WithFavorites_Call {
+	return &MockIFoodRecipeService_WithFavorites_Call{Call: _e.mock.On("WithFavorites", recipes, claims)}
+}
+
+func (_c *MockIFoodRecipeService_WithFavorites_Call) Run(run func(recipes model.FoodRecipes, claims model.Claims)) *MockIFoodRecipeService_WithFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipes
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipes)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_WithFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIFoodRecipeService_WithFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
 	return _c
 }
 
-func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
-	_c.Run(run)
+func (_c *MockIFoodRecipeService_WithFavorites_Call) RunAndReturn(run func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error)) *MockIFoodRecipeService_WithFavorites_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockIRepository(t interface {
+func NewMockIService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockIRepository {
-	mock := &MockIRepository{}
+}) *MockIService {
+	mock := &MockIService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -133,385 +2905,353 @@ func NewMockIRepository(t interface {
 	return mock
 }
 
-// MockIRepository is an autogenerated mock type for the IRepository type
-type MockIRepository struct {
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
 	mock.Mock
 }
 
-type MockIRepository_Expecter struct {
+type MockIService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
-	return &MockIRepository_Expecter{mock: &_m.Mock}
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Create(rating *model.Rating) error {
-	ret := _mock.Called(rating)
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(recipeID int, claims model.Claims) error {
+	ret := _mock.Called(recipeID, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Rating) error); ok {
-		r0 = returnFunc(rating)
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(recipeID, claims)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIRepository_Create_Call struct {
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - rating *model.Rating
-func (_e *MockIRepository_Expecter) Create(rating interface{}) *MockIRepository_Create_Call {
-	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", rating)}
+// Delete is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(recipeID interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", recipeID, claims)}
 }
 
-func (_c *MockIRepository_Create_Call) Run(run func(rating *model.Rating)) *MockIRepository_Create_Call {
+func (_c *MockIService_Delete_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Rating
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(*model.Rating)
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) RunAndReturn(run func(rating *model.Rating) error) *MockIRepository_Create_Call {
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(recipeID int, claims model.Claims) error) *MockIService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Get(recipeID int) (model.Ratings, error) {
-	ret := _mock.Called(recipeID)
+// Get provides a mock function for the type MockIService
+func (_mock *MockIService) Get(recipeID int, query model.RatingQuery) (model.Ratings, int64, error) {
+	ret := _mock.Called(recipeID, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 model.Ratings
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.Ratings, error)); ok {
-		return returnFunc(recipeID)
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) (model.Ratings, int64, error)); ok {
+		return returnFunc(recipeID, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.Ratings); ok {
-		r0 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) model.Ratings); ok {
+		r0 = returnFunc(recipeID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.Ratings)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(1).(func(int, model.RatingQuery) int64); ok {
+		r1 = returnFunc(recipeID, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(int, model.RatingQuery) error); ok {
+		r2 = returnFunc(recipeID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockIRepository_Get_Call struct {
+// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIService_Get_Call struct {
 	*mock.Call
 }
 
 // Get is a helper method to define mock.On call
 //   - recipeID int
-func (_e *MockIRepository_Expecter) Get(recipeID interface{}) *MockIRepository_Get_Call {
-	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", recipeID)}
+//   - query model.RatingQuery
+func (_e *MockIService_Expecter) Get(recipeID interface{}, query interface{}) *MockIService_Get_Call {
+	return &MockIService_Get_Call{Call: _e.mock.On("Get", recipeID, query)}
 }
 
-func (_c *MockIRepository_Get_Call) Run(run func(recipeID int)) *MockIRepository_Get_Call {
+func (_c *MockIService_Get_Call) Run(run func(recipeID int, query model.RatingQuery)) *MockIService_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 model.RatingQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.RatingQuery)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) Return(ratings model.Ratings, err error) *MockIRepository_Get_Call {
-	_c.Call.Return(ratings, err)
+func (_c *MockIService_Get_Call) Return(ratings model.Ratings, n int64, err error) *MockIService_Get_Call {
+	_c.Call.Return(ratings, n, err)
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) RunAndReturn(run func(recipeID int) (model.Ratings, error)) *MockIRepository_Get_Call {
+func (_c *MockIService_Get_Call) RunAndReturn(run func(recipeID int, query model.RatingQuery) (model.Ratings, int64, error)) *MockIService_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIUserService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIUserService {
-	mock := &MockIUserService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIUserService is an autogenerated mock type for the IUserService type
-type MockIUserService struct {
-	mock.Mock
-}
-
-type MockIUserService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
-	return &MockIUserService_Expecter{mock: &_m.Mock}
-}
-
-// GetByID provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+// GetByUser provides a mock function for the type MockIService
+func (_mock *MockIService) GetByUser(recipeID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(recipeID, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for GetByUser")
 	}
 
-	var r0 model.User
+	var r0 model.Rating
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(recipeID, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(recipeID, claims)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.Rating)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) error); ok {
+		r1 = returnFunc(recipeID, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIUserService_GetByID_Call struct {
+// MockIService_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockIService_GetByUser_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// GetByUser is a helper method to define mock.On call
+//   - recipeID int
 //   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
-	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+func (_e *MockIService_Expecter) GetByUser(recipeID interface{}, claims interface{}) *MockIService_GetByUser_Call {
+	return &MockIService_GetByUser_Call{Call: _e.mock.On("GetByUser", recipeID, claims)}
 }
 
-func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+func (_c *MockIService_GetByUser_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_GetByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIService_GetByUser_Call) Return(rating model.Rating, err error) *MockIService_GetByUser_Call {
+	_c.Call.Return(rating, err)
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+func (_c *MockIService_GetByUser_Call) RunAndReturn(run func(recipeID int, claims model.Claims) (model.Rating, error)) *MockIService_GetByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
-	ret := _mock.Called(userID, claims)
+// RepairSummaries provides a mock function for the type MockIService
+func (_mock *MockIService) RepairSummaries() (int64, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for RepairSummaries")
 	}
 
-	var r0 model.FoodRecipes
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
-		r0 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
-		r1 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIUserService_GetRecipes_Call struct {
+// MockIService_RepairSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairSummaries'
+type MockIService_RepairSummaries_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
-//   - userID string
-//   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
-	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+// RepairSummaries is a helper method to define mock.On call
+func (_e *MockIService_Expecter) RepairSummaries() *MockIService_RepairSummaries_Call {
+	return &MockIService_RepairSummaries_Call{Call: _e.mock.On("RepairSummaries")}
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIService_RepairSummaries_Call) Run(run func()) *MockIService_RepairSummaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
-		if args[0] != nil {
-			arg0 = args[0].(string)
-		}
-		var arg1 model.Claims
-		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIService_RepairSummaries_Call) Return(n int64, err error) *MockIService_RepairSummaries_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIService_RepairSummaries_Call) RunAndReturn(run func() (int64, error)) *MockIService_RepairSummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpsertWithClaims provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) UpsertWithClaims(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+// Unvote provides a mock function for the type MockIService
+func (_mock *MockIService) Unvote(recipeID int, ratingID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(recipeID, ratingID, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpsertWithClaims")
+		panic("no return value specified for Unvote")
 	}
 
-	var r0 model.User
+	var r0 model.Rating
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(int, int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(recipeID, ratingID, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(int, int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(recipeID, ratingID, claims)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.Rating)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(int, int, model.Claims) error); ok {
+		r1 = returnFunc(recipeID, ratingID, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
-type MockIUserService_UpsertWithClaims_Call struct {
+// MockIService_Unvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unvote'
+type MockIService_Unvote_Call struct {
 	*mock.Call
 }
 
-// UpsertWithClaims is a helper method to define mock.On call
+// Unvote is a helper method to define mock.On call
+//   - recipeID int
+//   - ratingID int
 //   - claims model.Claims
-func (_e *MockIUserService_Expecter) UpsertWithClaims(claims interface{}) *MockIUserService_UpsertWithClaims_Call {
-	return &MockIUserService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+func (_e *MockIService_Expecter) Unvote(recipeID interface{}, ratingID interface{}, claims interface{}) *MockIService_Unvote_Call {
+	return &MockIService_Unvote_Call{Call: _e.mock.On("Unvote", recipeID, ratingID, claims)}
 }
 
-func (_c *MockIUserService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIUserService_UpsertWithClaims_Call {
+func (_c *MockIService_Unvote_Call) Run(run func(recipeID int, ratingID int, claims model.Claims)) *MockIService_Unvote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIUserService_UpsertWithClaims_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIService_Unvote_Call) Return(rating model.Rating, err error) *MockIService_Unvote_Call {
+	_c.Call.Return(rating, err)
 	return _c
 }
 
-func (_c *MockIUserService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_UpsertWithClaims_Call {
+func (_c *MockIService_Unvote_Call) RunAndReturn(run func(recipeID int, ratingID int, claims model.Claims) (model.Rating, error)) *MockIService_Unvote_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIService {
-	mock := &MockIService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIService is an autogenerated mock type for the IService type
-type MockIService struct {
-	mock.Mock
-}
-
-type MockIService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIService) EXPECT() *MockIService_Expecter {
-	return &MockIService_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockIService
-func (_mock *MockIService) Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error) {
+// Upsert provides a mock function for the type MockIService
+func (_mock *MockIService) Upsert(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error) {
 	ret := _mock.Called(request, recipeID, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Upsert")
 	}
 
 	var r0 model.Rating
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) (model.Rating, error)); ok {
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) (model.Rating, bool, error)); ok {
 		return returnFunc(request, recipeID, claims)
 	}
 	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) model.Rating); ok {
@@ -519,28 +3259,33 @@ func (_mock *MockIService) Create(request dto.RatingRequest, recipeID int, claim
 	} else {
 		r0 = ret.Get(0).(model.Rating)
 	}
-	if returnFunc, ok := ret.Get(1).(func(dto.RatingRequest, int, model.Claims) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(dto.RatingRequest, int, model.Claims) bool); ok {
 		r1 = returnFunc(request, recipeID, claims)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(dto.RatingRequest, int, model.Claims) error); ok {
+		r2 = returnFunc(request, recipeID, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIService_Create_Call struct {
+// MockIService_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockIService_Upsert_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Upsert is a helper method to define mock.On call
 //   - request dto.RatingRequest
 //   - recipeID int
 //   - claims model.Claims
-func (_e *MockIService_Expecter) Create(request interface{}, recipeID interface{}, claims interface{}) *MockIService_Create_Call {
-	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, recipeID, claims)}
+func (_e *MockIService_Expecter) Upsert(request interface{}, recipeID interface{}, claims interface{}) *MockIService_Upsert_Call {
+	return &MockIService_Upsert_Call{Call: _e.mock.On("Upsert", request, recipeID, claims)}
 }
 
-func (_c *MockIService_Create_Call) Run(run func(request dto.RatingRequest, recipeID int, claims model.Claims)) *MockIService_Create_Call {
+func (_c *MockIService_Upsert_Call) Run(run func(request dto.RatingRequest, recipeID int, claims model.Claims)) *MockIService_Upsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 dto.RatingRequest
 		if args[0] != nil {
@@ -563,74 +3308,90 @@ func (_c *MockIService_Create_Call) Run(run func(request dto.RatingRequest, reci
 	return _c
 }
 
-func (_c *MockIService_Create_Call) Return(rating model.Rating, err error) *MockIService_Create_Call {
-	_c.Call.Return(rating, err)
+func (_c *MockIService_Upsert_Call) Return(rating model.Rating, b bool, err error) *MockIService_Upsert_Call {
+	_c.Call.Return(rating, b, err)
 	return _c
 }
 
-func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error)) *MockIService_Create_Call {
+func (_c *MockIService_Upsert_Call) RunAndReturn(run func(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error)) *MockIService_Upsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockIService
-func (_mock *MockIService) Get(recipeID int) (model.Ratings, error) {
-	ret := _mock.Called(recipeID)
+// Vote provides a mock function for the type MockIService
+func (_mock *MockIService) Vote(request dto.RatingVoteRequest, recipeID int, ratingID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(request, recipeID, ratingID, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for Vote")
 	}
 
-	var r0 model.Ratings
+	var r0 model.Rating
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.Ratings, error)); ok {
-		return returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingVoteRequest, int, int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(request, recipeID, ratingID, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.Ratings); ok {
-		r0 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingVoteRequest, int, int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(request, recipeID, ratingID, claims)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.Ratings)
-		}
+		r0 = ret.Get(0).(model.Rating)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(1).(func(dto.RatingVoteRequest, int, int, model.Claims) error); ok {
+		r1 = returnFunc(request, recipeID, ratingID, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockIService_Get_Call struct {
+// MockIService_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockIService_Vote_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
+// Vote is a helper method to define mock.On call
+//   - request dto.RatingVoteRequest
 //   - recipeID int
-func (_e *MockIService_Expecter) Get(recipeID interface{}) *MockIService_Get_Call {
-	return &MockIService_Get_Call{Call: _e.mock.On("Get", recipeID)}
+//   - ratingID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Vote(request interface{}, recipeID interface{}, ratingID interface{}, claims interface{}) *MockIService_Vote_Call {
+	return &MockIService_Vote_Call{Call: _e.mock.On("Vote", request, recipeID, ratingID, claims)}
 }
 
-func (_c *MockIService_Get_Call) Run(run func(recipeID int)) *MockIService_Get_Call {
+func (_c *MockIService_Vote_Call) Run(run func(request dto.RatingVoteRequest, recipeID int, ratingID int, claims model.Claims)) *MockIService_Vote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 dto.RatingVoteRequest
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].(dto.RatingVoteRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_Get_Call) Return(ratings model.Ratings, err error) *MockIService_Get_Call {
-	_c.Call.Return(ratings, err)
+func (_c *MockIService_Vote_Call) Return(rating model.Rating, err error) *MockIService_Vote_Call {
+	_c.Call.Return(rating, err)
 	return _c
 }
 
-func (_c *MockIService_Get_Call) RunAndReturn(run func(recipeID int) (model.Ratings, error)) *MockIService_Get_Call {
+func (_c *MockIService_Vote_Call) RunAndReturn(run func(request dto.RatingVoteRequest, recipeID int, ratingID int, claims model.Claims) (model.Rating, error)) *MockIService_Vote_Call {
 	_c.Call.Return(run)
 	return _c
 }
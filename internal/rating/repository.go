@@ -1,14 +1,27 @@
 package rating
 
 import (
+	"errors"
+	"time"
+	"wongnok/internal/helper"
 	"wongnok/internal/model"
+	"wongnok/internal/notification"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type IRepository interface {
-	Get(recipeID int) (model.Ratings, error)
-	Create(rating *model.Rating) error
+	Get(recipeID int, query model.RatingQuery) (model.Ratings, error)
+	Count(recipeID int, query model.RatingQuery) (int64, error)
+	GetByUser(recipeID int, userID string) (model.Rating, error)
+	GetByID(recipeID int, ratingID int) (model.Rating, error)
+	// Upsert แจ้งเตือนเฉพาะ rating ใหม่ notice เป็น nil ได้
+	Upsert(rating *model.Rating, notice *model.Notification) error
+	Delete(recipeID int, userID string) error
+	RepairSummaries() (int64, error)
+	Vote(vote *model.RatingVote) error
+	Unvote(ratingID uint, userID string) error
 }
 
 type Repository struct {
@@ -21,20 +34,282 @@ func NewRepository(db *gorm.DB) IRepository {
 	}
 }
 
-func (repo Repository) Get(recipeID int) (model.Ratings, error) {
-	var ratings model.Ratings
+// ratingOrders ทุกแบบปิดท้ายด้วย id เพื่อให้แบ่งหน้าได้ลำดับคงที่
+var ratingOrders = map[string]string{
+	model.RatingSortNewest:  "created_at desc, id desc",
+	model.RatingSortHighest: "score desc, created_at desc, id desc",
+	model.RatingSortLowest:  "score asc, created_at desc, id desc",
+	model.RatingSortHelpful: "helpful_count desc, unhelpful_count asc, created_at desc, id desc",
+}
+
+func (repo Repository) Get(recipeID int, query model.RatingQuery) (model.Ratings, error) {
+	var ratings = make(model.Ratings, 0)
 
-	if err := repo.DB.Where("food_recipe_id = ?", recipeID).Find(&ratings).Error; err != nil {
+	offset := (query.Page - 1) * query.Limit
+
+	err := repo.DB.
+		Preload("User").
+		Where("food_recipe_id = ?", recipeID).
+		Scopes(helper.HideUsers(query.ViewerID, "user_id")).
+		Order(ratingOrders[query.Sort]).
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&ratings).Error
+	if err != nil {
 		return nil, err
 	}
 
 	return ratings, nil
 }
 
-func (repo Repository) Create(rating *model.Rating) error {
-	if err := repo.DB.Create(rating).First(&rating).Error; err != nil {
+func (repo Repository) Count(recipeID int, query model.RatingQuery) (int64, error) {
+	var count int64
+
+	if err := repo.DB.Model(&model.Rating{}).Where("food_recipe_id = ?", recipeID).Scopes(helper.HideUsers(query.ViewerID, "user_id")).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo Repository) GetByUser(recipeID int, userID string) (model.Rating, error) {
+	var rating model.Rating
+
+	if err := repo.DB.Preload("User").Where("food_recipe_id = ? AND user_id = ?", recipeID, userID).First(&rating).Error; err != nil {
+		return model.Rating{}, err
+	}
+
+	return rating, nil
+}
+
+func (repo Repository) GetByID(recipeID int, ratingID int) (model.Rating, error) {
+	var rating model.Rating
+
+	if err := repo.DB.Preload("User").Where("food_recipe_id = ?", recipeID).First(&rating, ratingID).Error; err != nil {
+		return model.Rating{}, err
+	}
+
+	return rating, nil
+}
+
+// Upsert ผู้ใช้ 1 คนมีได้ 1 rating ต่อ recipe ถ้ามีอยู่แล้วจะแก้คะแนนแทน
+// ใช้ unique index ที่ไม่รวมแถวที่ถูกลบ จึงต้องระบุเงื่อนไข deleted_at ให้ตรงกับ index
+func (repo Repository) Upsert(rating *model.Rating, notice *model.Notification) error {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		recipe, err := lockRecipe(tx, rating.FoodRecipeID)
+		if err != nil {
+			return err
+		}
+
+		summary := recipe.RatingSummary
+
+		var previous model.Rating
+		err = tx.Where("food_recipe_id = ? AND user_id = ?", rating.FoodRecipeID, rating.UserID).Take(&previous).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		created := err != nil
+		if !created {
+			summary = summary.Add(previous.Score, -1)
+		}
+
+		err = tx.Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "food_recipe_id"}, {Name: "user_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+			DoUpdates:   clause.AssignmentColumns([]string{"score", "title", "body", "photos", "cooked_on", "updated_at"}),
+		}).Create(rating).Error
+		if err != nil {
+			return err
+		}
+
+		summary = summary.Add(rating.Score, 1)
+		summary.RatingUpdatedAt = &rating.UpdatedAt
+
+		if err := saveSummary(tx, rating.FoodRecipeID, summary); err != nil {
+			return err
+		}
+
+		if !created || notice == nil {
+			return nil
+		}
+
+		notice.RatingID = &rating.ID
+		return notification.Notify(tx, notice)
+	})
+	if err != nil {
 		return err
 	}
 
-	return nil
+	// กรณีแก้คะแนน created_at ใน struct ยังเป็นเวลาปัจจุบัน จึงอ่านแถวจริงกลับมา
+	return repo.DB.Preload("User").First(rating, rating.ID).Error
+}
+
+func (repo Repository) Delete(recipeID int, userID string) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		recipe, err := lockRecipe(tx, uint(recipeID))
+		if err != nil {
+			return err
+		}
+
+		var rating model.Rating
+		if err := tx.Where("food_recipe_id = ? AND user_id = ?", recipeID, userID).Take(&rating).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&rating).Error; err != nil {
+			return err
+		}
+
+		summary := recipe.RatingSummary.Add(rating.Score, -1)
+		deletedAt := time.Now()
+		summary.RatingUpdatedAt = &deletedAt
+
+		return saveSummary(tx, recipe.ID, summary)
+	})
+}
+
+// lockRecipe ล็อกแถวของ recipe ไว้จนจบ transaction
+// การเขียน rating ของ recipe เดียวกันจึงทำทีละอัน และยอดรวมไม่คลาดเคลื่อน
+func lockRecipe(tx *gorm.DB, recipeID uint) (model.FoodRecipe, error) {
+	var recipe model.FoodRecipe
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&recipe, recipeID).Error
+
+	return recipe, err
+}
+
+// saveSummary ใช้ map เพราะยอดที่กลับเป็น 0 ต้องถูกเขียนด้วย และไม่แตะ updated_at ของ recipe
+func saveSummary(tx *gorm.DB, recipeID uint, summary model.RatingSummary) error {
+	return tx.Model(&model.FoodRecipe{}).Where("id = ?", recipeID).UpdateColumns(map[string]any{
+		"rating_count":      summary.RatingCount,
+		"rating_sum":        summary.RatingSum,
+		"rating_star_1":     summary.RatingStar1,
+		"rating_star_2":     summary.RatingStar2,
+		"rating_star_3":     summary.RatingStar3,
+		"rating_star_4":     summary.RatingStar4,
+		"rating_star_5":     summary.RatingStar5,
+		"rating_updated_at": summary.RatingUpdatedAt,
+	}).Error
+}
+
+// Vote โหวตซ้ำแบบเดิมไม่เปลี่ยนอะไร โหวตกลับข้างจะย้ายยอดจากฝั่งเดิม
+func (repo Repository) Vote(vote *model.RatingVote) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := lockRating(tx, vote.RatingID); err != nil {
+			return err
+		}
+
+		var previous model.RatingVote
+		err := tx.Where("rating_id = ? AND user_id = ?", vote.RatingID, vote.UserID).Take(&previous).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err == nil {
+			if previous.Helpful == vote.Helpful {
+				*vote = previous
+				return nil
+			}
+
+			previous.Helpful = vote.Helpful
+			if err := tx.Model(&previous).Update("helpful", previous.Helpful).Error; err != nil {
+				return err
+			}
+			*vote = previous
+
+			if err := addVoteCount(tx, vote.RatingID, !vote.Helpful, -1); err != nil {
+				return err
+			}
+		} else if err := tx.Create(vote).Error; err != nil {
+			return err
+		}
+
+		return addVoteCount(tx, vote.RatingID, vote.Helpful, 1)
+	})
+}
+
+// Unvote ไม่มีโหวตอยู่ก็ถือว่าสำเร็จ
+func (repo Repository) Unvote(ratingID uint, userID string) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := lockRating(tx, ratingID); err != nil {
+			return err
+		}
+
+		var vote model.RatingVote
+		err := tx.Where("rating_id = ? AND user_id = ?", ratingID, userID).Take(&vote).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("rating_id = ? AND user_id = ?", ratingID, userID).Delete(&model.RatingVote{}).Error; err != nil {
+			return err
+		}
+
+		return addVoteCount(tx, ratingID, vote.Helpful, -1)
+	})
+}
+
+// lockRating ล็อกแถว rating ไว้จนจบ transaction ยอดโหวตจึงไม่คลาดเคลื่อนเมื่อโหวตพร้อมกัน
+func lockRating(tx *gorm.DB, ratingID uint) error {
+	var rating model.Rating
+
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&rating, ratingID).Error
+}
+
+// addVoteCount ใช้ UpdateColumn เพื่อไม่แตะ updated_at ของ rating
+func addVoteCount(tx *gorm.DB, ratingID uint, helpful bool, n int) error {
+	column := "unhelpful_count"
+	if helpful {
+		column = "helpful_count"
+	}
+
+	return tx.Model(&model.Rating{}).Where("id = ?", ratingID).UpdateColumn(column, gorm.Expr(column+" + ?", n)).Error
+}
+
+// repairSummariesSQL คำนวณยอดรวมของทุก recipe ใหม่จากตาราง ratings
+// ต้องแบ่งช่องดาวแบบเดียวกับ model.RatingStar
+const repairSummariesSQL = `
+UPDATE food_recipes
+SET rating_count = summary.rating_count,
+	rating_sum = summary.rating_sum,
+	rating_star_1 = summary.rating_star_1,
+	rating_star_2 = summary.rating_star_2,
+	rating_star_3 = summary.rating_star_3,
+	rating_star_4 = summary.rating_star_4,
+	rating_star_5 = summary.rating_star_5,
+	rating_updated_at = GREATEST(food_recipes.rating_updated_at, summary.rating_updated_at)
+FROM (
+	SELECT
+		food_recipes.id,
+		COUNT(ratings.id) AS rating_count,
+		COALESCE(SUM(ratings.score), 0) AS rating_sum,
+		COUNT(ratings.id) FILTER (WHERE FLOOR(ratings.score) <= 1) AS rating_star_1,
+		COUNT(ratings.id) FILTER (WHERE FLOOR(ratings.score) = 2) AS rating_star_2,
+		COUNT(ratings.id) FILTER (WHERE FLOOR(ratings.score) = 3) AS rating_star_3,
+		COUNT(ratings.id) FILTER (WHERE FLOOR(ratings.score) = 4) AS rating_star_4,
+		COUNT(ratings.id) FILTER (WHERE FLOOR(ratings.score) >= 5) AS rating_star_5,
+		MAX(ratings.updated_at) AS rating_updated_at
+	FROM food_recipes
+	LEFT JOIN ratings ON ratings.food_recipe_id = food_recipes.id AND ratings.deleted_at IS NULL
+	GROUP BY food_recipes.id
+) AS summary
+WHERE food_recipes.id = summary.id
+AND (
+	food_recipes.rating_count, food_recipes.rating_sum,
+	food_recipes.rating_star_1, food_recipes.rating_star_2, food_recipes.rating_star_3,
+	food_recipes.rating_star_4, food_recipes.rating_star_5
+) IS DISTINCT FROM (
+	summary.rating_count, summary.rating_sum,
+	summary.rating_star_1, summary.rating_star_2, summary.rating_star_3,
+	summary.rating_star_4, summary.rating_star_5
+)`
+
+// RepairSummaries แก้ยอดรวมที่ไม่ตรงกับตาราง ratings คืนจำนวน recipe ที่ถูกแก้
+func (repo Repository) RepairSummaries() (int64, error) {
+	result := repo.DB.Exec(repairSummariesSQL)
+
+	return result.RowsAffected, result.Error
 }
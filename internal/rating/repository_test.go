@@ -94,93 +94,186 @@ func (suite *RepositoryTestSuite) TearDownTest() {
 }
 
 // Extend
-type RepositoryCreateRatingTestSuite struct {
+type RepositoryUpsertRatingTestSuite struct {
 	RepositoryTestSuite
 }
 
-func (suite *RepositoryCreateRatingTestSuite) TestReturnRatingPointerWhenRatingCreated() {
+func (suite *RepositoryUpsertRatingTestSuite) activeRatings() int64 {
+	var count int64
+	suite.NoError(suite.db.Model(&model.Rating{}).Where("food_recipe_id = ?", 1).Count(&count).Error)
+
+	return count
+}
+
+func (suite *RepositoryUpsertRatingTestSuite) TestCreateNewRatingAfterDeleted() {
+	err := suite.repository.Delete(1, "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11")
+	suite.NoError(err)
+
 	rating := model.Rating{
-		Score:        5,
+		Score:        2,
 		FoodRecipeID: 1,
-		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+		UserID:       "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11",
 	}
 
-	err := suite.repository.Create(&rating)
+	err = suite.repository.Upsert(&rating, nil)
 	suite.NoError(err)
 
-	// GORM จะ auto-assign ID ที่ database generate ให้
-	expectedRating := model.Rating{
-		Model:        gorm.Model{ID: 3}, // ← Database auto-increment มี 1 อยู่แล้วตอน initial data
-		Score:        5,
-		FoodRecipeID: 1,
-		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
-	}
+	// rating ที่ถูกลบไม่นับซ้ำ จึงสร้างแถวใหม่ได้
+	suite.Equal(uint(3), rating.ID)
+	suite.Equal(int64(2), suite.activeRatings())
+}
 
-	// Ignore CreatedAt field for comparison
-	rating.CreatedAt = time.Time{}
-	rating.UpdatedAt = time.Time{}
+func (suite *RepositoryUpsertRatingTestSuite) TestDeleteReturnNotFoundWhenNotRated() {
+	err := suite.repository.Delete(1, "unknown")
 
-	suite.Equal(expectedRating, rating)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
 }
 
-func (suite *RepositoryCreateRatingTestSuite) TestErrorWhenDuplicateID() {
+func (suite *RepositoryUpsertRatingTestSuite) TestGetByUserReturnCallerRating() {
+	rating, err := suite.repository.GetByUser(1, "38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	suite.NoError(err)
+
+	suite.Equal(uint(1), rating.ID)
+	suite.Equal(5.0, rating.Score)
+}
+
+func (suite *RepositoryUpsertRatingTestSuite) TestUpdateScoreWhenAlreadyRated() {
+	existing, err := suite.repository.GetByUser(1, "38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	suite.NoError(err)
+
 	rating := model.Rating{
-		Model:        gorm.Model{ID: 1},
-		Score:        5,
+		Score:        4.5,
 		FoodRecipeID: 1,
-		UserID:       "1a",
+		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
 	}
 
-	err := suite.repository.Create(&rating)
+	err = suite.repository.Upsert(&rating, nil)
+	suite.NoError(err)
+
+	// คอลัมน์เป็น NUMERIC จึงเก็บครึ่งดาวได้โดยไม่ถูกตัดทิ้ง
+	suite.Equal(existing.ID, rating.ID)
+	suite.Equal(4.5, rating.Score)
+	suite.True(existing.CreatedAt.Equal(rating.CreatedAt))
+	suite.Equal(int64(2), suite.activeRatings())
+}
+
+func (suite *RepositoryUpsertRatingTestSuite) TestErrorWhenInsertDuplicateRow() {
+	// unique index กันซ้ำแม้จะ insert ตรงโดยไม่ผ่าน Upsert
+	err := suite.db.Create(&model.Rating{
+		Score:        1,
+		FoodRecipeID: 1,
+		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}).Error
 	suite.Error(err)
 
-	// SQL state 23505 is พยายามจะ insert ข้อมูลที่มี primary key ซ้ำ
+	// SQL state 23505 คือ unique violation
 	suite.Equal("23505", err.(*pgconn.PgError).SQLState())
 }
 
-func TestRepositoryCreateRating(t *testing.T) {
-	suite.Run(t, new(RepositoryCreateRatingTestSuite))
+func (suite *RepositoryUpsertRatingTestSuite) TestErrorWhenScoreOutOfScale() {
+	for _, score := range []float64{0.5, 4.2, 5.5} {
+		err := suite.db.Create(&model.Rating{
+			Score:        score,
+			FoodRecipeID: 1,
+			UserID:       "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11",
+		}).Error
+		suite.Error(err)
+
+		// SQL state 23514 คือ check violation
+		suite.Equal("23514", err.(*pgconn.PgError).SQLState(), score)
+	}
+}
+
+func TestRepositoryUpsertRating(t *testing.T) {
+	suite.Run(t, new(RepositoryUpsertRatingTestSuite))
 }
 
 type RepositoryGetRatingTestSuite struct {
 	RepositoryTestSuite
 }
 
-func (suite *RepositoryGetRatingTestSuite) TestReturnRatings() {
-	recipeID := 1
+func (suite *RepositoryGetRatingTestSuite) ids(ratings model.Ratings) []uint {
+	var ids []uint
+	for _, rating := range ratings {
+		ids = append(ids, rating.ID)
+	}
 
-	result, err := suite.repository.Get(recipeID)
+	return ids
+}
+
+func (suite *RepositoryGetRatingTestSuite) TestReturnRatingsWithReviewer() {
+	result, err := suite.repository.Get(1, model.RatingQuery{Page: 1, Limit: 10, Sort: model.RatingSortHighest})
 	suite.NoError(err)
 
-	suite.NotEmpty(result)
-	for index, rating := range result {
-		rating.CreatedAt = time.Time{}
-		rating.UpdatedAt = time.Time{}
+	suite.Equal([]uint{1, 2}, suite.ids(result))
+	suite.Equal(5.0, result[0].Score)
+	suite.Equal("38fa4e9e-27de-42d5-a70f-9f01d41f32c2", result[0].User.ID)
+	suite.Equal(model.Photos{}, result[0].Photos)
+	suite.Nil(result[0].CookedOn)
+}
 
-		result[index] = rating
+func (suite *RepositoryGetRatingTestSuite) TestSortRatings() {
+	// ข้อมูลตั้งต้นสร้างเวลาเดียวกัน newest จึงเรียงตาม id
+	for sort, expected := range map[string][]uint{
+		model.RatingSortNewest:  {2, 1},
+		model.RatingSortHighest: {1, 2},
+		model.RatingSortLowest:  {2, 1},
+		model.RatingSortHelpful: {2, 1},
+	} {
+		result, err := suite.repository.Get(1, model.RatingQuery{Page: 1, Limit: 10, Sort: sort})
+		suite.NoError(err)
+
+		suite.Equal(expected, suite.ids(result), sort)
 	}
+}
 
-	suite.Equal(model.Ratings{
-		{
-			Model:        gorm.Model{ID: 1},
-			Score:        5,
-			FoodRecipeID: 1,
-			UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
-		},
-		{
-			Model:        gorm.Model{ID: 2},
-			Score:        3,
-			FoodRecipeID: 1,
-			UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
-		},
-	}, result)
+func (suite *RepositoryGetRatingTestSuite) TestPaginateRatings() {
+	result, err := suite.repository.Get(1, model.RatingQuery{Page: 2, Limit: 1, Sort: model.RatingSortHighest})
+	suite.NoError(err)
+
+	suite.Equal([]uint{2}, suite.ids(result))
+
+	total, err := suite.repository.Count(1, model.RatingQuery{})
+	suite.NoError(err)
+	suite.Equal(int64(2), total)
+}
+
+func (suite *RepositoryGetRatingTestSuite) TestHideRatingsOfMutedAndBlockedUsers() {
+	viewer := "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	other := "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+	defer suite.db.Exec("DELETE FROM user_mutes")
+	defer suite.db.Exec("DELETE FROM user_blocks")
+
+	query := model.RatingQuery{Page: 1, Limit: 10, Sort: model.RatingSortHighest, ViewerID: viewer}
+
+	suite.NoError(suite.db.Create(&model.UserMute{MuterID: viewer, MutedID: other}).Error)
+
+	result, err := suite.repository.Get(1, query)
+	suite.NoError(err)
+	suite.Equal([]uint{1}, suite.ids(result))
+
+	total, err := suite.repository.Count(1, query)
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+
+	// mute ซ่อนฝ่ายเดียว
+	result, err = suite.repository.Get(1, model.RatingQuery{Page: 1, Limit: 10, Sort: model.RatingSortHighest, ViewerID: other})
+	suite.NoError(err)
+	suite.Equal([]uint{1, 2}, suite.ids(result))
+
+	// block ซ่อนทั้งสองฝ่าย
+	suite.NoError(suite.db.Create(&model.UserBlock{BlockerID: viewer, BlockedID: other}).Error)
+
+	result, err = suite.repository.Get(1, model.RatingQuery{Page: 1, Limit: 10, Sort: model.RatingSortHighest, ViewerID: other})
+	suite.NoError(err)
+	suite.Equal([]uint{2}, suite.ids(result))
 }
 
 func (suite *RepositoryGetRatingTestSuite) TestReturnEmptyWhenNotFound() {
 	recipeID := 2
 
 	// ไม่มี rating สำหรับ recipeID 2 ใน initial data
-	result, err := suite.repository.Get(recipeID)
+	result, err := suite.repository.Get(recipeID, model.RatingQuery{Page: 1, Limit: 10, Sort: model.RatingSortNewest})
 
 	suite.NoError(err)
 	suite.Empty(result)
@@ -190,3 +283,130 @@ func (suite *RepositoryGetRatingTestSuite) TestReturnEmptyWhenNotFound() {
 func TestRepositoryGetRatings(t *testing.T) {
 	suite.Run(t, new(RepositoryGetRatingTestSuite))
 }
+
+type RepositoryRatingSummaryTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryRatingSummaryTestSuite) summary(recipeID uint) model.RatingSummary {
+	var recipe model.FoodRecipe
+	suite.NoError(suite.db.First(&recipe, recipeID).Error)
+
+	return recipe.RatingSummary
+}
+
+// expectedSummary คำนวณจาก rating ทุกอันของ recipe โดยตรง
+func (suite *RepositoryRatingSummaryTestSuite) expectedSummary(recipeID uint) model.RatingSummary {
+	var ratings model.Ratings
+	suite.NoError(suite.db.Where("food_recipe_id = ?", recipeID).Find(&ratings).Error)
+
+	var summary model.RatingSummary
+	for _, rating := range ratings {
+		summary = summary.Add(rating.Score, 1)
+	}
+
+	return summary
+}
+
+func (suite *RepositoryRatingSummaryTestSuite) withoutUpdatedAt(summary model.RatingSummary) model.RatingSummary {
+	summary.RatingUpdatedAt = nil
+	return summary
+}
+
+func (suite *RepositoryRatingSummaryTestSuite) TestKeepSummaryWhenRatingWritten() {
+	before := suite.summary(1)
+
+	rating := model.Rating{Score: 4.5, FoodRecipeID: 1, UserID: "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"}
+	suite.NoError(suite.repository.Upsert(&rating, nil))
+
+	// แก้คะแนนต้องเอาคะแนนเดิมออกก่อน ไม่นับเป็น rating ใหม่
+	afterUpsert := suite.summary(1)
+	suite.Equal(before.RatingCount, afterUpsert.RatingCount)
+	suite.Equal(suite.expectedSummary(1), suite.withoutUpdatedAt(afterUpsert))
+	suite.NotNil(afterUpsert.RatingUpdatedAt)
+
+	suite.NoError(suite.repository.Delete(1, "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"))
+
+	afterDelete := suite.summary(1)
+	suite.Equal(before.RatingCount-1, afterDelete.RatingCount)
+	suite.Equal(suite.expectedSummary(1), suite.withoutUpdatedAt(afterDelete))
+
+	// ยอดตรงอยู่แล้วจึงไม่มีอะไรต้องแก้
+	repaired, err := suite.repository.RepairSummaries()
+	suite.NoError(err)
+	suite.Equal(int64(0), repaired)
+}
+
+func (suite *RepositoryRatingSummaryTestSuite) TestRepairSummaries() {
+	suite.NoError(suite.db.Exec("UPDATE food_recipes SET rating_count = 99, rating_star_1 = 99 WHERE id = 1").Error)
+
+	repaired, err := suite.repository.RepairSummaries()
+	suite.NoError(err)
+
+	suite.Equal(int64(1), repaired)
+	suite.Equal(suite.expectedSummary(1), suite.withoutUpdatedAt(suite.summary(1)))
+}
+
+func (suite *RepositoryRatingSummaryTestSuite) TestReturnNotFoundWhenRecipeNotExist() {
+	err := suite.repository.Upsert(&model.Rating{Score: 4, FoodRecipeID: 999, UserID: "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"}, nil)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryRatingSummary(t *testing.T) {
+	suite.Run(t, new(RepositoryRatingSummaryTestSuite))
+}
+
+type RepositoryRatingVoteTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryRatingVoteTestSuite) counts(ratingID uint) (int, int) {
+	var rating model.Rating
+	suite.NoError(suite.db.First(&rating, ratingID).Error)
+
+	return rating.HelpfulCount, rating.UnhelpfulCount
+}
+
+func (suite *RepositoryRatingVoteTestSuite) TestKeepCountsWhenVoted() {
+	// rating 1 ของ 38fa... ใน initial data ให้ b3a1... โหวต
+	voter := "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+
+	suite.NoError(suite.repository.Vote(&model.RatingVote{RatingID: 1, UserID: voter, Helpful: true}))
+	helpful, unhelpful := suite.counts(1)
+	suite.Equal(1, helpful)
+	suite.Equal(0, unhelpful)
+
+	// โหวตซ้ำแบบเดิมไม่นับเพิ่ม
+	suite.NoError(suite.repository.Vote(&model.RatingVote{RatingID: 1, UserID: voter, Helpful: true}))
+	helpful, unhelpful = suite.counts(1)
+	suite.Equal(1, helpful)
+	suite.Equal(0, unhelpful)
+
+	suite.NoError(suite.repository.Vote(&model.RatingVote{RatingID: 1, UserID: voter, Helpful: false}))
+	helpful, unhelpful = suite.counts(1)
+	suite.Equal(0, helpful)
+	suite.Equal(1, unhelpful)
+
+	suite.NoError(suite.repository.Unvote(1, voter))
+	suite.NoError(suite.repository.Unvote(1, voter))
+	helpful, unhelpful = suite.counts(1)
+	suite.Equal(0, helpful)
+	suite.Equal(0, unhelpful)
+}
+
+func (suite *RepositoryRatingVoteTestSuite) TestReturnNotFoundWhenRatingNotExist() {
+	err := suite.repository.Vote(&model.RatingVote{RatingID: 999, UserID: "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11", Helpful: true})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *RepositoryRatingVoteTestSuite) TestGetByIDOfOtherRecipe() {
+	_, err := suite.repository.GetByID(2, 1)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryRatingVote(t *testing.T) {
+	suite.Run(t, new(RepositoryRatingVoteTestSuite))
+}
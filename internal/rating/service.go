@@ -1,6 +1,8 @@
 package rating
 
 import (
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/users"
@@ -12,52 +14,174 @@ import (
 
 type IUserService user.IService
 
-type IService interface {
-	Get(recipeID int) (model.Ratings, error)
+type IFoodRecipeService foodrecipe.IService
 
-	Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error)
+type IService interface {
+	Get(recipeID int, query model.RatingQuery) (model.Ratings, int64, error)
+	GetByUser(recipeID int, claims model.Claims) (model.Rating, error)
+	// Upsert คืน true เมื่อเป็น rating ใหม่
+	Upsert(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error)
+	Delete(recipeID int, claims model.Claims) error
+	RepairSummaries() (int64, error)
+	// Vote และ Unvote คืน rating พร้อมยอดโหวตล่าสุด
+	Vote(request dto.RatingVoteRequest, recipeID int, ratingID int, claims model.Claims) (model.Rating, error)
+	Unvote(recipeID int, ratingID int, claims model.Claims) (model.Rating, error)
 }
 
 type Service struct {
-	Repository  IRepository
-	UserService IUserService
+	Repository        IRepository
+	UserService       IUserService
+	FoodRecipeService IFoodRecipeService
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
-		Repository:  NewRepository(db),
-		UserService: user.NewService(db),
+		Repository:        NewRepository(db),
+		UserService:       user.NewService(db),
+		FoodRecipeService: foodrecipe.NewService(db),
 	}
 }
 
-func (service Service) Get(recipeID int) (model.Ratings, error) {
-	ratings, err := service.Repository.Get(recipeID)
+func newValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterValidation("ratingstep", func(field validator.FieldLevel) bool {
+		return model.IsRatingStep(field.Field().Float())
+	})
+
+	return validate
+}
+
+func (service Service) Get(recipeID int, query model.RatingQuery) (model.Ratings, int64, error) {
+	total, err := service.Repository.Count(recipeID, query)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return ratings, nil
+	ratings, err := service.Repository.Get(recipeID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ratings, total, nil
 }
 
-func (service Service) Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error) {
-	validate := validator.New()
+func (service Service) GetByUser(recipeID int, claims model.Claims) (model.Rating, error) {
+	rating, err := service.Repository.GetByUser(recipeID, claims.ID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
+	}
+
+	return rating, nil
+}
+
+func (service Service) Upsert(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error) {
+	validate := newValidator()
 	if err := validate.Struct(request); err != nil {
-		return model.Rating{}, errors.Wrap(err, "request invalid")
+		return model.Rating{}, false, errors.Wrap(err, "request invalid")
+	}
+
+	// ตรวจก่อนเพื่อตอบ 404 แทน foreign key error
+	recipe, err := service.FoodRecipeService.GetByID(recipeID)
+	if err != nil {
+		return model.Rating{}, false, errors.Wrap(err, "find recipe")
+	}
+
+	if err := service.UserService.CheckBlocked(claims.ID, recipe.UserID); err != nil {
+		return model.Rating{}, false, err
 	}
 
-	userID, err := service.UserService.GetByID(claims)
+	user, err := service.UserService.GetByID(claims)
 	if err != nil {
-		return model.Rating{}, errors.Wrap(err, "create rating")
+		return model.Rating{}, false, errors.Wrap(err, "create rating")
 	}
 
+	// ใช้บอก client ว่าสร้างใหม่หรือแก้ของเดิม ส่วนการกันซ้ำจริงอยู่ที่ unique index
+	_, err = service.Repository.GetByUser(recipeID, user.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.Rating{}, false, errors.Wrap(err, "find rating")
+	}
+	created := err != nil
+
 	var rating model.Rating
 	rating = rating.FromRequest(request)
 	rating.FoodRecipeID = uint(recipeID)
 
-	rating.UserID = userID.ID
+	rating.UserID = user.ID
+
+	notice := model.Notification{
+		RecipientID:  recipe.UserID,
+		ActorID:      user.ID,
+		Type:         model.NotificationRating,
+		FoodRecipeID: &rating.FoodRecipeID,
+	}
+
+	if err := service.Repository.Upsert(&rating, &notice); err != nil {
+		return model.Rating{}, false, errors.Wrap(err, "save rating")
+	}
+
+	return rating, created, nil
+}
+
+func (service Service) Delete(recipeID int, claims model.Claims) error {
+	if err := service.Repository.Delete(recipeID, claims.ID); err != nil {
+		return errors.Wrap(err, "delete rating")
+	}
+
+	return nil
+}
+
+func (service Service) RepairSummaries() (int64, error) {
+	repaired, err := service.Repository.RepairSummaries()
+	if err != nil {
+		return 0, errors.Wrap(err, "repair rating summaries")
+	}
+
+	return repaired, nil
+}
+
+func (service Service) Vote(request dto.RatingVoteRequest, recipeID int, ratingID int, claims model.Claims) (model.Rating, error) {
+	if err := validator.New().Struct(request); err != nil {
+		return model.Rating{}, errors.Wrap(err, "request invalid")
+	}
+
+	rating, err := service.Repository.GetByID(recipeID, ratingID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
+	}
+
+	if rating.UserID == claims.ID {
+		return model.Rating{}, errors.Wrap(global.ErrForbidden, "vote own rating")
+	}
+
+	vote := model.RatingVote{
+		RatingID: rating.ID,
+		UserID:   claims.ID,
+		Helpful:  *request.Helpful,
+	}
+	if err := service.Repository.Vote(&vote); err != nil {
+		return model.Rating{}, errors.Wrap(err, "save vote")
+	}
+
+	return service.reload(recipeID, ratingID)
+}
 
-	if err := service.Repository.Create(&rating); err != nil {
-		return model.Rating{}, errors.Wrap(err, "create rating")
+func (service Service) Unvote(recipeID int, ratingID int, claims model.Claims) (model.Rating, error) {
+	rating, err := service.Repository.GetByID(recipeID, ratingID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
+	}
+
+	if err := service.Repository.Unvote(rating.ID, claims.ID); err != nil {
+		return model.Rating{}, errors.Wrap(err, "delete vote")
+	}
+
+	return service.reload(recipeID, ratingID)
+}
+
+func (service Service) reload(recipeID int, ratingID int) (model.Rating, error) {
+	rating, err := service.Repository.GetByID(recipeID, ratingID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
 	}
 
 	return rating, nil
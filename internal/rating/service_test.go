@@ -2,11 +2,14 @@ package rating_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/rating"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -34,8 +37,11 @@ type ServiceGetRating struct {
 	repo    *MockIRepository
 
 	// Mock data
-	respRepositoryGet model.Ratings
-	errRepositoryGet  error
+	query               model.RatingQuery
+	respRepositoryGet   model.Ratings
+	errRepositoryGet    error
+	respRepositoryCount int64
+	errRepositoryCount  error
 }
 
 func (suite *ServiceGetRating) SetupTest() {
@@ -66,22 +72,31 @@ func (suite *ServiceGetRating) SetupTest() {
 	}
 
 	suite.errRepositoryGet = nil
+	suite.respRepositoryCount = 3
+	suite.errRepositoryCount = nil
+	suite.query = model.RatingQuery{Page: 1, Limit: 10, Sort: model.RatingSortNewest}
 
 	// Mock the repository's Get method
-	suite.repo.On("Get", mock.AnythingOfType("int")).Return(func(id int) (model.Ratings, error) {
+	suite.repo.On("Get", mock.AnythingOfType("int"), mock.AnythingOfType("model.RatingQuery")).Return(func(id int, query model.RatingQuery) (model.Ratings, error) {
 		if id == 1 {
 			return suite.respRepositoryGet, suite.errRepositoryGet
 		}
 		return nil, gorm.ErrRecordNotFound
 	})
+
+	suite.repo.On("Count", mock.AnythingOfType("int"), mock.AnythingOfType("model.RatingQuery")).Return(func(int, model.RatingQuery) (int64, error) {
+		return suite.respRepositoryCount, suite.errRepositoryCount
+	})
 }
 
 func (suite *ServiceGetRating) TestReturnRatingsWhenFound() {
-	ratings, err := suite.service.Get(1)
+	ratings, total, err := suite.service.Get(1, suite.query)
 
-	suite.repo.AssertCalled(suite.T(), "Get", 1)
+	suite.repo.AssertCalled(suite.T(), "Get", 1, suite.query)
+	suite.repo.AssertCalled(suite.T(), "Count", 1, suite.query)
 
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
 	assert.Equal(suite.T(), len(suite.respRepositoryGet), len(ratings))
 	assert.Equal(suite.T(), suite.respRepositoryGet[0].Score, ratings[0].Score)
 	assert.Equal(suite.T(), suite.respRepositoryGet[0].FoodRecipeID, ratings[0].FoodRecipeID)
@@ -92,21 +107,33 @@ func (suite *ServiceGetRating) TestReturnRatingsWhenFound() {
 }
 
 func (suite *ServiceGetRating) TestReturnErrorWhenRepositoryNotFound() {
-	ratings, err := suite.service.Get(2)
+	ratings, _, err := suite.service.Get(2, suite.query)
 
 	// Expect call repo get with recipe ID 2
-	suite.repo.AssertCalled(suite.T(), "Get", 2)
+	suite.repo.AssertCalled(suite.T(), "Get", 2, suite.query)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), ratings)
 	assert.Equal(suite.T(), gorm.ErrRecordNotFound, err)
 }
 
+func (suite *ServiceGetRating) TestReturnErrorWhenRepositoryCount() {
+	suite.errRepositoryCount = assert.AnError
+
+	ratings, total, err := suite.service.Get(1, suite.query)
+
+	suite.repo.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+
+	assert.ErrorIs(suite.T(), err, assert.AnError)
+	assert.Nil(suite.T(), ratings)
+	assert.Zero(suite.T(), total)
+}
+
 func TestServiceGetRating(t *testing.T) {
 	suite.Run(t, new(ServiceGetRating))
 }
 
-type ServiceCreateRating struct {
+type ServiceUpsertRating struct {
 	suite.Suite
 
 	// Dependencies
@@ -114,24 +141,35 @@ type ServiceCreateRating struct {
 
 	userService *MockIUserService
 
+	foodRecipeService *MockIFoodRecipeService
+
 	repo *MockIRepository
 
 	// Mock data
-	errRepositoryCreate error
+	errFoodRecipeServiceGetByID error
+
+	errRepositoryUpsert     error
+	respRepositoryGetByUser model.Rating
+	errRepositoryGetByUser  error
 
 	errUserServiceGetByID error
+	errUserServiceBlocked error
 	user                  model.User
 }
 
-func (suite *ServiceCreateRating) SetupTest() {
+func (suite *ServiceUpsertRating) SetupTest() {
 	suite.repo = new(MockIRepository)
 
 	suite.userService = new(MockIUserService)
 
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+
 	suite.service = &rating.Service{
 		Repository: suite.repo,
 
 		UserService: suite.userService,
+
+		FoodRecipeService: suite.foodRecipeService,
 	}
 
 	suite.user = model.User{
@@ -140,20 +178,27 @@ func (suite *ServiceCreateRating) SetupTest() {
 		LastName:  "mock_LastName_1",
 	}
 
-	suite.errRepositoryCreate = nil
+	suite.errRepositoryUpsert = nil
+	suite.respRepositoryGetByUser = model.Rating{}
+	suite.errRepositoryGetByUser = gorm.ErrRecordNotFound
 
 	suite.errUserServiceGetByID = nil
+	suite.errUserServiceBlocked = nil
+	suite.errFoodRecipeServiceGetByID = nil
 
-	suite.repo.On("Create", mock.AnythingOfType("*model.Rating")).Run(func(args mock.Arguments) {
+	suite.foodRecipeService.On("GetByID", mock.AnythingOfType("int")).Return(func(int) (model.FoodRecipe, error) {
+		return model.FoodRecipe{Model: gorm.Model{ID: 1}, UserID: "author"}, suite.errFoodRecipeServiceGetByID
+	})
+
+	suite.repo.On("Upsert", mock.AnythingOfType("*model.Rating"), mock.AnythingOfType("*model.Notification")).Run(func(args mock.Arguments) {
 		rating := args.Get(0).(*model.Rating)
-		*rating = model.Rating{
-			Score:        1,
-			FoodRecipeID: 1,
+		rating.ID = 1
+	}).Return(func(*model.Rating, *model.Notification) error {
+		return suite.errRepositoryUpsert
+	})
 
-			UserID: "1",
-		}
-	}).Return(func(*model.Rating) error {
-		return suite.errRepositoryCreate
+	suite.repo.On("GetByUser", mock.Anything, mock.Anything).Return(func(int, string) (model.Rating, error) {
+		return suite.respRepositoryGetByUser, suite.errRepositoryGetByUser
 	})
 
 	suite.userService.On("GetByID", mock.AnythingOfType("model.Claims")).Return(func(model.Claims) (model.User, error) {
@@ -163,31 +208,68 @@ func (suite *ServiceCreateRating) SetupTest() {
 		return suite.user, suite.errUserServiceGetByID
 	})
 
+	suite.userService.On("CheckBlocked", mock.Anything, mock.Anything).Return(func(string, string) error {
+		return suite.errUserServiceBlocked
+	})
 }
 
-func (suite *ServiceCreateRating) TestReturnRatingWhenCreated() {
+func (suite *ServiceUpsertRating) TestReturnRatingWhenCreated() {
 	request := dto.RatingRequest{
 		Score: 1,
 	}
 
 	claims := model.Claims{
-		ID:        "1",
+		ID:        "123abc",
 		FirstName: "mock_FirstName",
 		LastName:  "mock_LastName",
 	}
 
-	rating, err := suite.service.Create(request, 1, claims)
+	rating, created, err := suite.service.Upsert(request, 1, claims)
 
 	suite.userService.AssertCalled(suite.T(), "GetByID", mock.AnythingOfType("model.Claims"))
-	suite.repo.AssertCalled(suite.T(), "Create", mock.AnythingOfType("*model.Rating"))
+	suite.repo.AssertCalled(suite.T(), "GetByUser", 1, "123abc")
+	recipeID := uint(1)
+	suite.repo.AssertCalled(suite.T(), "Upsert", &model.Rating{
+		Model:        gorm.Model{ID: 1},
+		Score:        1,
+		FoodRecipeID: 1,
+		UserID:       "123abc",
+	}, &model.Notification{
+		RecipientID:  "author",
+		ActorID:      "123abc",
+		Type:         model.NotificationRating,
+		FoodRecipeID: &recipeID,
+	})
 
 	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), created)
 	assert.Equal(suite.T(), 1, int(rating.Score))
 	assert.Equal(suite.T(), 1, int(rating.FoodRecipeID))
-	assert.Equal(suite.T(), "1", rating.UserID)
+	assert.Equal(suite.T(), "123abc", rating.UserID)
 }
 
-func (suite *ServiceCreateRating) TestReturnErrorWhenRequestValidate() {
+func (suite *ServiceUpsertRating) TestReturnNotCreatedWhenRatingExists() {
+	suite.respRepositoryGetByUser = model.Rating{Model: gorm.Model{ID: 1}, Score: 2, FoodRecipeID: 1, UserID: "123abc"}
+	suite.errRepositoryGetByUser = nil
+
+	rating, created, err := suite.service.Upsert(dto.RatingRequest{Score: 4}, 1, model.Claims{ID: "123abc"})
+
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), created)
+	assert.Equal(suite.T(), 4.0, rating.Score)
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenBlocked() {
+	suite.errUserServiceBlocked = global.ErrBlocked
+
+	_, _, err := suite.service.Upsert(dto.RatingRequest{Score: 4}, 1, model.Claims{ID: "123abc"})
+
+	suite.ErrorIs(err, global.ErrBlocked)
+	suite.userService.AssertCalled(suite.T(), "CheckBlocked", "123abc", "author")
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenRequestValidate() {
 	request := dto.RatingRequest{
 		Score: 0, // Invalid score
 	}
@@ -198,16 +280,298 @@ func (suite *ServiceCreateRating) TestReturnErrorWhenRequestValidate() {
 		LastName:  "mock_LastName",
 	}
 
-	rating, err := suite.service.Create(request, 1, claims)
+	rating, created, err := suite.service.Upsert(request, 1, claims)
 
 	suite.userService.AssertNotCalled(suite.T(), "GetByID", mock.AnythingOfType("model.Claims"))
 
-	suite.repo.AssertNotCalled(suite.T(), "Create", mock.AnythingOfType("*model.Rating"))
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
 
 	assert.Equal(suite.T(), model.Rating{}, rating)
+	assert.False(suite.T(), created)
 	assert.Equal(suite.T(), "request invalid: Key: 'RatingRequest.Score' Error:Field validation for 'Score' failed on the 'required' tag", err.Error())
 }
 
-func TestServiceCreateRating(t *testing.T) {
-	suite.Run(t, new(ServiceCreateRating))
+func (suite *ServiceUpsertRating) TestSaveReviewFields() {
+	request := dto.RatingRequest{
+		Score:    5,
+		Title:    "Best omelette",
+		Body:     "Fluffy",
+		Photos:   []string{"https://example.com/1.jpg", "https://example.com/2.jpg"},
+		CookedOn: "2026-10-18",
+	}
+
+	rating, _, err := suite.service.Upsert(request, 1, model.Claims{ID: "123abc"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Best omelette", rating.Title)
+	assert.Equal(suite.T(), model.Photos(request.Photos), rating.Photos)
+	assert.Equal(suite.T(), "2026-10-18", rating.CookedOn.Format(model.CookedOnLayout))
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenReviewInvalid() {
+	for name, request := range map[string]dto.RatingRequest{
+		"TooManyPhotos": {Score: 4, Photos: []string{
+			"https://example.com/1.jpg", "https://example.com/2.jpg", "https://example.com/3.jpg",
+			"https://example.com/4.jpg", "https://example.com/5.jpg", "https://example.com/6.jpg",
+		}},
+		"PhotoNotURL":     {Score: 4, Photos: []string{"not a url"}},
+		"DuplicatePhotos": {Score: 4, Photos: []string{"https://example.com/1.jpg", "https://example.com/1.jpg"}},
+		"CookedOnFormat":  {Score: 4, CookedOn: "18/10/2026"},
+		"TitleTooLong":    {Score: 4, Title: strings.Repeat("a", 121)},
+	} {
+		_, _, err := suite.service.Upsert(request, 1, model.Claims{ID: "123abc"})
+
+		assert.ErrorAs(suite.T(), err, &validator.ValidationErrors{}, name)
+	}
+
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpsertRating) TestAcceptHalfStar() {
+	rating, _, err := suite.service.Upsert(dto.RatingRequest{Score: 4.5}, 1, model.Claims{ID: "123abc"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4.5, rating.Score)
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenScoreOutOfRange() {
+	for _, score := range []float64{0.5, 5.5, -1} {
+		_, _, err := suite.service.Upsert(dto.RatingRequest{Score: score}, 1, model.Claims{ID: "123abc"})
+
+		assert.ErrorAs(suite.T(), err, &validator.ValidationErrors{}, score)
+	}
+
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenScoreNotHalfStep() {
+	_, _, err := suite.service.Upsert(dto.RatingRequest{Score: 4.2}, 1, model.Claims{ID: "123abc"})
+
+	assert.EqualError(suite.T(), err, "request invalid: Key: 'RatingRequest.Score' Error:Field validation for 'Score' failed on the 'ratingstep' tag")
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpsertRating) TestReturnNotFoundWhenRecipeNotExist() {
+	suite.errFoodRecipeServiceGetByID = gorm.ErrRecordNotFound
+
+	rating, created, err := suite.service.Upsert(dto.RatingRequest{Score: 4}, 99, model.Claims{ID: "123abc"})
+
+	suite.foodRecipeService.AssertCalled(suite.T(), "GetByID", 99)
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+	assert.False(suite.T(), created)
+	assert.Equal(suite.T(), model.Rating{}, rating)
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenRepositoryGetByUser() {
+	suite.errRepositoryGetByUser = assert.AnError
+
+	_, _, err := suite.service.Upsert(dto.RatingRequest{Score: 4}, 1, model.Claims{ID: "123abc"})
+
+	assert.ErrorIs(suite.T(), err, assert.AnError)
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpsertRating) TestReturnErrorWhenRepositoryUpsert() {
+	suite.errRepositoryUpsert = assert.AnError
+
+	rating, _, err := suite.service.Upsert(dto.RatingRequest{Score: 4}, 1, model.Claims{ID: "123abc"})
+
+	assert.ErrorIs(suite.T(), err, assert.AnError)
+	assert.Equal(suite.T(), model.Rating{}, rating)
+}
+
+func TestServiceUpsertRating(t *testing.T) {
+	suite.Run(t, new(ServiceUpsertRating))
+}
+
+type ServiceMyRating struct {
+	suite.Suite
+
+	// Dependencies
+	service rating.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respRepositoryGetByUser model.Rating
+	errRepositoryGetByUser  error
+	errRepositoryDelete     error
+}
+
+func (suite *ServiceMyRating) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &rating.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respRepositoryGetByUser = model.Rating{Model: gorm.Model{ID: 1}, Score: 4, FoodRecipeID: 1, UserID: "123abc"}
+	suite.errRepositoryGetByUser = nil
+	suite.errRepositoryDelete = nil
+
+	suite.repo.On("GetByUser", mock.Anything, mock.Anything).Return(func(int, string) (model.Rating, error) {
+		return suite.respRepositoryGetByUser, suite.errRepositoryGetByUser
+	})
+	suite.repo.On("Delete", mock.Anything, mock.Anything).Return(func(int, string) error {
+		return suite.errRepositoryDelete
+	})
+}
+
+func (suite *ServiceMyRating) TestReturnRatingOfCaller() {
+	rating, err := suite.service.GetByUser(1, model.Claims{ID: "123abc"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respRepositoryGetByUser, rating)
+	suite.repo.AssertCalled(suite.T(), "GetByUser", 1, "123abc")
+}
+
+func (suite *ServiceMyRating) TestReturnNotFoundWhenNotRated() {
+	suite.errRepositoryGetByUser = gorm.ErrRecordNotFound
+
+	rating, err := suite.service.GetByUser(1, model.Claims{ID: "123abc"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Empty(rating)
+}
+
+func (suite *ServiceMyRating) TestDeleteRatingOfCaller() {
+	err := suite.service.Delete(1, model.Claims{ID: "123abc"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Delete", 1, "123abc")
+}
+
+func (suite *ServiceMyRating) TestReturnNotFoundWhenDeleteNotRated() {
+	suite.errRepositoryDelete = gorm.ErrRecordNotFound
+
+	err := suite.service.Delete(1, model.Claims{ID: "123abc"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceMyRating(t *testing.T) {
+	suite.Run(t, new(ServiceMyRating))
+}
+
+func TestServiceRepairSummaries(t *testing.T) {
+	t.Run("ShouldReturnRepairedCount", func(t *testing.T) {
+		repo := new(MockIRepository)
+		repo.On("RepairSummaries").Return(int64(3), nil)
+
+		repaired, err := rating.Service{Repository: repo}.RepairSummaries()
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), repaired)
+	})
+
+	t.Run("ShouldWrapRepositoryError", func(t *testing.T) {
+		repo := new(MockIRepository)
+		repo.On("RepairSummaries").Return(int64(0), assert.AnError)
+
+		_, err := rating.Service{Repository: repo}.RepairSummaries()
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+type ServiceVoteRating struct {
+	suite.Suite
+
+	// Dependencies
+	service rating.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respRepositoryGetByID model.Rating
+	errRepositoryGetByID  error
+	errRepositoryVote     error
+	errRepositoryUnvote   error
+}
+
+func (suite *ServiceVoteRating) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &rating.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respRepositoryGetByID = model.Rating{Model: gorm.Model{ID: 7}, Score: 4, FoodRecipeID: 1, UserID: "author"}
+	suite.errRepositoryGetByID = nil
+	suite.errRepositoryVote = nil
+	suite.errRepositoryUnvote = nil
+
+	suite.repo.On("GetByID", mock.Anything, mock.Anything).Return(func(int, int) (model.Rating, error) {
+		return suite.respRepositoryGetByID, suite.errRepositoryGetByID
+	})
+	suite.repo.On("Vote", mock.Anything).Return(func(*model.RatingVote) error {
+		return suite.errRepositoryVote
+	})
+	suite.repo.On("Unvote", mock.Anything, mock.Anything).Return(func(uint, string) error {
+		return suite.errRepositoryUnvote
+	})
+}
+
+func (suite *ServiceVoteRating) TestSaveVoteOfCaller() {
+	helpful := false
+
+	rating, err := suite.service.Vote(dto.RatingVoteRequest{Helpful: &helpful}, 1, 7, model.Claims{ID: "123abc"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respRepositoryGetByID, rating)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 1, 7)
+	suite.repo.AssertCalled(suite.T(), "Vote", &model.RatingVote{RatingID: 7, UserID: "123abc", Helpful: false})
+}
+
+func (suite *ServiceVoteRating) TestReturnErrorWhenHelpfulMissing() {
+	_, err := suite.service.Vote(dto.RatingVoteRequest{}, 1, 7, model.Claims{ID: "123abc"})
+
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+	suite.repo.AssertNotCalled(suite.T(), "Vote", mock.Anything)
+}
+
+func (suite *ServiceVoteRating) TestReturnForbiddenWhenOwnRating() {
+	helpful := true
+
+	_, err := suite.service.Vote(dto.RatingVoteRequest{Helpful: &helpful}, 1, 7, model.Claims{ID: "author"})
+
+	suite.ErrorIs(err, global.ErrForbidden)
+	suite.repo.AssertNotCalled(suite.T(), "Vote", mock.Anything)
+}
+
+func (suite *ServiceVoteRating) TestReturnNotFoundWhenRatingNotExist() {
+	suite.errRepositoryGetByID = gorm.ErrRecordNotFound
+	helpful := true
+
+	_, err := suite.service.Vote(dto.RatingVoteRequest{Helpful: &helpful}, 1, 7, model.Claims{ID: "123abc"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceVoteRating) TestReturnErrorWhenRepositoryVote() {
+	suite.errRepositoryVote = assert.AnError
+	helpful := true
+
+	_, err := suite.service.Vote(dto.RatingVoteRequest{Helpful: &helpful}, 1, 7, model.Claims{ID: "123abc"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceVoteRating) TestRemoveVoteOfCaller() {
+	rating, err := suite.service.Unvote(1, 7, model.Claims{ID: "123abc"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respRepositoryGetByID, rating)
+	suite.repo.AssertCalled(suite.T(), "Unvote", uint(7), "123abc")
+}
+
+func (suite *ServiceVoteRating) TestReturnNotFoundWhenUnvoteRatingNotExist() {
+	suite.errRepositoryGetByID = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Unvote(1, 7, model.Claims{ID: "123abc"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Unvote", mock.Anything, mock.Anything)
+}
+
+func TestServiceVoteRating(t *testing.T) {
+	suite.Run(t, new(ServiceVoteRating))
 }
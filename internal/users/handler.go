@@ -2,11 +2,14 @@ package user
 
 import (
 	"net/http"
+	"wongnok/internal/global"
 	"wongnok/internal/helper"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -14,8 +17,21 @@ type IHandler interface {
 	GetRecipes(ctx *gin.Context)
 	//เพิ่มการอัพเดท สร้าง และ ดึงข้อมูลผู้ใช้
 	Update(ctx *gin.Context)
+	Patch(ctx *gin.Context)
 	Create(ctx *gin.Context)
-	Get(ctx *gin.Context)		
+	Get(ctx *gin.Context)
+	GetProfile(ctx *gin.Context)
+	Follow(ctx *gin.Context)
+	Unfollow(ctx *gin.Context)
+	GetFollowers(ctx *gin.Context)
+	GetFollowing(ctx *gin.Context)
+	Block(ctx *gin.Context)
+	Unblock(ctx *gin.Context)
+	GetBlocked(ctx *gin.Context)
+	Mute(ctx *gin.Context)
+	Unmute(ctx *gin.Context)
+	GetMuted(ctx *gin.Context)
+	ChangeHandle(ctx *gin.Context)
 }
 
 type Handler struct {
@@ -34,10 +50,11 @@ func NewHandler(db *gorm.DB) *Handler {
 // @Tags users
 // @Accept json
 // @Produce json
-// @Param id path string true "User ID"
+// @Param id path string true "User ID, @handle or self"
 // @Success 200 {object} dto.FoodRecipesResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Security BearerAuth
 // @Router /api/v1/users/{id}/food-recipes [get]
@@ -52,7 +69,7 @@ func (handler Handler) GetRecipes(ctx *gin.Context) {
 
 	recipes, err := handler.Service.GetRecipes(userID, claims)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
 		return
 	}
 
@@ -62,6 +79,17 @@ func (handler Handler) GetRecipes(ctx *gin.Context) {
 // อัพเดทข้อมูลผู้ใช้
 // Update godoc
 // @Summary Update user information
+// @Description Replace the caller's account information
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body dto.UserRequest true "User Request"
+// @Success 200 {object} dto.AccountResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/ [put]
 func (handler Handler) Update(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
@@ -80,12 +108,64 @@ func (handler Handler) Update(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, user.ToResponse())
+	ctx.JSON(http.StatusOK, user.ToAccountResponse())
+}
+
+// แก้ไขข้อมูลผู้ใช้บางส่วน
+// Patch godoc
+// @Summary Partially update user information with JSON Merge Patch
+// @Tags users
+// @Accept application/merge-patch+json
+// @Produce json
+// @Success 200 {object} dto.AccountResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/ [patch]
+func (handler Handler) Patch(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	patch, err := ctx.GetRawData()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	user, err := handler.Service.Patch(patch, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.As(err, &validator.ValidationErrors{}) || errors.Is(err, global.ErrInvalidPatch) {
+			statusCode = http.StatusBadRequest
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user.ToAccountResponse())
 }
 
 // สร้างข้อมูลผู้ใช้
 // Create godoc
 // @Summary Create user information
+// @Description Create the caller's account from the token claims if it doesn't exist yet
+// @Tags users
+// @Produce json
+// @Success 200 {object} dto.AccountResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/ [post]
 func (handler Handler) Create(ctx *gin.Context) {
 	claims, err := helper.DecodeClaims(ctx)
 	if err != nil {
@@ -99,12 +179,20 @@ func (handler Handler) Create(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, user.ToResponse())
+	ctx.JSON(http.StatusOK, user.ToAccountResponse())
 }
 
 // ดึงข้อมูลผู้ใช้
 // Get godoc
 // @Summary Get user information
+// @Description Get the caller's account information
+// @Tags users
+// @Produce json
+// @Success 200 {object} dto.AccountResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/ [get]
 func (handler Handler) Get(ctx *gin.Context) {
 
 	claims, err := helper.DecodeClaims(ctx)
@@ -119,6 +207,343 @@ func (handler Handler) Get(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, user.ToResponse())
+	ctx.JSON(http.StatusOK, user.ToAccountResponse())
+}
+
+// GetProfile godoc
+// @Summary Get a user's public profile
+// @Description Get profile, cooking stats and published recipes. Fields hidden by the user's privacy settings are omitted unless the caller is the owner.
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID, @handle or self"
+// @Param page query int false "Page number"
+// @Param limit query int false "Recipes per page"
+// @Success 200 {object} dto.ProfileResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/users/{id}/profile [get]
+func (handler Handler) GetProfile(ctx *gin.Context) {
+	query := model.ProfileQuery{Page: 1, Limit: 20}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	// ไม่ login ก็ดูได้ แต่ response ขึ้นกับผู้เรียก
+	ctx.Header("Vary", "Authorization")
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		claims = model.Claims{}
+	}
+
+	profile, err := handler.Service.GetProfile(ctx.Param("id"), query, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	if claims.ID != "" {
+		ctx.Header("Cache-Control", "private, no-store")
+	}
+
+	owner := claims.ID != "" && claims.ID == profile.User.ID
+
+	ctx.JSON(http.StatusOK, profile.ToResponse(owner))
+}
+
+// Follow godoc
+// @Summary Follow a user
+// @Description Follow another user. Repeating the request changes nothing
+// @Tags users
+// @Param userId path string true "User ID or @handle"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/following/{userId} [put]
+func (handler Handler) Follow(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Follow(ctx.Param("userId"), claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// Unfollow godoc
+// @Summary Unfollow a user
+// @Description Stop following a user. Succeeds even when not following
+// @Tags users
+// @Param userId path string true "User ID or @handle"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/following/{userId} [delete]
+func (handler Handler) Unfollow(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Unfollow(ctx.Param("userId"), claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetFollowers godoc
+// @Summary Get followers of a user
+// @Description Get users following the given user, most recent first
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID, @handle or self"
+// @Param page query int false "Page number"
+// @Param limit query int false "Users per page"
+// @Success 200 {object} dto.UsersResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/users/{id}/followers [get]
+func (handler Handler) GetFollowers(ctx *gin.Context) {
+	handler.listFollows(ctx, handler.Service.GetFollowers)
+}
+
+// GetFollowing godoc
+// @Summary Get users followed by a user
+// @Description Get users the given user follows, most recent first
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID, @handle or self"
+// @Param page query int false "Page number"
+// @Param limit query int false "Users per page"
+// @Success 200 {object} dto.UsersResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/users/{id}/following [get]
+func (handler Handler) GetFollowing(ctx *gin.Context) {
+	handler.listFollows(ctx, handler.Service.GetFollowing)
+}
+
+func (handler Handler) listFollows(ctx *gin.Context, list func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)) {
+	query := model.FollowQuery{Page: 1, Limit: 20}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	// ไม่ login ก็ดูได้ ใช้ claims เฉพาะเพื่อแปลง self
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		claims = model.Claims{}
+	}
+
+	users, total, err := list(ctx.Param("id"), query, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, users.ToResponse(total))
+}
+
+// Block godoc
+// @Summary Block a user
+// @Description Block another user. Both users stop following each other, can't see each other's content and the blocked user can't rate, comment on or favorite the caller's recipes
+// @Tags users
+// @Param userId path string true "User ID or @handle"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/blocks/{userId} [put]
+func (handler Handler) Block(ctx *gin.Context) {
+	handler.relate(ctx, handler.Service.Block)
+}
+
+// Unblock godoc
+// @Summary Unblock a user
+// @Description Remove a block. Succeeds even when the user is not blocked
+// @Tags users
+// @Param userId path string true "User ID or @handle"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/blocks/{userId} [delete]
+func (handler Handler) Unblock(ctx *gin.Context) {
+	handler.relate(ctx, handler.Service.Unblock)
+}
+
+// GetBlocked godoc
+// @Summary Get blocked users
+// @Description Get users the caller has blocked, most recent first
+// @Tags users
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Users per page"
+// @Success 200 {object} dto.UsersResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/blocks [get]
+func (handler Handler) GetBlocked(ctx *gin.Context) {
+	handler.listMine(ctx, handler.Service.GetBlocked)
+}
+
+// Mute godoc
+// @Summary Mute a user
+// @Description Hide a user's recipes, ratings and comments from the caller's views. The muted user is not affected
+// @Tags users
+// @Param userId path string true "User ID or @handle"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/mutes/{userId} [put]
+func (handler Handler) Mute(ctx *gin.Context) {
+	handler.relate(ctx, handler.Service.Mute)
+}
+
+// Unmute godoc
+// @Summary Unmute a user
+// @Description Remove a mute. Succeeds even when the user is not muted
+// @Tags users
+// @Param userId path string true "User ID or @handle"
+// @Success 204
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/mutes/{userId} [delete]
+func (handler Handler) Unmute(ctx *gin.Context) {
+	handler.relate(ctx, handler.Service.Unmute)
+}
+
+// GetMuted godoc
+// @Summary Get muted users
+// @Description Get users the caller has muted, most recent first
+// @Tags users
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Users per page"
+// @Success 200 {object} dto.UsersResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/mutes [get]
+func (handler Handler) GetMuted(ctx *gin.Context) {
+	handler.listMine(ctx, handler.Service.GetMuted)
+}
+
+// relate ใช้กับ block และ mute ซึ่งทำกับผู้ใช้ใน path param userId
+func (handler Handler) relate(ctx *gin.Context, action func(string, model.Claims) error) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := action(ctx.Param("userId"), claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (handler Handler) listMine(ctx *gin.Context, list func(model.FollowQuery, model.Claims) (model.Users, int64, error)) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	query := model.FollowQuery{Page: 1, Limit: 20}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	users, total, err := list(query, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, users.ToResponse(total))
 }
 
+// ChangeHandle godoc
+// @Summary Change my handle
+// @Description 3-30 letters, digits or underscores starting with a letter, case-insensitive. The old handle keeps resolving for 90 days
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param handle body dto.HandleRequest true "Handle"
+// @Success 200 {object} dto.AccountResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/handle [put]
+func (handler Handler) ChangeHandle(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.HandleRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	user, err := handler.Service.ChangeHandle(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user.ToAccountResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}), errors.Is(err, global.ErrFollowSelf), errors.Is(err, global.ErrBlockSelf),
+		errors.Is(err, global.ErrInvalidHandle), errors.Is(err, global.ErrHandleReserved):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrHandleTaken):
+		return http.StatusConflict
+	case errors.Is(err, global.ErrBlocked):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
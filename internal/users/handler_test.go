@@ -6,13 +6,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
-	"wongnok/internal/user"
+	user "wongnok/internal/users"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -192,6 +195,710 @@ func (suite *HandlerGetRecipesTestSuite) TestResponseErrorWhenGetRecipes() {
 	suite.Equal(http.StatusInternalServerError, response.Code)
 }
 
+func (suite *HandlerGetRecipesTestSuite) TestResponseStatusCode404WhenUserNotFound() {
+	suite.errServiceGetRecipes = errors.Wrap(gorm.ErrRecordNotFound, "find user")
+
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(nil, &claims)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
 func TestHandlerGetRecipes(t *testing.T) {
 	suite.Run(t, new(HandlerGetRecipesTestSuite))
 }
+
+type HandlerPatchTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler user.IHandler
+	service *MockIService
+
+	// Mock data
+	respServicePatch model.User
+	errServicePatch  error
+
+	// Helper
+	server func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerPatchTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerPatchTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = user.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.PATCH("/api/v1/users/", suite.handler.Patch)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(
+			http.MethodPatch,
+			"/api/v1/users/",
+			payload,
+		)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServicePatch = model.User{
+		ID:       "ID",
+		NickName: "NickName",
+	}
+	suite.errServicePatch = nil
+
+	suite.service.On("Patch", mock.Anything, mock.Anything).Return(func(patch []byte, claims model.Claims) (model.User, error) {
+		return suite.respServicePatch, suite.errServicePatch
+	})
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseUserWithStatusCode200() {
+	claims := model.Claims{ID: "ID"}
+
+	response := suite.server(strings.NewReader(`{"nickName":"NickName"}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(dto.AccountResponse{UserResponse: dto.UserResponse{ID: "ID", Nickname: "NickName"}})
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+
+	suite.service.AssertCalled(suite.T(), "Patch", []byte(`{"nickName":"NickName"}`), claims)
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseStatusCode400WhenPatchInvalid() {
+	suite.errServicePatch = global.ErrInvalidPatch
+	claims := model.Claims{ID: "ID"}
+
+	response := suite.server(strings.NewReader(`[]`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid merge patch"}`, response.Body.String())
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseStatusCode404WhenUserNotFound() {
+	suite.errServicePatch = gorm.ErrRecordNotFound
+	claims := model.Claims{ID: "ID"}
+
+	response := suite.server(strings.NewReader(`{}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseErrorWhenPatch() {
+	suite.errServicePatch = assert.AnError
+	claims := model.Claims{ID: "ID"}
+
+	response := suite.server(strings.NewReader(`{}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
+func (suite *HandlerPatchTestSuite) TestResponseStatusCode401() {
+	response := suite.server(nil, nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Patch", mock.Anything, mock.Anything)
+}
+
+func TestHandlerPatch(t *testing.T) {
+	suite.Run(t, new(HandlerPatchTestSuite))
+}
+
+type HandlerGetProfileTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler user.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceGetProfile model.Profile
+	errServiceGetProfile  error
+
+	// Helper
+	server func(url string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerGetProfileTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerGetProfileTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = user.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(url string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/users/:id/profile", suite.handler.GetProfile)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceGetProfile = model.Profile{
+		User: model.User{
+			ID:             "UID",
+			NickName:       "NickName",
+			Bio:            "Bio",
+			ProfilePrivacy: model.ProfilePrivacy{HideBio: true},
+		},
+		Stats: model.UserStats{RecipeCount: 1},
+	}
+	suite.errServiceGetProfile = nil
+
+	suite.service.On("GetProfile", mock.Anything, mock.Anything, mock.Anything).Return(func(string, model.ProfileQuery, model.Claims) (model.Profile, error) {
+		return suite.respServiceGetProfile, suite.errServiceGetProfile
+	})
+}
+
+func (suite *HandlerGetProfileTestSuite) TestResponsePublicProfileForGuest() {
+	response := suite.server("/api/v1/users/UID/profile", nil)
+
+	var body dto.ProfileResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &body))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("Authorization", response.Header().Get("Vary"))
+	suite.Empty(response.Header().Get("Cache-Control"))
+	suite.Equal("UID", body.ID)
+	suite.Nil(body.Bio)
+	suite.Equal(int64(1), *body.RecipeCount)
+	suite.service.AssertCalled(suite.T(), "GetProfile", "UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+}
+
+func (suite *HandlerGetProfileTestSuite) TestResponseHiddenFieldsToOwner() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server("/api/v1/users/UID/profile?page=2&limit=5", &claims)
+
+	var body dto.ProfileResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &body))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("private, no-store", response.Header().Get("Cache-Control"))
+	suite.Equal("Bio", *body.Bio)
+	suite.service.AssertCalled(suite.T(), "GetProfile", "UID", model.ProfileQuery{Page: 2, Limit: 5}, claims)
+}
+
+func (suite *HandlerGetProfileTestSuite) TestResponseStatusCode400WhenQueryInvalid() {
+	response := suite.server("/api/v1/users/UID/profile?limit=1000", nil)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetProfile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerGetProfileTestSuite) TestResponseStatusCode404WhenUserNotFound() {
+	suite.errServiceGetProfile = gorm.ErrRecordNotFound
+
+	response := suite.server("/api/v1/users/UID/profile", nil)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerGetProfileTestSuite) TestResponseErrorWhenGetProfile() {
+	suite.errServiceGetProfile = assert.AnError
+
+	response := suite.server("/api/v1/users/UID/profile", nil)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerGetProfile(t *testing.T) {
+	suite.Run(t, new(HandlerGetProfileTestSuite))
+}
+
+type HandlerFollowTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler user.IHandler
+	service *MockIService
+
+	// Mock data
+	errServiceFollow       error
+	errServiceUnfollow     error
+	respServiceGetFollows  model.Users
+	errServiceGetFollowers error
+
+	// Helper
+	server func(method string, url string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerFollowTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerFollowTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = user.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.PUT("/api/v1/users/self/following/:userId", suite.handler.Follow)
+		router.DELETE("/api/v1/users/self/following/:userId", suite.handler.Unfollow)
+		router.GET("/api/v1/users/:id/followers", suite.handler.GetFollowers)
+		router.GET("/api/v1/users/:id/following", suite.handler.GetFollowing)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.errServiceFollow = nil
+	suite.errServiceUnfollow = nil
+	suite.respServiceGetFollows = model.Users{{ID: "Follower", NickName: "NickName"}}
+	suite.errServiceGetFollowers = nil
+
+	suite.service.On("Follow", mock.Anything, mock.Anything).Return(func(string, model.Claims) error {
+		return suite.errServiceFollow
+	})
+	suite.service.On("Unfollow", mock.Anything, mock.Anything).Return(func(string, model.Claims) error {
+		return suite.errServiceUnfollow
+	})
+	suite.service.On("GetFollowers", mock.Anything, mock.Anything, mock.Anything).Return(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error) {
+		return suite.respServiceGetFollows, 1, suite.errServiceGetFollowers
+	})
+	suite.service.On("GetFollowing", mock.Anything, mock.Anything, mock.Anything).Return(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error) {
+		return suite.respServiceGetFollows, 1, nil
+	})
+}
+
+func (suite *HandlerFollowTestSuite) TestFollowResponseStatusCode204() {
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/following/UID", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "Follow", "UID", claims)
+}
+
+func (suite *HandlerFollowTestSuite) TestFollowResponseStatusCode400WhenFollowSelf() {
+	suite.errServiceFollow = global.ErrFollowSelf
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/following/UID", &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"cannot follow yourself"}`, response.Body.String())
+}
+
+func (suite *HandlerFollowTestSuite) TestFollowResponseStatusCode403WhenBlocked() {
+	suite.errServiceFollow = global.ErrBlocked
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/following/UID", &claims)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerFollowTestSuite) TestFollowResponseStatusCode404WhenUserNotFound() {
+	suite.errServiceFollow = gorm.ErrRecordNotFound
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/following/UID", &claims)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerFollowTestSuite) TestFollowResponseStatusCode401() {
+	response := suite.server(http.MethodPut, "/api/v1/users/self/following/UID", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Follow", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerFollowTestSuite) TestUnfollowResponseStatusCode204() {
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/following/UID", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "Unfollow", "UID", claims)
+}
+
+func (suite *HandlerFollowTestSuite) TestUnfollowResponseErrorWhenUnfollow() {
+	suite.errServiceUnfollow = assert.AnError
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/following/UID", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerFollowTestSuite) TestGetFollowersResponseUsers() {
+	response := suite.server(http.MethodGet, "/api/v1/users/UID/followers?page=2&limit=5", nil)
+
+	expectedJson, _ := json.Marshal(suite.respServiceGetFollows.ToResponse(1))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "GetFollowers", "UID", model.FollowQuery{Page: 2, Limit: 5}, model.Claims{})
+}
+
+func (suite *HandlerFollowTestSuite) TestGetFollowersResponseStatusCode404WhenUserNotFound() {
+	suite.errServiceGetFollowers = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodGet, "/api/v1/users/UID/followers", nil)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerFollowTestSuite) TestGetFollowersResponseStatusCode400WhenQueryInvalid() {
+	response := suite.server(http.MethodGet, "/api/v1/users/UID/followers?page=0", nil)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetFollowers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerFollowTestSuite) TestGetFollowingResolveSelfWithClaims() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/following", &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "GetFollowing", "self", model.FollowQuery{Page: 1, Limit: 20}, claims)
+}
+
+func TestHandlerFollow(t *testing.T) {
+	suite.Run(t, new(HandlerFollowTestSuite))
+}
+
+type HandlerBlockTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler user.IHandler
+	service *MockIService
+
+	// Mock data
+	errServiceBlock      error
+	respServiceGetMuted  model.Users
+	errServiceGetBlocked error
+
+	// Helper
+	server func(method string, url string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerBlockTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerBlockTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = user.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/users/self/blocks", suite.handler.GetBlocked)
+		router.PUT("/api/v1/users/self/blocks/:userId", suite.handler.Block)
+		router.DELETE("/api/v1/users/self/blocks/:userId", suite.handler.Unblock)
+		router.GET("/api/v1/users/self/mutes", suite.handler.GetMuted)
+		router.PUT("/api/v1/users/self/mutes/:userId", suite.handler.Mute)
+		router.DELETE("/api/v1/users/self/mutes/:userId", suite.handler.Unmute)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, url, nil)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.errServiceBlock = nil
+	suite.respServiceGetMuted = model.Users{{ID: "Muted", NickName: "NickName"}}
+	suite.errServiceGetBlocked = nil
+
+	suite.service.On("Block", mock.Anything, mock.Anything).Return(func(string, model.Claims) error {
+		return suite.errServiceBlock
+	})
+	suite.service.On("Unblock", mock.Anything, mock.Anything).Return(nil)
+	suite.service.On("Mute", mock.Anything, mock.Anything).Return(nil)
+	suite.service.On("Unmute", mock.Anything, mock.Anything).Return(nil)
+	suite.service.On("GetBlocked", mock.Anything, mock.Anything).Return(func(model.FollowQuery, model.Claims) (model.Users, int64, error) {
+		return model.Users{}, 0, suite.errServiceGetBlocked
+	})
+	suite.service.On("GetMuted", mock.Anything, mock.Anything).Return(func(model.FollowQuery, model.Claims) (model.Users, int64, error) {
+		return suite.respServiceGetMuted, 1, nil
+	})
+}
+
+func (suite *HandlerBlockTestSuite) TestBlockResponseStatusCode204() {
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/blocks/UID", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "Block", "UID", claims)
+}
+
+func (suite *HandlerBlockTestSuite) TestBlockResponseStatusCode400WhenBlockSelf() {
+	suite.errServiceBlock = global.ErrBlockSelf
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/blocks/UID", &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (suite *HandlerBlockTestSuite) TestBlockResponseStatusCode404WhenUserNotFound() {
+	suite.errServiceBlock = gorm.ErrRecordNotFound
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodPut, "/api/v1/users/self/blocks/UID", &claims)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerBlockTestSuite) TestBlockResponseStatusCode401() {
+	response := suite.server(http.MethodPut, "/api/v1/users/self/blocks/UID", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Block", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerBlockTestSuite) TestUnblockResponseStatusCode204() {
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/blocks/UID", &claims)
+
+	suite.Equal(http.StatusNoContent, response.Code)
+	suite.service.AssertCalled(suite.T(), "Unblock", "UID", claims)
+}
+
+func (suite *HandlerBlockTestSuite) TestGetBlockedResponseErrorWhenGetBlocked() {
+	suite.errServiceGetBlocked = assert.AnError
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/blocks", &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func (suite *HandlerBlockTestSuite) TestMuteAndUnmuteResponseStatusCode204() {
+	claims := model.Claims{ID: "Viewer"}
+
+	suite.Equal(http.StatusNoContent, suite.server(http.MethodPut, "/api/v1/users/self/mutes/UID", &claims).Code)
+	suite.Equal(http.StatusNoContent, suite.server(http.MethodDelete, "/api/v1/users/self/mutes/UID", &claims).Code)
+
+	suite.service.AssertCalled(suite.T(), "Mute", "UID", claims)
+	suite.service.AssertCalled(suite.T(), "Unmute", "UID", claims)
+}
+
+func (suite *HandlerBlockTestSuite) TestGetMutedResponseUsers() {
+	claims := model.Claims{ID: "Viewer"}
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/mutes?page=2&limit=5", &claims)
+
+	expectedJson, _ := json.Marshal(suite.respServiceGetMuted.ToResponse(1))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "GetMuted", model.FollowQuery{Page: 2, Limit: 5}, claims)
+}
+
+func (suite *HandlerBlockTestSuite) TestGetMutedResponseStatusCode401() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/mutes", nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetMuted", mock.Anything, mock.Anything)
+}
+
+func TestHandlerBlock(t *testing.T) {
+	suite.Run(t, new(HandlerBlockTestSuite))
+}
+
+type HandlerChangeHandleTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler user.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceChangeHandle model.User
+	errServiceChangeHandle  error
+
+	// Helper
+	server func(body string, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerChangeHandleTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerChangeHandleTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = user.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(body string, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.PUT("/api/v1/users/self/handle", suite.handler.ChangeHandle)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(http.MethodPut, "/api/v1/users/self/handle", strings.NewReader(body))
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	handle := "Chef_Demo"
+	suite.respServiceChangeHandle = model.User{ID: "UID", Handle: &handle}
+	suite.errServiceChangeHandle = nil
+
+	suite.service.On("ChangeHandle", mock.Anything, mock.Anything).Return(func(dto.HandleRequest, model.Claims) (model.User, error) {
+		return suite.respServiceChangeHandle, suite.errServiceChangeHandle
+	})
+}
+
+func (suite *HandlerChangeHandleTestSuite) TestResponseAccount() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(`{"handle":"Chef_Demo"}`, &claims)
+
+	expectedJson, _ := json.Marshal(suite.respServiceChangeHandle.ToAccountResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.Contains(response.Body.String(), `"handle":"Chef_Demo"`)
+	suite.service.AssertCalled(suite.T(), "ChangeHandle", dto.HandleRequest{Handle: "Chef_Demo"}, claims)
+}
+
+func (suite *HandlerChangeHandleTestSuite) TestResponseStatusCode400WhenBodyInvalid() {
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(`{"handle":1}`, &claims)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "ChangeHandle", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerChangeHandleTestSuite) TestResponseStatusCode400WhenHandleRejected() {
+	claims := model.Claims{ID: "UID"}
+
+	for _, err := range []error{errors.Wrap(global.ErrInvalidHandle, "too short"), global.ErrHandleReserved} {
+		suite.errServiceChangeHandle = err
+
+		response := suite.server(`{"handle":"self"}`, &claims)
+
+		suite.Equal(http.StatusBadRequest, response.Code)
+	}
+}
+
+func (suite *HandlerChangeHandleTestSuite) TestResponseStatusCode409WhenHandleTaken() {
+	suite.errServiceChangeHandle = global.ErrHandleTaken
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(`{"handle":"chef_demo"}`, &claims)
+
+	suite.Equal(http.StatusConflict, response.Code)
+}
+
+func (suite *HandlerChangeHandleTestSuite) TestResponseStatusCode401WhenNoClaims() {
+	response := suite.server(`{"handle":"chef_demo"}`, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "ChangeHandle", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerChangeHandleTestSuite) TestResponseErrorWhenChangeHandle() {
+	suite.errServiceChangeHandle = assert.AnError
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(`{"handle":"chef_demo"}`, &claims)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerChangeHandle(t *testing.T) {
+	suite.Run(t, new(HandlerChangeHandleTestSuite))
+}
@@ -6,6 +6,7 @@ package user_test
 
 import (
 	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
 
 	"github.com/gin-gonic/gin"
 	mock "github.com/stretchr/testify/mock"
@@ -38,53 +39,3385 @@ func (_m *MockIHandler) EXPECT() *MockIHandler_Expecter {
 	return &MockIHandler_Expecter{mock: &_m.Mock}
 }
 
-// GetRecipes provides a mock function for the type MockIHandler
-func (_mock *MockIHandler) GetRecipes(ctx *gin.Context) {
+// Block provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Block(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockIHandler_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Block(ctx interface{}) *MockIHandler_Block_Call {
+	return &MockIHandler_Block_Call{Call: _e.mock.On("Block", ctx)}
+}
+
+func (_c *MockIHandler_Block_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Block_Call) Return() *MockIHandler_Block_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Block_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Block_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ChangeHandle provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) ChangeHandle(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_ChangeHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeHandle'
+type MockIHandler_ChangeHandle_Call struct {
+	*mock.Call
+}
+
+// ChangeHandle is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) ChangeHandle(ctx interface{}) *MockIHandler_ChangeHandle_Call {
+	return &MockIHandler_ChangeHandle_Call{Call: _e.mock.On("ChangeHandle", ctx)}
+}
+
+func (_c *MockIHandler_ChangeHandle_Call) Run(run func(ctx *gin.Context)) *MockIHandler_ChangeHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_ChangeHandle_Call) Return() *MockIHandler_ChangeHandle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_ChangeHandle_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_ChangeHandle_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Create(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Create(ctx interface{}) *MockIHandler_Create_Call {
+	return &MockIHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *MockIHandler_Create_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) Return() *MockIHandler_Create_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Follow(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockIHandler_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Follow(ctx interface{}) *MockIHandler_Follow_Call {
+	return &MockIHandler_Follow_Call{Call: _e.mock.On("Follow", ctx)}
+}
+
+func (_c *MockIHandler_Follow_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Follow_Call) Return() *MockIHandler_Follow_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Follow_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Follow_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Get(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIHandler_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Get(ctx interface{}) *MockIHandler_Get_Call {
+	return &MockIHandler_Get_Call{Call: _e.mock.On("Get", ctx)}
+}
+
+func (_c *MockIHandler_Get_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) Return() *MockIHandler_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetBlocked provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetBlocked(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocked'
+type MockIHandler_GetBlocked_Call struct {
+	*mock.Call
+}
+
+// GetBlocked is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetBlocked(ctx interface{}) *MockIHandler_GetBlocked_Call {
+	return &MockIHandler_GetBlocked_Call{Call: _e.mock.On("GetBlocked", ctx)}
+}
+
+func (_c *MockIHandler_GetBlocked_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetBlocked_Call) Return() *MockIHandler_GetBlocked_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetBlocked_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetBlocked_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetFollowers(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockIHandler_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetFollowers(ctx interface{}) *MockIHandler_GetFollowers_Call {
+	return &MockIHandler_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx)}
+}
+
+func (_c *MockIHandler_GetFollowers_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetFollowers_Call) Return() *MockIHandler_GetFollowers_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetFollowers_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetFollowers_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetFollowing provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetFollowing(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type MockIHandler_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetFollowing(ctx interface{}) *MockIHandler_GetFollowing_Call {
+	return &MockIHandler_GetFollowing_Call{Call: _e.mock.On("GetFollowing", ctx)}
+}
+
+func (_c *MockIHandler_GetFollowing_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetFollowing_Call) Return() *MockIHandler_GetFollowing_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetFollowing_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetFollowing_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetMuted provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetMuted(ctx *gin.Context) {
 	_mock.Called(ctx)
 	return
 }
 
-// MockIHandler_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIHandler_GetRecipes_Call struct {
+// MockIHandler_GetMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMuted'
+type MockIHandler_GetMuted_Call struct {
+	*mock.Call
+}
+
+// GetMuted is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetMuted(ctx interface{}) *MockIHandler_GetMuted_Call {
+	return &MockIHandler_GetMuted_Call{Call: _e.mock.On("GetMuted", ctx)}
+}
+
+func (_c *MockIHandler_GetMuted_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetMuted_Call) Return() *MockIHandler_GetMuted_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetMuted_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetMuted_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetProfile provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetProfile(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockIHandler_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetProfile(ctx interface{}) *MockIHandler_GetProfile_Call {
+	return &MockIHandler_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx)}
+}
+
+func (_c *MockIHandler_GetProfile_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetProfile_Call) Return() *MockIHandler_GetProfile_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetProfile_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetProfile_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetRecipes(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIHandler_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetRecipes(ctx interface{}) *MockIHandler_GetRecipes_Call {
+	return &MockIHandler_GetRecipes_Call{Call: _e.mock.On("GetRecipes", ctx)}
+}
+
+func (_c *MockIHandler_GetRecipes_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetRecipes_Call) Return() *MockIHandler_GetRecipes_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetRecipes_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetRecipes_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Mute(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockIHandler_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Mute(ctx interface{}) *MockIHandler_Mute_Call {
+	return &MockIHandler_Mute_Call{Call: _e.mock.On("Mute", ctx)}
+}
+
+func (_c *MockIHandler_Mute_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Mute_Call) Return() *MockIHandler_Mute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Mute_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Mute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Patch(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIHandler_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Patch(ctx interface{}) *MockIHandler_Patch_Call {
+	return &MockIHandler_Patch_Call{Call: _e.mock.On("Patch", ctx)}
+}
+
+func (_c *MockIHandler_Patch_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Patch_Call) Return() *MockIHandler_Patch_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Patch_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Patch_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Unblock(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockIHandler_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Unblock(ctx interface{}) *MockIHandler_Unblock_Call {
+	return &MockIHandler_Unblock_Call{Call: _e.mock.On("Unblock", ctx)}
+}
+
+func (_c *MockIHandler_Unblock_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Unblock_Call) Return() *MockIHandler_Unblock_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Unblock_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Unblock_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Unfollow(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockIHandler_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Unfollow(ctx interface{}) *MockIHandler_Unfollow_Call {
+	return &MockIHandler_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx)}
+}
+
+func (_c *MockIHandler_Unfollow_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Unfollow_Call) Return() *MockIHandler_Unfollow_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Unfollow_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Unfollow_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Unmute provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Unmute(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockIHandler_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Unmute(ctx interface{}) *MockIHandler_Unmute_Call {
+	return &MockIHandler_Unmute_Call{Call: _e.mock.On("Unmute", ctx)}
+}
+
+func (_c *MockIHandler_Unmute_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Unmute_Call) Return() *MockIHandler_Unmute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Unmute_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Unmute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Update(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
+	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+}
+
+func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRepository {
+	mock := &MockIRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIRepository is an autogenerated mock type for the IRepository type
+type MockIRepository struct {
+	mock.Mock
+}
+
+type MockIRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// Block provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Block(block *model.UserBlock) error {
+	ret := _mock.Called(block)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.UserBlock) error); ok {
+		r0 = returnFunc(block)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockIRepository_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - block *model.UserBlock
+func (_e *MockIRepository_Expecter) Block(block interface{}) *MockIRepository_Block_Call {
+	return &MockIRepository_Block_Call{Call: _e.mock.On("Block", block)}
+}
+
+func (_c *MockIRepository_Block_Call) Run(run func(block *model.UserBlock)) *MockIRepository_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.UserBlock
+		if args[0] != nil {
+			arg0 = args[0].(*model.UserBlock)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Block_Call) Return(err error) *MockIRepository_Block_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Block_Call) RunAndReturn(run func(block *model.UserBlock) error) *MockIRepository_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Create(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIRepository_Expecter) Create(user interface{}) *MockIRepository_Create_Call {
+	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", user)}
+}
+
+func (_c *MockIRepository_Create_Call) Run(run func(user *model.User)) *MockIRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) Return(user model.User, err error) *MockIRepository_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Follow(follow *model.Follow, notice *model.Notification) error {
+	ret := _mock.Called(follow, notice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Follow, *model.Notification) error); ok {
+		r0 = returnFunc(follow, notice)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockIRepository_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - follow *model.Follow
+//   - notice *model.Notification
+func (_e *MockIRepository_Expecter) Follow(follow interface{}, notice interface{}) *MockIRepository_Follow_Call {
+	return &MockIRepository_Follow_Call{Call: _e.mock.On("Follow", follow, notice)}
+}
+
+func (_c *MockIRepository_Follow_Call) Run(run func(follow *model.Follow, notice *model.Notification)) *MockIRepository_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Follow
+		if args[0] != nil {
+			arg0 = args[0].(*model.Follow)
+		}
+		var arg1 *model.Notification
+		if args[1] != nil {
+			arg1 = args[1].(*model.Notification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Follow_Call) Return(err error) *MockIRepository_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Follow_Call) RunAndReturn(run func(follow *model.Follow, notice *model.Notification) error) *MockIRepository_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlocked provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetBlocked(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocked")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) model.Users); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery) int64); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery) error); ok {
+		r2 = returnFunc(userID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocked'
+type MockIRepository_GetBlocked_Call struct {
+	*mock.Call
+}
+
+// GetBlocked is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+func (_e *MockIRepository_Expecter) GetBlocked(userID interface{}, query interface{}) *MockIRepository_GetBlocked_Call {
+	return &MockIRepository_GetBlocked_Call{Call: _e.mock.On("GetBlocked", userID, query)}
+}
+
+func (_c *MockIRepository_GetBlocked_Call) Run(run func(userID string, query model.FollowQuery)) *MockIRepository_GetBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetBlocked_Call) Return(users model.Users, n int64, err error) *MockIRepository_GetBlocked_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetBlocked_Call) RunAndReturn(run func(userID string, query model.FollowQuery) (model.Users, int64, error)) *MockIRepository_GetBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHandle provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByHandle(handle string) (model.User, error) {
+	ret := _mock.Called(handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHandle")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.User, error)); ok {
+		return returnFunc(handle)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.User); ok {
+		r0 = returnFunc(handle)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHandle'
+type MockIRepository_GetByHandle_Call struct {
+	*mock.Call
+}
+
+// GetByHandle is a helper method to define mock.On call
+//   - handle string
+func (_e *MockIRepository_Expecter) GetByHandle(handle interface{}) *MockIRepository_GetByHandle_Call {
+	return &MockIRepository_GetByHandle_Call{Call: _e.mock.On("GetByHandle", handle)}
+}
+
+func (_c *MockIRepository_GetByHandle_Call) Run(run func(handle string)) *MockIRepository_GetByHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByHandle_Call) Return(user model.User, err error) *MockIRepository_GetByHandle_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByHandle_Call) RunAndReturn(run func(handle string) (model.User, error)) *MockIRepository_GetByHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id string) (model.User, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.User, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.User); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(id string)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(user model.User, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id string) (model.User, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetFollowers(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) model.Users); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery) int64); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery) error); ok {
+		r2 = returnFunc(userID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockIRepository_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+func (_e *MockIRepository_Expecter) GetFollowers(userID interface{}, query interface{}) *MockIRepository_GetFollowers_Call {
+	return &MockIRepository_GetFollowers_Call{Call: _e.mock.On("GetFollowers", userID, query)}
+}
+
+func (_c *MockIRepository_GetFollowers_Call) Run(run func(userID string, query model.FollowQuery)) *MockIRepository_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetFollowers_Call) Return(users model.Users, n int64, err error) *MockIRepository_GetFollowers_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetFollowers_Call) RunAndReturn(run func(userID string, query model.FollowQuery) (model.Users, int64, error)) *MockIRepository_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetFollowing(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) model.Users); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery) int64); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery) error); ok {
+		r2 = returnFunc(userID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type MockIRepository_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+func (_e *MockIRepository_Expecter) GetFollowing(userID interface{}, query interface{}) *MockIRepository_GetFollowing_Call {
+	return &MockIRepository_GetFollowing_Call{Call: _e.mock.On("GetFollowing", userID, query)}
+}
+
+func (_c *MockIRepository_GetFollowing_Call) Run(run func(userID string, query model.FollowQuery)) *MockIRepository_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetFollowing_Call) Return(users model.Users, n int64, err error) *MockIRepository_GetFollowing_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetFollowing_Call) RunAndReturn(run func(userID string, query model.FollowQuery) (model.Users, int64, error)) *MockIRepository_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMuted provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetMuted(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMuted")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery) model.Users); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery) int64); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery) error); ok {
+		r2 = returnFunc(userID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMuted'
+type MockIRepository_GetMuted_Call struct {
+	*mock.Call
+}
+
+// GetMuted is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+func (_e *MockIRepository_Expecter) GetMuted(userID interface{}, query interface{}) *MockIRepository_GetMuted_Call {
+	return &MockIRepository_GetMuted_Call{Call: _e.mock.On("GetMuted", userID, query)}
+}
+
+func (_c *MockIRepository_GetMuted_Call) Run(run func(userID string, query model.FollowQuery)) *MockIRepository_GetMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetMuted_Call) Return(users model.Users, n int64, err error) *MockIRepository_GetMuted_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetMuted_Call) RunAndReturn(run func(userID string, query model.FollowQuery) (model.Users, int64, error)) *MockIRepository_GetMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublishedRecipes provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetPublishedRecipes(userID string, query model.ProfileQuery) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublishedRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.ProfileQuery) error); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetPublishedRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublishedRecipes'
+type MockIRepository_GetPublishedRecipes_Call struct {
+	*mock.Call
+}
+
+// GetPublishedRecipes is a helper method to define mock.On call
+//   - userID string
+//   - query model.ProfileQuery
+func (_e *MockIRepository_Expecter) GetPublishedRecipes(userID interface{}, query interface{}) *MockIRepository_GetPublishedRecipes_Call {
+	return &MockIRepository_GetPublishedRecipes_Call{Call: _e.mock.On("GetPublishedRecipes", userID, query)}
+}
+
+func (_c *MockIRepository_GetPublishedRecipes_Call) Run(run func(userID string, query model.ProfileQuery)) *MockIRepository_GetPublishedRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.ProfileQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.ProfileQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetPublishedRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetPublishedRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetPublishedRecipes_Call) RunAndReturn(run func(userID string, query model.ProfileQuery) (model.FoodRecipes, error)) *MockIRepository_GetPublishedRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetRecipes(userID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipes); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIRepository_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) GetRecipes(userID interface{}) *MockIRepository_GetRecipes_Call {
+	return &MockIRepository_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID)}
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Run(run func(userID string)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) RunAndReturn(run func(userID string) (model.FoodRecipes, error)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStats provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetStats(userID string) (model.UserStats, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStats")
+	}
+
+	var r0 model.UserStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.UserStats, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.UserStats); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(model.UserStats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStats'
+type MockIRepository_GetStats_Call struct {
+	*mock.Call
+}
+
+// GetStats is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) GetStats(userID interface{}) *MockIRepository_GetStats_Call {
+	return &MockIRepository_GetStats_Call{Call: _e.mock.On("GetStats", userID)}
+}
+
+func (_c *MockIRepository_GetStats_Call) Run(run func(userID string)) *MockIRepository_GetStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetStats_Call) Return(userStats model.UserStats, err error) *MockIRepository_GetStats_Call {
+	_c.Call.Return(userStats, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetStats_Call) RunAndReturn(run func(userID string) (model.UserStats, error)) *MockIRepository_GetStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBlocked provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) IsBlocked(userID string, otherID string) (bool, error) {
+	ret := _mock.Called(userID, otherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBlocked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return returnFunc(userID, otherID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = returnFunc(userID, otherID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(userID, otherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_IsBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBlocked'
+type MockIRepository_IsBlocked_Call struct {
+	*mock.Call
+}
+
+// IsBlocked is a helper method to define mock.On call
+//   - userID string
+//   - otherID string
+func (_e *MockIRepository_Expecter) IsBlocked(userID interface{}, otherID interface{}) *MockIRepository_IsBlocked_Call {
+	return &MockIRepository_IsBlocked_Call{Call: _e.mock.On("IsBlocked", userID, otherID)}
+}
+
+func (_c *MockIRepository_IsBlocked_Call) Run(run func(userID string, otherID string)) *MockIRepository_IsBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_IsBlocked_Call) Return(b bool, err error) *MockIRepository_IsBlocked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_IsBlocked_Call) RunAndReturn(run func(userID string, otherID string) (bool, error)) *MockIRepository_IsBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowing provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) IsFollowing(followerID string, followeeID string) (bool, error) {
+	ret := _mock.Called(followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowing")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return returnFunc(followerID, followeeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = returnFunc(followerID, followeeID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(followerID, followeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_IsFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowing'
+type MockIRepository_IsFollowing_Call struct {
+	*mock.Call
+}
+
+// IsFollowing is a helper method to define mock.On call
+//   - followerID string
+//   - followeeID string
+func (_e *MockIRepository_Expecter) IsFollowing(followerID interface{}, followeeID interface{}) *MockIRepository_IsFollowing_Call {
+	return &MockIRepository_IsFollowing_Call{Call: _e.mock.On("IsFollowing", followerID, followeeID)}
+}
+
+func (_c *MockIRepository_IsFollowing_Call) Run(run func(followerID string, followeeID string)) *MockIRepository_IsFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_IsFollowing_Call) Return(b bool, err error) *MockIRepository_IsFollowing_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_IsFollowing_Call) RunAndReturn(run func(followerID string, followeeID string) (bool, error)) *MockIRepository_IsFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsHandleAvailable provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) IsHandleAvailable(handle string, userID string) (bool, error) {
+	ret := _mock.Called(handle, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsHandleAvailable")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return returnFunc(handle, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = returnFunc(handle, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(handle, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_IsHandleAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsHandleAvailable'
+type MockIRepository_IsHandleAvailable_Call struct {
+	*mock.Call
+}
+
+// IsHandleAvailable is a helper method to define mock.On call
+//   - handle string
+//   - userID string
+func (_e *MockIRepository_Expecter) IsHandleAvailable(handle interface{}, userID interface{}) *MockIRepository_IsHandleAvailable_Call {
+	return &MockIRepository_IsHandleAvailable_Call{Call: _e.mock.On("IsHandleAvailable", handle, userID)}
+}
+
+func (_c *MockIRepository_IsHandleAvailable_Call) Run(run func(handle string, userID string)) *MockIRepository_IsHandleAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_IsHandleAvailable_Call) Return(b bool, err error) *MockIRepository_IsHandleAvailable_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_IsHandleAvailable_Call) RunAndReturn(run func(handle string, userID string) (bool, error)) *MockIRepository_IsHandleAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Mute(mute *model.UserMute) error {
+	ret := _mock.Called(mute)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.UserMute) error); ok {
+		r0 = returnFunc(mute)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockIRepository_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - mute *model.UserMute
+func (_e *MockIRepository_Expecter) Mute(mute interface{}) *MockIRepository_Mute_Call {
+	return &MockIRepository_Mute_Call{Call: _e.mock.On("Mute", mute)}
+}
+
+func (_c *MockIRepository_Mute_Call) Run(run func(mute *model.UserMute)) *MockIRepository_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.UserMute
+		if args[0] != nil {
+			arg0 = args[0].(*model.UserMute)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Mute_Call) Return(err error) *MockIRepository_Mute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Mute_Call) RunAndReturn(run func(mute *model.UserMute) error) *MockIRepository_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Restore(userID string) error {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockIRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) Restore(userID interface{}) *MockIRepository_Restore_Call {
+	return &MockIRepository_Restore_Call{Call: _e.mock.On("Restore", userID)}
+}
+
+func (_c *MockIRepository_Restore_Call) Run(run func(userID string)) *MockIRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Restore_Call) Return(err error) *MockIRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Restore_Call) RunAndReturn(run func(userID string) error) *MockIRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHandle provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) SetHandle(userID string, handle string, redirect *model.HandleRedirect) error {
+	ret := _mock.Called(userID, handle, redirect)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHandle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, *model.HandleRedirect) error); ok {
+		r0 = returnFunc(userID, handle, redirect)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_SetHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHandle'
+type MockIRepository_SetHandle_Call struct {
+	*mock.Call
+}
+
+// SetHandle is a helper method to define mock.On call
+//   - userID string
+//   - handle string
+//   - redirect *model.HandleRedirect
+func (_e *MockIRepository_Expecter) SetHandle(userID interface{}, handle interface{}, redirect interface{}) *MockIRepository_SetHandle_Call {
+	return &MockIRepository_SetHandle_Call{Call: _e.mock.On("SetHandle", userID, handle, redirect)}
+}
+
+func (_c *MockIRepository_SetHandle_Call) Run(run func(userID string, handle string, redirect *model.HandleRedirect)) *MockIRepository_SetHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *model.HandleRedirect
+		if args[2] != nil {
+			arg2 = args[2].(*model.HandleRedirect)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_SetHandle_Call) Return(err error) *MockIRepository_SetHandle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_SetHandle_Call) RunAndReturn(run func(userID string, handle string, redirect *model.HandleRedirect) error) *MockIRepository_SetHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Unblock(blockerID string, blockedID string) error {
+	ret := _mock.Called(blockerID, blockedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(blockerID, blockedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockIRepository_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - blockerID string
+//   - blockedID string
+func (_e *MockIRepository_Expecter) Unblock(blockerID interface{}, blockedID interface{}) *MockIRepository_Unblock_Call {
+	return &MockIRepository_Unblock_Call{Call: _e.mock.On("Unblock", blockerID, blockedID)}
+}
+
+func (_c *MockIRepository_Unblock_Call) Run(run func(blockerID string, blockedID string)) *MockIRepository_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Unblock_Call) Return(err error) *MockIRepository_Unblock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Unblock_Call) RunAndReturn(run func(blockerID string, blockedID string) error) *MockIRepository_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Unfollow(followerID string, followeeID string) error {
+	ret := _mock.Called(followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockIRepository_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - followerID string
+//   - followeeID string
+func (_e *MockIRepository_Expecter) Unfollow(followerID interface{}, followeeID interface{}) *MockIRepository_Unfollow_Call {
+	return &MockIRepository_Unfollow_Call{Call: _e.mock.On("Unfollow", followerID, followeeID)}
+}
+
+func (_c *MockIRepository_Unfollow_Call) Run(run func(followerID string, followeeID string)) *MockIRepository_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Unfollow_Call) Return(err error) *MockIRepository_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Unfollow_Call) RunAndReturn(run func(followerID string, followeeID string) error) *MockIRepository_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Unmute(muterID string, mutedID string) error {
+	ret := _mock.Called(muterID, mutedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(muterID, mutedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockIRepository_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - muterID string
+//   - mutedID string
+func (_e *MockIRepository_Expecter) Unmute(muterID interface{}, mutedID interface{}) *MockIRepository_Unmute_Call {
+	return &MockIRepository_Unmute_Call{Call: _e.mock.On("Unmute", muterID, mutedID)}
+}
+
+func (_c *MockIRepository_Unmute_Call) Run(run func(muterID string, mutedID string)) *MockIRepository_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Unmute_Call) Return(err error) *MockIRepository_Unmute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Unmute_Call) RunAndReturn(run func(muterID string, mutedID string) error) *MockIRepository_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIRepository_Expecter) Update(user interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(user *model.User)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(user model.User, err error) *MockIRepository_Update_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Upsert(user *model.User) error {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockIRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIRepository_Expecter) Upsert(user interface{}) *MockIRepository_Upsert_Call {
+	return &MockIRepository_Upsert_Call{Call: _e.mock.On("Upsert", user)}
+}
+
+func (_c *MockIRepository_Upsert_Call) Run(run func(user *model.User)) *MockIRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) Return(err error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) RunAndReturn(run func(user *model.User) error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// Block provides a mock function for the type MockIService
+func (_mock *MockIService) Block(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockIService_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Block(userID interface{}, claims interface{}) *MockIService_Block_Call {
+	return &MockIService_Block_Call{Call: _e.mock.On("Block", userID, claims)}
+}
+
+func (_c *MockIService_Block_Call) Run(run func(userID string, claims model.Claims)) *MockIService_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Block_Call) Return(err error) *MockIService_Block_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Block_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIService_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeHandle provides a mock function for the type MockIService
+func (_mock *MockIService) ChangeHandle(request dto.HandleRequest, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeHandle")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.HandleRequest, model.Claims) (model.User, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.HandleRequest, model.Claims) model.User); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.HandleRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_ChangeHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeHandle'
+type MockIService_ChangeHandle_Call struct {
+	*mock.Call
+}
+
+// ChangeHandle is a helper method to define mock.On call
+//   - request dto.HandleRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) ChangeHandle(request interface{}, claims interface{}) *MockIService_ChangeHandle_Call {
+	return &MockIService_ChangeHandle_Call{Call: _e.mock.On("ChangeHandle", request, claims)}
+}
+
+func (_c *MockIService_ChangeHandle_Call) Run(run func(request dto.HandleRequest, claims model.Claims)) *MockIService_ChangeHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.HandleRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.HandleRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_ChangeHandle_Call) Return(user model.User, err error) *MockIService_ChangeHandle_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_ChangeHandle_Call) RunAndReturn(run func(request dto.HandleRequest, claims model.Claims) (model.User, error)) *MockIService_ChangeHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBlocked provides a mock function for the type MockIService
+func (_mock *MockIService) CheckBlocked(userID string, otherID string) error {
+	ret := _mock.Called(userID, otherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlocked")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, otherID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_CheckBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBlocked'
+type MockIService_CheckBlocked_Call struct {
+	*mock.Call
+}
+
+// CheckBlocked is a helper method to define mock.On call
+//   - userID string
+//   - otherID string
+func (_e *MockIService_Expecter) CheckBlocked(userID interface{}, otherID interface{}) *MockIService_CheckBlocked_Call {
+	return &MockIService_CheckBlocked_Call{Call: _e.mock.On("CheckBlocked", userID, otherID)}
+}
+
+func (_c *MockIService_CheckBlocked_Call) Run(run func(userID string, otherID string)) *MockIService_CheckBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_CheckBlocked_Call) Return(err error) *MockIService_CheckBlocked_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_CheckBlocked_Call) RunAndReturn(run func(userID string, otherID string) error) *MockIService_CheckBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(user model.User, err error) *MockIService_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_Create_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockIService
+func (_mock *MockIService) Follow(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockIService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Follow(userID interface{}, claims interface{}) *MockIService_Follow_Call {
+	return &MockIService_Follow_Call{Call: _e.mock.On("Follow", userID, claims)}
+}
+
+func (_c *MockIService_Follow_Call) Run(run func(userID string, claims model.Claims)) *MockIService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Follow_Call) Return(err error) *MockIService_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Follow_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlocked provides a mock function for the type MockIService
+func (_mock *MockIService) GetBlocked(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocked")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_GetBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocked'
+type MockIService_GetBlocked_Call struct {
+	*mock.Call
+}
+
+// GetBlocked is a helper method to define mock.On call
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetBlocked(query interface{}, claims interface{}) *MockIService_GetBlocked_Call {
+	return &MockIService_GetBlocked_Call{Call: _e.mock.On("GetBlocked", query, claims)}
+}
+
+func (_c *MockIService_GetBlocked_Call) Run(run func(query model.FollowQuery, claims model.Claims)) *MockIService_GetBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FollowQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FollowQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetBlocked_Call) Return(users model.Users, n int64, err error) *MockIService_GetBlocked_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIService_GetBlocked_Call) RunAndReturn(run func(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIService_GetBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByID(claims interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+}
+
+func (_c *MockIService_GetByID_Call) Run(run func(claims model.Claims)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(user model.User, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockIService
+func (_mock *MockIService) GetFollowers(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockIService_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetFollowers(userID interface{}, query interface{}, claims interface{}) *MockIService_GetFollowers_Call {
+	return &MockIService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", userID, query, claims)}
+}
+
+func (_c *MockIService_GetFollowers_Call) Run(run func(userID string, query model.FollowQuery, claims model.Claims)) *MockIService_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetFollowers_Call) Return(users model.Users, n int64, err error) *MockIService_GetFollowers_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIService_GetFollowers_Call) RunAndReturn(run func(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIService_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function for the type MockIService
+func (_mock *MockIService) GetFollowing(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type MockIService_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - userID string
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetFollowing(userID interface{}, query interface{}, claims interface{}) *MockIService_GetFollowing_Call {
+	return &MockIService_GetFollowing_Call{Call: _e.mock.On("GetFollowing", userID, query, claims)}
+}
+
+func (_c *MockIService_GetFollowing_Call) Run(run func(userID string, query model.FollowQuery, claims model.Claims)) *MockIService_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.FollowQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FollowQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetFollowing_Call) Return(users model.Users, n int64, err error) *MockIService_GetFollowing_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIService_GetFollowing_Call) RunAndReturn(run func(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIService_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMuted provides a mock function for the type MockIService
+func (_mock *MockIService) GetMuted(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMuted")
+	}
+
+	var r0 model.Users
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) (model.Users, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FollowQuery, model.Claims) model.Users); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Users)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FollowQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FollowQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_GetMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMuted'
+type MockIService_GetMuted_Call struct {
+	*mock.Call
+}
+
+// GetMuted is a helper method to define mock.On call
+//   - query model.FollowQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetMuted(query interface{}, claims interface{}) *MockIService_GetMuted_Call {
+	return &MockIService_GetMuted_Call{Call: _e.mock.On("GetMuted", query, claims)}
+}
+
+func (_c *MockIService_GetMuted_Call) Run(run func(query model.FollowQuery, claims model.Claims)) *MockIService_GetMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FollowQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FollowQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetMuted_Call) Return(users model.Users, n int64, err error) *MockIService_GetMuted_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockIService_GetMuted_Call) RunAndReturn(run func(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)) *MockIService_GetMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function for the type MockIService
+func (_mock *MockIService) GetProfile(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery, model.Claims) (model.Profile, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.ProfileQuery, model.Claims) model.Profile); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		r0 = ret.Get(0).(model.Profile)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.ProfileQuery, model.Claims) error); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockIService_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - userID string
+//   - query model.ProfileQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetProfile(userID interface{}, query interface{}, claims interface{}) *MockIService_GetProfile_Call {
+	return &MockIService_GetProfile_Call{Call: _e.mock.On("GetProfile", userID, query, claims)}
+}
+
+func (_c *MockIService_GetProfile_Call) Run(run func(userID string, query model.ProfileQuery, claims model.Claims)) *MockIService_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.ProfileQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.ProfileQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetProfile_Call) Return(profile model.Profile, err error) *MockIService_GetProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockIService_GetProfile_Call) RunAndReturn(run func(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error)) *MockIService_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIService
+func (_mock *MockIService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(userID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIService_GetRecipes_Call {
+	return &MockIService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+}
+
+func (_c *MockIService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockIService
+func (_mock *MockIService) Mute(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockIService_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Mute(userID interface{}, claims interface{}) *MockIService_Mute_Call {
+	return &MockIService_Mute_Call{Call: _e.mock.On("Mute", userID, claims)}
+}
+
+func (_c *MockIService_Mute_Call) Run(run func(userID string, claims model.Claims)) *MockIService_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Mute_Call) Return(err error) *MockIService_Mute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Mute_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIService_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIService
+func (_mock *MockIService) Patch(patch []byte, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(patch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) (model.User, error)); ok {
+		return returnFunc(patch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) model.User); ok {
+		r0 = returnFunc(patch, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, model.Claims) error); ok {
+		r1 = returnFunc(patch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIService_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - patch []byte
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Patch(patch interface{}, claims interface{}) *MockIService_Patch_Call {
+	return &MockIService_Patch_Call{Call: _e.mock.On("Patch", patch, claims)}
+}
+
+func (_c *MockIService_Patch_Call) Run(run func(patch []byte, claims model.Claims)) *MockIService_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Patch_Call) Return(user model.User, err error) *MockIService_Patch_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_Patch_Call) RunAndReturn(run func(patch []byte, claims model.Claims) (model.User, error)) *MockIService_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockIService
+func (_mock *MockIService) Unblock(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockIService_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Unblock(userID interface{}, claims interface{}) *MockIService_Unblock_Call {
+	return &MockIService_Unblock_Call{Call: _e.mock.On("Unblock", userID, claims)}
+}
+
+func (_c *MockIService_Unblock_Call) Run(run func(userID string, claims model.Claims)) *MockIService_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Unblock_Call) Return(err error) *MockIService_Unblock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Unblock_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIService_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockIService
+func (_mock *MockIService) Unfollow(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockIService_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Unfollow(userID interface{}, claims interface{}) *MockIService_Unfollow_Call {
+	return &MockIService_Unfollow_Call{Call: _e.mock.On("Unfollow", userID, claims)}
+}
+
+func (_c *MockIService_Unfollow_Call) Run(run func(userID string, claims model.Claims)) *MockIService_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Unfollow_Call) Return(err error) *MockIService_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Unfollow_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIService_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function for the type MockIService
+func (_mock *MockIService) Unmute(userID string, claims model.Claims) error {
+	ret := _mock.Called(userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockIService_Unmute_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
-//   - ctx *gin.Context
-func (_e *MockIHandler_Expecter) GetRecipes(ctx interface{}) *MockIHandler_GetRecipes_Call {
-	return &MockIHandler_GetRecipes_Call{Call: _e.mock.On("GetRecipes", ctx)}
+// Unmute is a helper method to define mock.On call
+//   - userID string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Unmute(userID interface{}, claims interface{}) *MockIService_Unmute_Call {
+	return &MockIService_Unmute_Call{Call: _e.mock.On("Unmute", userID, claims)}
 }
 
-func (_c *MockIHandler_GetRecipes_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetRecipes_Call {
+func (_c *MockIService_Unmute_Call) Run(run func(userID string, claims model.Claims)) *MockIService_Unmute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *gin.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(*gin.Context)
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIHandler_GetRecipes_Call) Return() *MockIHandler_GetRecipes_Call {
-	_c.Call.Return()
+func (_c *MockIService_Unmute_Call) Return(err error) *MockIService_Unmute_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIHandler_GetRecipes_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetRecipes_Call {
-	_c.Run(run)
+func (_c *MockIService_Unmute_Call) RunAndReturn(run func(userID string, claims model.Claims) error) *MockIService_Unmute_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// Update provides a mock function for the type MockIService
+func (_mock *MockIService) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIService_Expecter) Update(user interface{}) *MockIService_Update_Call {
+	return &MockIService_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIService_Update_Call) Run(run func(user *model.User)) *MockIService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Update_Call) Return(user model.User, err error) *MockIService_Update_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertWithClaims provides a mock function for the type MockIService
+func (_mock *MockIService) UpsertWithClaims(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithClaims")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
+type MockIService_UpsertWithClaims_Call struct {
+	*mock.Call
+}
+
+// UpsertWithClaims is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) UpsertWithClaims(claims interface{}) *MockIService_UpsertWithClaims_Call {
+	return &MockIService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+}
+
+func (_c *MockIService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIService_UpsertWithClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIService_UpsertWithClaims_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIService_UpsertWithClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIFoodRecipeService creates a new instance of MockIFoodRecipeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockIRepository(t interface {
+func NewMockIFoodRecipeService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockIRepository {
-	mock := &MockIRepository{}
+}) *MockIFoodRecipeService {
+	mock := &MockIFoodRecipeService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -92,38 +3425,244 @@ func NewMockIRepository(t interface {
 	return mock
 }
 
-// MockIRepository is an autogenerated mock type for the IRepository type
-type MockIRepository struct {
+// MockIFoodRecipeService is an autogenerated mock type for the IFoodRecipeService type
+type MockIFoodRecipeService struct {
 	mock.Mock
 }
 
-type MockIRepository_Expecter struct {
-	mock *mock.Mock
+type MockIFoodRecipeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIFoodRecipeService) EXPECT() *MockIFoodRecipeService_Expecter {
+	return &MockIFoodRecipeService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIFoodRecipeService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Create(request interface{}, claims interface{}) *MockIFoodRecipeService_Create_Call {
+	return &MockIFoodRecipeService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Delete(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ifMatch, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ifMatch, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ifMatch, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(id, ifMatch, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIFoodRecipeService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - ifMatch model.IfMatch
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Delete(id interface{}, ifMatch interface{}, claims interface{}) *MockIFoodRecipeService_Delete_Call {
+	return &MockIFoodRecipeService_Delete_Call{Call: _e.mock.On("Delete", id, ifMatch, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Run(run func(id int, ifMatch model.IfMatch, claims model.Claims)) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.IfMatch
+		if args[1] != nil {
+			arg1 = args[1].(model.IfMatch)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) RunAndReturn(run func(id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) int64); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
+		r2 = returnFunc(foodRecipeQuery)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIFoodRecipeService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIFoodRecipeService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIFoodRecipeService_Expecter) Get(foodRecipeQuery interface{}) *MockIFoodRecipeService_Get_Call {
+	return &MockIFoodRecipeService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery)}
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, err error) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(foodRecipes, n, err)
+	return _c
 }
 
-func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
-	return &MockIRepository_Expecter{mock: &_m.Mock}
+func (_c *MockIFoodRecipeService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// GetByID provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetByID(id string) (model.User, error) {
+// GetByID provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetByID(id int) (model.FoodRecipe, error) {
 	ret := _mock.Called(id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
 	}
 
-	var r0 model.User
+	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.User, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(int) (model.FoodRecipe, error)); ok {
 		return returnFunc(id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.User); ok {
+	if returnFunc, ok := ret.Get(0).(func(int) model.FoodRecipe); ok {
 		r0 = returnFunc(id)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
 		r1 = returnFunc(id)
 	} else {
 		r1 = ret.Error(1)
@@ -131,22 +3670,22 @@ func (_mock *MockIRepository) GetByID(id string) (model.User, error) {
 	return r0, r1
 }
 
-// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIRepository_GetByID_Call struct {
+// MockIFoodRecipeService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIFoodRecipeService_GetByID_Call struct {
 	*mock.Call
 }
 
 // GetByID is a helper method to define mock.On call
-//   - id string
-func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
-	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - id int
+func (_e *MockIFoodRecipeService_Expecter) GetByID(id interface{}) *MockIFoodRecipeService_GetByID_Call {
+	return &MockIFoodRecipeService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
 }
 
-func (_c *MockIRepository_GetByID_Call) Run(run func(id string)) *MockIRepository_GetByID_Call {
+func (_c *MockIFoodRecipeService_GetByID_Call) Run(run func(id int)) *MockIFoodRecipeService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int)
 		}
 		run(
 			arg0,
@@ -155,111 +3694,177 @@ func (_c *MockIRepository_GetByID_Call) Run(run func(id string)) *MockIRepositor
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) Return(user model.User, err error) *MockIRepository_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIFoodRecipeService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id string) (model.User, error)) *MockIRepository_GetByID_Call {
+func (_c *MockIFoodRecipeService_GetByID_Call) RunAndReturn(run func(id int) (model.FoodRecipe, error)) *MockIFoodRecipeService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetRecipes(userID string) (model.FoodRecipes, error) {
-	ret := _mock.Called(userID)
+// GetByIDWithRating provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetByIDWithRating(id int, ratingPriorWeight float64) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, ratingPriorWeight)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for GetByIDWithRating")
 	}
 
-	var r0 model.FoodRecipes
+	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(int, float64) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, ratingPriorWeight)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipes); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(int, float64) model.FoodRecipe); ok {
+		r0 = returnFunc(id, ratingPriorWeight)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(int, float64) error); ok {
+		r1 = returnFunc(id, ratingPriorWeight)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRepository_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIRepository_GetRecipes_Call struct {
+// MockIFoodRecipeService_GetByIDWithRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithRating'
+type MockIFoodRecipeService_GetByIDWithRating_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
-//   - userID string
-func (_e *MockIRepository_Expecter) GetRecipes(userID interface{}) *MockIRepository_GetRecipes_Call {
-	return &MockIRepository_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID)}
+// GetByIDWithRating is a helper method to define mock.On call
+//   - id int
+//   - ratingPriorWeight float64
+func (_e *MockIFoodRecipeService_Expecter) GetByIDWithRating(id interface{}, ratingPriorWeight interface{}) *MockIFoodRecipeService_GetByIDWithRating_Call {
+	return &MockIFoodRecipeService_GetByIDWithRating_Call{Call: _e.mock.On("GetByIDWithRating", id, ratingPriorWeight)}
 }
 
-func (_c *MockIRepository_GetRecipes_Call) Run(run func(userID string)) *MockIRepository_GetRecipes_Call {
+func (_c *MockIFoodRecipeService_GetByIDWithRating_Call) Run(run func(id int, ratingPriorWeight float64)) *MockIFoodRecipeService_GetByIDWithRating_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int)
+		}
+		var arg1 float64
+		if args[1] != nil {
+			arg1 = args[1].(float64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetRecipes_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIFoodRecipeService_GetByIDWithRating_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_GetByIDWithRating_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIRepository_GetRecipes_Call) RunAndReturn(run func(userID string) (model.FoodRecipes, error)) *MockIRepository_GetRecipes_Call {
+func (_c *MockIFoodRecipeService_GetByIDWithRating_Call) RunAndReturn(run func(id int, ratingPriorWeight float64) (model.FoodRecipe, error)) *MockIFoodRecipeService_GetByIDWithRating_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Upsert provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Upsert(user *model.User) error {
-	ret := _mock.Called(user)
+// GetCacheValidator provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetCacheValidator() (model.CacheValidator, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Upsert")
+		panic("no return value specified for GetCacheValidator")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
-		r0 = returnFunc(user)
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (model.CacheValidator, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() model.CacheValidator); ok {
+		r0 = returnFunc()
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.CacheValidator)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
-type MockIRepository_Upsert_Call struct {
+// MockIFoodRecipeService_GetCacheValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidator'
+type MockIFoodRecipeService_GetCacheValidator_Call struct {
 	*mock.Call
 }
 
-// Upsert is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockIRepository_Expecter) Upsert(user interface{}) *MockIRepository_Upsert_Call {
-	return &MockIRepository_Upsert_Call{Call: _e.mock.On("Upsert", user)}
+// GetCacheValidator is a helper method to define mock.On call
+func (_e *MockIFoodRecipeService_Expecter) GetCacheValidator() *MockIFoodRecipeService_GetCacheValidator_Call {
+	return &MockIFoodRecipeService_GetCacheValidator_Call{Call: _e.mock.On("GetCacheValidator")}
 }
 
-func (_c *MockIRepository_Upsert_Call) Run(run func(user *model.User)) *MockIRepository_Upsert_Call {
+func (_c *MockIFoodRecipeService_GetCacheValidator_Call) Run(run func()) *MockIFoodRecipeService_GetCacheValidator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidator_Call) Return(cacheValidator model.CacheValidator, err error) *MockIFoodRecipeService_GetCacheValidator_Call {
+	_c.Call.Return(cacheValidator, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidator_Call) RunAndReturn(run func() (model.CacheValidator, error)) *MockIFoodRecipeService_GetCacheValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCacheValidatorByID provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetCacheValidatorByID(id int) (model.CacheValidator, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheValidatorByID")
+	}
+
+	var r0 model.CacheValidator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.CacheValidator, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.CacheValidator); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.CacheValidator)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetCacheValidatorByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheValidatorByID'
+type MockIFoodRecipeService_GetCacheValidatorByID_Call struct {
+	*mock.Call
+}
+
+// GetCacheValidatorByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIFoodRecipeService_Expecter) GetCacheValidatorByID(id interface{}) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	return &MockIFoodRecipeService_GetCacheValidatorByID_Call{Call: _e.mock.On("GetCacheValidatorByID", id)}
+}
+
+func (_c *MockIFoodRecipeService_GetCacheValidatorByID_Call) Run(run func(id int)) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(int)
 		}
 		run(
 			arg0,
@@ -268,227 +3873,298 @@ func (_c *MockIRepository_Upsert_Call) Run(run func(user *model.User)) *MockIRep
 	return _c
 }
 
-func (_c *MockIRepository_Upsert_Call) Return(err error) *MockIRepository_Upsert_Call {
-	_c.Call.Return(err)
+func (_c *MockIFoodRecipeService_GetCacheValidatorByID_Call) Return(cacheValidator model.CacheValidator, err error) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
+	_c.Call.Return(cacheValidator, err)
 	return _c
 }
 
-func (_c *MockIRepository_Upsert_Call) RunAndReturn(run func(user *model.User) error) *MockIRepository_Upsert_Call {
+func (_c *MockIFoodRecipeService_GetCacheValidatorByID_Call) RunAndReturn(run func(id int) (model.CacheValidator, error)) *MockIFoodRecipeService_GetCacheValidatorByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIService {
-	mock := &MockIService{}
-	mock.Mock.Test(t)
+// GetTrending provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetTrending(query model.TrendingQuery) (model.FoodRecipes, error) {
+	ret := _mock.Called(query)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrending")
+	}
 
-	return mock
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) (model.FoodRecipes, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.TrendingQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.TrendingQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIService is an autogenerated mock type for the IService type
-type MockIService struct {
-	mock.Mock
+// MockIFoodRecipeService_GetTrending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrending'
+type MockIFoodRecipeService_GetTrending_Call struct {
+	*mock.Call
 }
 
-type MockIService_Expecter struct {
-	mock *mock.Mock
+// GetTrending is a helper method to define mock.On call
+//   - query model.TrendingQuery
+func (_e *MockIFoodRecipeService_Expecter) GetTrending(query interface{}) *MockIFoodRecipeService_GetTrending_Call {
+	return &MockIFoodRecipeService_GetTrending_Call{Call: _e.mock.On("GetTrending", query)}
 }
 
-func (_m *MockIService) EXPECT() *MockIService_Expecter {
-	return &MockIService_Expecter{mock: &_m.Mock}
+func (_c *MockIFoodRecipeService_GetTrending_Call) Run(run func(query model.TrendingQuery)) *MockIFoodRecipeService_GetTrending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.TrendingQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.TrendingQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
 }
 
-// GetByID provides a mock function for the type MockIService
-func (_mock *MockIService) GetByID(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+func (_c *MockIFoodRecipeService_GetTrending_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIFoodRecipeService_GetTrending_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetTrending_Call) RunAndReturn(run func(query model.TrendingQuery) (model.FoodRecipes, error)) *MockIFoodRecipeService_GetTrending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Patch(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(patch, id, ifMatch, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Patch")
 	}
 
-	var r0 model.User
+	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func([]byte, int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(patch, id, ifMatch, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func([]byte, int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(patch, id, ifMatch, claims)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func([]byte, int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(patch, id, ifMatch, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIService_GetByID_Call struct {
+// MockIFoodRecipeService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIFoodRecipeService_Patch_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Patch is a helper method to define mock.On call
+//   - patch []byte
+//   - id int
+//   - ifMatch model.IfMatch
 //   - claims model.Claims
-func (_e *MockIService_Expecter) GetByID(claims interface{}) *MockIService_GetByID_Call {
-	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+func (_e *MockIFoodRecipeService_Expecter) Patch(patch interface{}, id interface{}, ifMatch interface{}, claims interface{}) *MockIFoodRecipeService_Patch_Call {
+	return &MockIFoodRecipeService_Patch_Call{Call: _e.mock.On("Patch", patch, id, ifMatch, claims)}
 }
 
-func (_c *MockIService_GetByID_Call) Run(run func(claims model.Claims)) *MockIService_GetByID_Call {
+func (_c *MockIFoodRecipeService_Patch_Call) Run(run func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims)) *MockIFoodRecipeService_Patch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 []byte
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].([]byte)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.IfMatch
+		if args[2] != nil {
+			arg2 = args[2].(model.IfMatch)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) Return(user model.User, err error) *MockIService_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIFoodRecipeService_Patch_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Patch_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIService_GetByID_Call {
+func (_c *MockIFoodRecipeService_Patch_Call) RunAndReturn(run func(patch []byte, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Patch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function for the type MockIService
-func (_mock *MockIService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
-	ret := _mock.Called(userID, claims)
+// Update provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Update(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, id, ifMatch, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for Update")
 	}
 
-	var r0 model.FoodRecipes
+	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, id, ifMatch, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
-		r0 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, id, ifMatch, claims)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
-		r1 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, int, model.IfMatch, model.Claims) error); ok {
+		r1 = returnFunc(request, id, ifMatch, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIService_GetRecipes_Call struct {
+// MockIFoodRecipeService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIFoodRecipeService_Update_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
-//   - userID string
+// Update is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - id int
+//   - ifMatch model.IfMatch
 //   - claims model.Claims
-func (_e *MockIService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIService_GetRecipes_Call {
-	return &MockIService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+func (_e *MockIFoodRecipeService_Expecter) Update(request interface{}, id interface{}, ifMatch interface{}, claims interface{}) *MockIFoodRecipeService_Update_Call {
+	return &MockIFoodRecipeService_Update_Call{Call: _e.mock.On("Update", request, id, ifMatch, claims)}
 }
 
-func (_c *MockIService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIService_GetRecipes_Call {
+func (_c *MockIFoodRecipeService_Update_Call) Run(run func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims)) *MockIFoodRecipeService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 dto.FoodRecipeRequest
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(dto.FoodRecipeRequest)
 		}
-		var arg1 model.Claims
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
+			arg1 = args[1].(int)
+		}
+		var arg2 model.IfMatch
+		if args[2] != nil {
+			arg2 = args[2].(model.IfMatch)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetRecipes_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIFoodRecipeService_Update_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIService_GetRecipes_Call {
+func (_c *MockIFoodRecipeService_Update_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, id int, ifMatch model.IfMatch, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpsertWithClaims provides a mock function for the type MockIService
-func (_mock *MockIService) UpsertWithClaims(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+// WithFavorites provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) WithFavorites(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(recipes, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpsertWithClaims")
+		panic("no return value specified for WithFavorites")
 	}
 
-	var r0 model.User
+	var r0 model.FoodRecipes
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipes, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(recipes, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipes, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(recipes, claims)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipes, model.Claims) error); ok {
+		r1 = returnFunc(recipes, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
-type MockIService_UpsertWithClaims_Call struct {
+// MockIFoodRecipeService_WithFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithFavorites'
+type MockIFoodRecipeService_WithFavorites_Call struct {
 	*mock.Call
 }
 
-// UpsertWithClaims is a helper method to define mock.On call
+// WithFavorites is a helper method to define mock.On call
+//   - recipes model.FoodRecipes
 //   - claims model.Claims
-func (_e *MockIService_Expecter) UpsertWithClaims(claims interface{}) *MockIService_UpsertWithClaims_Call {
-	return &MockIService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+func (_e *MockIFoodRecipeService_Expecter) WithFavorites(recipes interface{}, claims interface{}) *MockIFoodRecipeService_WithFavorites_Call {
+	return &MockIFoodRecipeService_WithFavorites_Call{Call: _e.mock.On("WithFavorites", recipes, claims)}
 }
 
-func (_c *MockIService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIService_UpsertWithClaims_Call {
+func (_c *MockIFoodRecipeService_WithFavorites_Call) Run(run func(recipes model.FoodRecipes, claims model.Claims)) *MockIFoodRecipeService_WithFavorites_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 model.FoodRecipes
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(model.FoodRecipes)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIService_UpsertWithClaims_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIFoodRecipeService_WithFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIFoodRecipeService_WithFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
 	return _c
 }
 
-func (_c *MockIService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIService_UpsertWithClaims_Call {
+func (_c *MockIFoodRecipeService_WithFavorites_Call) RunAndReturn(run func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error)) *MockIFoodRecipeService_WithFavorites_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,19 +1,51 @@
 package user
 
 import (
+	"time"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
+	"wongnok/internal/notification"
 
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type IRepository interface {
 	GetByID(id string) (model.User, error)
+	// Upsert และ Create คืน global.ErrHandleTaken ถ้าผู้อื่นได้ handle นี้ไปก่อนบันทึก
 	Upsert(user *model.User) error
+	// Restore ยกเลิกคำขอลบบัญชีที่ยังไม่ถูก purge ไม่มีคำขอก็ถือว่าสำเร็จ
+	Restore(userID string) error
 	// เพิ่มการส้ร้างและอัพเดท
 	Create(user *model.User) (model.User, error)
 	Update(user *model.User) (model.User, error)
 	GetRecipes(userID string) (model.FoodRecipes, error)
+	GetStats(userID string) (model.UserStats, error)
+	GetPublishedRecipes(userID string, query model.ProfileQuery) (model.FoodRecipes, error)
+	// Follow ติดตามซ้ำไม่ error ส่วน Unfollow ไม่ได้ติดตามอยู่ก็ถือว่าสำเร็จ
+	Follow(follow *model.Follow, notice *model.Notification) error
+	Unfollow(followerID string, followeeID string) error
+	IsFollowing(followerID string, followeeID string) (bool, error)
+	GetFollowers(userID string, query model.FollowQuery) (model.Users, int64, error)
+	GetFollowing(userID string, query model.FollowQuery) (model.Users, int64, error)
+	// Block ยกเลิกการติดตามของทั้งสองฝ่ายไปพร้อมกัน ส่วน Block ซ้ำ Unblock Mute และ Unmute เรียกซ้ำได้ผลเหมือนเดิม
+	Block(block *model.UserBlock) error
+	Unblock(blockerID string, blockedID string) error
+	// IsBlocked จริงเมื่อฝ่ายใดฝ่ายหนึ่ง block อีกฝ่าย
+	IsBlocked(userID string, otherID string) (bool, error)
+	GetBlocked(userID string, query model.FollowQuery) (model.Users, int64, error)
+	Mute(mute *model.UserMute) error
+	Unmute(muterID string, mutedID string) error
+	GetMuted(userID string, query model.FollowQuery) (model.Users, int64, error)
+	// GetByHandle ค้นจาก handle ปัจจุบันก่อน แล้วจึงค้นจาก handle เดิมที่ยังไม่หมดอายุ
+	GetByHandle(handle string) (model.User, error)
+	// IsHandleAvailable handle ว่างเมื่อไม่ใช่ของผู้ใช้อื่นและไม่ใช่ handle เดิมของผู้อื่นที่ยังไม่หมดอายุ
+	IsHandleAvailable(handle string, userID string) (bool, error)
+	// SetHandle เก็บ handle เดิมไว้ใน redirect ถ้ามี ผู้ใช้เอา handle เดิมของตัวเองกลับมาใช้ได้
+	// คืน global.ErrHandleTaken ถ้าผู้อื่นได้ handle นี้ไปก่อนบันทึก
+	SetHandle(userID string, handle string, redirect *model.HandleRedirect) error
 }
 
 type Repository struct {
@@ -37,7 +69,23 @@ func (repo Repository) GetByID(id string) (model.User, error) {
 }
 
 func (repo Repository) Upsert(user *model.User) error {
-	return repo.DB.Save(user).Error
+	err := repo.DB.Save(user).Error
+	if isUniqueViolation(err, "idx_users_handle") {
+		return global.ErrHandleTaken
+	}
+
+	return err
+}
+
+func (repo Repository) Restore(userID string) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&model.AccountDeletion{}, "user_id = ?", userID)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return tx.Unscoped().Model(&model.User{}).Where("id = ?", userID).Update("deleted_at", nil).Error
+	})
 }
 
 func (repo Repository) GetRecipes(userID string) (model.FoodRecipes, error) {
@@ -49,13 +97,18 @@ func (repo Repository) GetRecipes(userID string) (model.FoodRecipes, error) {
 
 	return recipes, nil
 }
+
 // การสร้างผู้ใช้
 func (repo Repository) Create(user *model.User) (model.User, error) {
 	if err := repo.DB.Create(user).Error; err != nil {
+		if isUniqueViolation(err, "idx_users_handle") {
+			return model.User{}, global.ErrHandleTaken
+		}
 		return model.User{}, err
 	}
 	return *user, nil
 }
+
 // การอัพเดทผู้ใช้
 func (repo Repository) Update(user *model.User) (model.User, error) {
 	if err := repo.DB.Save(user).Error; err != nil {
@@ -63,3 +116,226 @@ func (repo Repository) Update(user *model.User) (model.User, error) {
 	}
 	return *user, nil
 }
+
+// GetStats ยอดรวมของ recipe ที่ผู้ใช้เขียน ใช้ rating_count/rating_sum ที่เก็บไว้แล้วแทนการนับจาก ratings
+func (repo Repository) GetStats(userID string) (model.UserStats, error) {
+	var stats model.UserStats
+
+	err := repo.DB.Raw(`
+		SELECT
+			COUNT(*) AS recipe_count,
+			COALESCE(SUM(rating_count), 0) AS rating_count,
+			COALESCE(SUM(rating_sum), 0) AS rating_sum,
+			(
+				SELECT COUNT(*)
+				FROM favorites
+				JOIN food_recipes ON food_recipes.id = favorites.food_recipe_id AND food_recipes.deleted_at IS NULL
+				WHERE favorites.deleted_at IS NULL AND food_recipes.user_id = ?
+			) AS favorites_received,
+			(
+				SELECT COUNT(*)
+				FROM follows
+				JOIN users ON users.id = follows.follower_id AND users.deleted_at IS NULL
+				WHERE follows.followee_id = ?
+			) AS follower_count,
+			(
+				SELECT COUNT(*)
+				FROM follows
+				JOIN users ON users.id = follows.followee_id AND users.deleted_at IS NULL
+				WHERE follows.follower_id = ?
+			) AS following_count
+		FROM food_recipes
+		WHERE deleted_at IS NULL AND user_id = ?`, userID, userID, userID, userID).Scan(&stats).Error
+	if err != nil {
+		return model.UserStats{}, err
+	}
+
+	return stats, nil
+}
+
+// GetPublishedRecipes recipe ใหม่สุดก่อน
+func (repo Repository) GetPublishedRecipes(userID string, query model.ProfileQuery) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	offset := (query.Page - 1) * query.Limit
+
+	if err := repo.DB.Preload(clause.Associations).
+		Where("user_id = ?", userID).
+		Order("created_at desc, id desc").
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&recipes).Error; err != nil {
+		return model.FoodRecipes{}, err
+	}
+
+	return recipes, nil
+}
+
+// Follow แจ้งเตือนเฉพาะครั้งที่สร้างแถวใหม่
+func (repo Repository) Follow(follow *model.Follow, notice *model.Notification) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return notification.Notify(tx, notice)
+	})
+}
+
+func (repo Repository) Unfollow(followerID string, followeeID string) error {
+	return repo.DB.Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Delete(&model.Follow{}).Error
+}
+
+func (repo Repository) IsFollowing(followerID string, followeeID string) (bool, error) {
+	var count int64
+
+	if err := repo.DB.Model(&model.Follow{}).Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetFollowers คนที่ติดตาม userID ติดตามล่าสุดก่อน
+func (repo Repository) GetFollowers(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	return repo.listUsers("follows", "follows.follower_id", "follows.followee_id", userID, query)
+}
+
+// GetFollowing คนที่ userID ติดตาม ติดตามล่าสุดก่อน
+func (repo Repository) GetFollowing(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	return repo.listUsers("follows", "follows.followee_id", "follows.follower_id", userID, query)
+}
+
+func (repo Repository) Block(block *model.UserBlock) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(block).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("(follower_id = ? AND followee_id = ?) OR (follower_id = ? AND followee_id = ?)",
+			block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).Delete(&model.Follow{}).Error
+	})
+}
+
+func (repo Repository) Unblock(blockerID string, blockedID string) error {
+	return repo.DB.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Delete(&model.UserBlock{}).Error
+}
+
+func (repo Repository) IsBlocked(userID string, otherID string) (bool, error) {
+	var count int64
+
+	if err := repo.DB.Model(&model.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetBlocked คนที่ userID block ไว้ block ล่าสุดก่อน
+func (repo Repository) GetBlocked(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	return repo.listUsers("user_blocks", "user_blocks.blocked_id", "user_blocks.blocker_id", userID, query)
+}
+
+func (repo Repository) Mute(mute *model.UserMute) error {
+	return repo.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(mute).Error
+}
+
+func (repo Repository) Unmute(muterID string, mutedID string) error {
+	return repo.DB.Where("muter_id = ? AND muted_id = ?", muterID, mutedID).Delete(&model.UserMute{}).Error
+}
+
+// GetMuted คนที่ userID mute ไว้ mute ล่าสุดก่อน
+func (repo Repository) GetMuted(userID string, query model.FollowQuery) (model.Users, int64, error) {
+	return repo.listUsers("user_mutes", "user_mutes.muted_id", "user_mutes.muter_id", userID, query)
+}
+
+// listUsers ผู้ใช้ที่อยู่ใน column listed ของแถวใน table ที่ column owner เป็น userID ไม่รวมผู้ใช้ที่ถูกลบ
+func (repo Repository) listUsers(table string, listed string, owner string, userID string, query model.FollowQuery) (model.Users, int64, error) {
+	var users = make(model.Users, 0)
+	var total int64
+
+	rows := func() *gorm.DB {
+		return repo.DB.Model(&model.User{}).
+			Joins("JOIN "+table+" ON users.id = "+listed).
+			Where(owner+" = ? AND users.deleted_at IS NULL", userID)
+	}
+
+	if err := rows().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (query.Page - 1) * query.Limit
+
+	if err := rows().Order(table + ".created_at desc, users.id asc").Limit(query.Limit).Offset(offset).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (repo Repository) GetByHandle(handle string) (model.User, error) {
+	var user model.User
+
+	err := repo.DB.First(&user, "lower(handle) = ?", handle).Error
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, err
+	}
+
+	err = repo.DB.
+		Joins("JOIN handle_redirects ON handle_redirects.user_id = users.id").
+		Where("handle_redirects.handle = ? AND handle_redirects.expires_at > ?", handle, time.Now()).
+		First(&user).Error
+
+	return user, err
+}
+
+// IsHandleAvailable รวมผู้ใช้ที่ถูก soft delete เพราะ unique index ก็รวมด้วย
+func (repo Repository) IsHandleAvailable(handle string, userID string) (bool, error) {
+	var count int64
+
+	if err := repo.DB.Unscoped().Model(&model.User{}).Where("lower(handle) = ? AND id <> ?", handle, userID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	if err := repo.DB.Model(&model.HandleRedirect{}).
+		Where("handle = ? AND user_id <> ? AND expires_at > ?", handle, userID, time.Now()).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count == 0, nil
+}
+
+func (repo Repository) SetHandle(userID string, handle string, redirect *model.HandleRedirect) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		// เหลือแค่ redirect ของตัวเองหรือที่หมดอายุแล้ว ตามที่ IsHandleAvailable ตรวจไว้
+		if err := tx.Where("handle = ?", model.NormalizeHandle(handle)).Delete(&model.HandleRedirect{}).Error; err != nil {
+			return err
+		}
+
+		if redirect != nil {
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(redirect).Error; err != nil {
+				return err
+			}
+		}
+
+		err := tx.Model(&model.User{}).Where("id = ?", userID).Update("handle", handle).Error
+		// ผู้อื่นได้ handle นี้ไปหลัง IsHandleAvailable
+		if isUniqueViolation(err, "idx_users_handle") {
+			return global.ErrHandleTaken
+		}
+
+		return err
+	})
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
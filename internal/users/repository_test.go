@@ -6,8 +6,9 @@ import (
 	"reflect"
 	"testing"
 	"time"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
-	"wongnok/internal/user"
+	user "wongnok/internal/users"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -207,19 +208,12 @@ func (suite *RepositoryGetRecipesTestSuite) TestGetRecipesReturnFoodRecipes() {
 				Model: gorm.Model{ID: 1, CreatedAt: mockTime, UpdatedAt: mockTime},
 				Name:  "Easy",
 			},
-			Ratings: model.Ratings{
-				model.Rating{
-					Model:        gorm.Model{ID: 1, CreatedAt: mockTime, UpdatedAt: mockTime},
-					Score:        5,
-					FoodRecipeID: 1,
-					UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
-				},
-				model.Rating{
-					Model:        gorm.Model{ID: 2, CreatedAt: mockTime, UpdatedAt: mockTime},
-					Score:        3,
-					FoodRecipeID: 1,
-					UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
-				},
+			RatingSummary: model.RatingSummary{
+				RatingCount:     2,
+				RatingSum:       8,
+				RatingStar3:     1,
+				RatingStar5:     1,
+				RatingUpdatedAt: &mockTime,
 			},
 			AverageRating: 0,
 			UserID:        "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
@@ -241,10 +235,7 @@ func (suite *RepositoryGetRecipesTestSuite) TestGetRecipesReturnFoodRecipes() {
 		foodRecipes[i].CookingDuration.UpdatedAt = mockTime
 		foodRecipes[i].Difficulty.CreatedAt = mockTime
 		foodRecipes[i].Difficulty.UpdatedAt = mockTime
-		foodRecipes[i].Ratings[0].CreatedAt = mockTime
-		foodRecipes[i].Ratings[0].UpdatedAt = mockTime
-		foodRecipes[i].Ratings[1].CreatedAt = mockTime
-		foodRecipes[i].Ratings[1].UpdatedAt = mockTime
+		foodRecipes[i].RatingUpdatedAt = &mockTime
 		foodRecipes[i].User.CreatedAt = mockTime
 		foodRecipes[i].User.UpdatedAt = mockTime
 	}
@@ -255,3 +246,357 @@ func (suite *RepositoryGetRecipesTestSuite) TestGetRecipesReturnFoodRecipes() {
 func TestRepositoryGetRecipes(t *testing.T) {
 	suite.Run(t, new(RepositoryGetRecipesTestSuite))
 }
+
+// Extend
+type RepositoryGetStatsTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryGetStatsTestSuite) TestSumRecipesOfUser() {
+	stats, err := suite.repo.GetStats("38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	suite.NoError(err)
+
+	suite.Equal(model.UserStats{RecipeCount: 1, RatingCount: 2, RatingSum: 8}, stats)
+}
+
+func (suite *RepositoryGetStatsTestSuite) TestCountFavoritesReceived() {
+	suite.NoError(suite.db.Exec(
+		"INSERT INTO favorites (user_id, food_recipe_id, created_at, updated_at) VALUES (?, 1, NOW(), NOW())",
+		"b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11",
+	).Error)
+	defer suite.db.Exec("DELETE FROM favorites")
+
+	stats, err := suite.repo.GetStats("38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	suite.NoError(err)
+
+	suite.Equal(int64(1), stats.FavoritesReceived)
+}
+
+func (suite *RepositoryGetStatsTestSuite) TestZeroWhenNoRecipe() {
+	stats, err := suite.repo.GetStats("b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11")
+	suite.NoError(err)
+
+	suite.Equal(model.UserStats{}, stats)
+}
+
+func TestRepositoryGetStats(t *testing.T) {
+	suite.Run(t, new(RepositoryGetStatsTestSuite))
+}
+
+// Extend
+type RepositoryGetPublishedRecipesTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryGetPublishedRecipesTestSuite) TestReturnRecipesOfPage() {
+	recipes, err := suite.repo.GetPublishedRecipes("38fa4e9e-27de-42d5-a70f-9f01d41f32c2", model.ProfileQuery{Page: 1, Limit: 20})
+	suite.NoError(err)
+
+	suite.Len(recipes, 1)
+	suite.Equal(uint(1), recipes[0].ID)
+	suite.Equal("Demo", recipes[0].User.FirstName)
+}
+
+func (suite *RepositoryGetPublishedRecipesTestSuite) TestReturnEmptyAfterLastPage() {
+	recipes, err := suite.repo.GetPublishedRecipes("38fa4e9e-27de-42d5-a70f-9f01d41f32c2", model.ProfileQuery{Page: 2, Limit: 20})
+	suite.NoError(err)
+
+	suite.Empty(recipes)
+}
+
+func TestRepositoryGetPublishedRecipes(t *testing.T) {
+	suite.Run(t, new(RepositoryGetPublishedRecipesTestSuite))
+}
+
+// Extend
+type RepositoryFollowTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryFollowTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM follows")
+	suite.RepositoryTestSuite.TearDownTest()
+}
+
+func (suite *RepositoryFollowTestSuite) TestFollowTwiceKeepOneRow() {
+	follower := "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+	followee := "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+	suite.NoError(suite.repo.Follow(&model.Follow{FollowerID: follower, FolloweeID: followee}, nil))
+	suite.NoError(suite.repo.Follow(&model.Follow{FollowerID: follower, FolloweeID: followee}, nil))
+
+	isFollowing, err := suite.repo.IsFollowing(follower, followee)
+	suite.NoError(err)
+	suite.True(isFollowing)
+
+	followers, total, err := suite.repo.GetFollowers(followee, model.FollowQuery{Page: 1, Limit: 20})
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Len(followers, 1)
+	suite.Equal(follower, followers[0].ID)
+
+	following, total, err := suite.repo.GetFollowing(follower, model.FollowQuery{Page: 1, Limit: 20})
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Equal(followee, following[0].ID)
+
+	stats, err := suite.repo.GetStats(followee)
+	suite.NoError(err)
+	suite.Equal(int64(1), stats.FollowerCount)
+	suite.Equal(int64(0), stats.FollowingCount)
+}
+
+func (suite *RepositoryFollowTestSuite) TestUnfollow() {
+	follower := "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+	followee := "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+	suite.NoError(suite.repo.Follow(&model.Follow{FollowerID: follower, FolloweeID: followee}, nil))
+	suite.NoError(suite.repo.Unfollow(follower, followee))
+	suite.NoError(suite.repo.Unfollow(follower, followee))
+
+	isFollowing, err := suite.repo.IsFollowing(follower, followee)
+	suite.NoError(err)
+	suite.False(isFollowing)
+}
+
+func (suite *RepositoryFollowTestSuite) TestErrorWhenFollowSelf() {
+	err := suite.repo.Follow(&model.Follow{FollowerID: "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11", FolloweeID: "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"}, nil)
+
+	suite.Error(err)
+}
+
+func TestRepositoryFollow(t *testing.T) {
+	suite.Run(t, new(RepositoryFollowTestSuite))
+}
+
+// Extend
+type RepositoryBlockTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryBlockTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM follows")
+	suite.db.Exec("DELETE FROM user_blocks")
+	suite.db.Exec("DELETE FROM user_mutes")
+	suite.RepositoryTestSuite.TearDownTest()
+}
+
+func (suite *RepositoryBlockTestSuite) TestBlockRemoveFollowsBothWays() {
+	blocker := "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	blocked := "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+
+	suite.NoError(suite.repo.Follow(&model.Follow{FollowerID: blocker, FolloweeID: blocked}, nil))
+	suite.NoError(suite.repo.Follow(&model.Follow{FollowerID: blocked, FolloweeID: blocker}, nil))
+
+	suite.NoError(suite.repo.Block(&model.UserBlock{BlockerID: blocker, BlockedID: blocked}))
+	suite.NoError(suite.repo.Block(&model.UserBlock{BlockerID: blocker, BlockedID: blocked}))
+
+	var count int64
+	suite.NoError(suite.db.Model(&model.Follow{}).Count(&count).Error)
+	suite.Equal(int64(0), count)
+
+	// ตรวจได้จากทั้งสองฝ่าย
+	isBlocked, err := suite.repo.IsBlocked(blocked, blocker)
+	suite.NoError(err)
+	suite.True(isBlocked)
+
+	users, total, err := suite.repo.GetBlocked(blocker, model.FollowQuery{Page: 1, Limit: 20})
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Equal(blocked, users[0].ID)
+
+	suite.NoError(suite.repo.Unblock(blocker, blocked))
+
+	isBlocked, err = suite.repo.IsBlocked(blocker, blocked)
+	suite.NoError(err)
+	suite.False(isBlocked)
+}
+
+func (suite *RepositoryBlockTestSuite) TestMuteKeepFollows() {
+	muter := "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	muted := "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+
+	suite.NoError(suite.repo.Follow(&model.Follow{FollowerID: muter, FolloweeID: muted}, nil))
+	suite.NoError(suite.repo.Mute(&model.UserMute{MuterID: muter, MutedID: muted}))
+	suite.NoError(suite.repo.Mute(&model.UserMute{MuterID: muter, MutedID: muted}))
+
+	isFollowing, err := suite.repo.IsFollowing(muter, muted)
+	suite.NoError(err)
+	suite.True(isFollowing)
+
+	users, total, err := suite.repo.GetMuted(muter, model.FollowQuery{Page: 1, Limit: 20})
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Equal(muted, users[0].ID)
+
+	// mute ไม่ใช่ block
+	isBlocked, err := suite.repo.IsBlocked(muter, muted)
+	suite.NoError(err)
+	suite.False(isBlocked)
+
+	suite.NoError(suite.repo.Unmute(muter, muted))
+
+	_, total, err = suite.repo.GetMuted(muter, model.FollowQuery{Page: 1, Limit: 20})
+	suite.NoError(err)
+	suite.Equal(int64(0), total)
+}
+
+func TestRepositoryBlock(t *testing.T) {
+	suite.Run(t, new(RepositoryBlockTestSuite))
+}
+
+type RepositoryRestoreTestSuite struct {
+	RepositoryTestSuite
+}
+
+const deletedUserID = "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+
+func (suite *RepositoryRestoreTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM account_deletions")
+	suite.db.Exec("UPDATE users SET deleted_at = NULL")
+	suite.RepositoryTestSuite.TearDownTest()
+}
+
+func (suite *RepositoryRestoreTestSuite) TestRestorePendingDeletion() {
+	suite.NoError(suite.db.Create(&model.AccountDeletion{UserID: deletedUserID, Recipes: model.RecipesDelete, PurgeAt: time.Now().Add(time.Hour)}).Error)
+	suite.NoError(suite.db.Delete(&model.User{}, "id = ?", deletedUserID).Error)
+
+	_, err := suite.repo.GetByID(deletedUserID)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.NoError(suite.repo.Restore(deletedUserID))
+
+	user, err := suite.repo.GetByID(deletedUserID)
+	suite.NoError(err)
+	suite.False(user.DeletedAt.Valid)
+}
+
+func (suite *RepositoryRestoreTestSuite) TestKeepDeletedWithoutRequest() {
+	suite.NoError(suite.db.Delete(&model.User{}, "id = ?", deletedUserID).Error)
+
+	suite.NoError(suite.repo.Restore(deletedUserID))
+
+	_, err := suite.repo.GetByID(deletedUserID)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryRestore(t *testing.T) {
+	suite.Run(t, new(RepositoryRestoreTestSuite))
+}
+
+type RepositoryHandleTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryHandleTestSuite) TearDownTest() {
+	suite.db.Exec("DELETE FROM handle_redirects")
+	suite.db.Exec("UPDATE users SET handle = NULL")
+	suite.RepositoryTestSuite.TearDownTest()
+}
+
+const (
+	handleOwnerID = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	handleOtherID = "b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11"
+)
+
+func (suite *RepositoryHandleTestSuite) TestGetByHandleCaseInsensitive() {
+	suite.NoError(suite.repo.SetHandle(handleOwnerID, "Chef_Demo", nil))
+
+	user, err := suite.repo.GetByHandle("chef_demo")
+	suite.NoError(err)
+	suite.Equal(handleOwnerID, user.ID)
+	suite.Equal("Chef_Demo", *user.Handle)
+
+	available, err := suite.repo.IsHandleAvailable("chef_demo", handleOtherID)
+	suite.NoError(err)
+	suite.False(available)
+
+	available, err = suite.repo.IsHandleAvailable("chef_demo", handleOwnerID)
+	suite.NoError(err)
+	suite.True(available)
+
+	err = suite.db.Model(&model.User{}).Where("id = ?", handleOtherID).Update("handle", "CHEF_DEMO").Error
+	suite.Error(err)
+}
+
+func (suite *RepositoryHandleTestSuite) TestOldHandleRedirectsUntilExpired() {
+	suite.NoError(suite.repo.SetHandle(handleOwnerID, "demo_tester", nil))
+	suite.NoError(suite.repo.SetHandle(handleOwnerID, "chef_demo", &model.HandleRedirect{
+		Handle:    "demo_tester",
+		UserID:    handleOwnerID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	user, err := suite.repo.GetByHandle("demo_tester")
+	suite.NoError(err)
+	suite.Equal(handleOwnerID, user.ID)
+
+	available, err := suite.repo.IsHandleAvailable("demo_tester", handleOtherID)
+	suite.NoError(err)
+	suite.False(available)
+
+	suite.NoError(suite.db.Model(&model.HandleRedirect{}).Where("handle = ?", "demo_tester").Update("expires_at", time.Now().Add(-time.Minute)).Error)
+
+	_, err = suite.repo.GetByHandle("demo_tester")
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	available, err = suite.repo.IsHandleAvailable("demo_tester", handleOtherID)
+	suite.NoError(err)
+	suite.True(available)
+}
+
+func (suite *RepositoryHandleTestSuite) TestReclaimOwnOldHandle() {
+	suite.NoError(suite.repo.SetHandle(handleOwnerID, "chef_demo", &model.HandleRedirect{
+		Handle:    "demo_tester",
+		UserID:    handleOwnerID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	suite.NoError(suite.repo.SetHandle(handleOwnerID, "Demo_Tester", &model.HandleRedirect{
+		Handle:    "chef_demo",
+		UserID:    handleOwnerID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	var redirects []model.HandleRedirect
+	suite.NoError(suite.db.Find(&redirects).Error)
+	suite.Equal(1, len(redirects))
+	suite.Equal("chef_demo", redirects[0].Handle)
+
+	user, err := suite.repo.GetByHandle("demo_tester")
+	suite.NoError(err)
+	suite.Equal("Demo_Tester", *user.Handle)
+}
+
+func (suite *RepositoryHandleTestSuite) TestErrorWhenHandleTakenConcurrently() {
+	// อีกคนได้ handle ไประหว่างที่ตรวจว่าว่างกับตอนบันทึก
+	suite.NoError(suite.repo.SetHandle(handleOtherID, "chef_demo", nil))
+
+	err := suite.repo.SetHandle(handleOwnerID, "Chef_Demo", &model.HandleRedirect{
+		Handle:    "demo_tester",
+		UserID:    handleOwnerID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	suite.ErrorIs(err, global.ErrHandleTaken)
+
+	// transaction ถูก rollback จึงไม่มี redirect ค้าง
+	var count int64
+	suite.NoError(suite.db.Model(&model.HandleRedirect{}).Count(&count).Error)
+	suite.Zero(count)
+}
+
+func (suite *RepositoryHandleTestSuite) TestUpsertErrorWhenHandleTakenConcurrently() {
+	suite.NoError(suite.repo.SetHandle(handleOtherID, "chef_demo", nil))
+
+	user, err := suite.repo.GetByID(handleOwnerID)
+	suite.NoError(err)
+
+	handle := "Chef_Demo"
+	user.Handle = &handle
+
+	suite.ErrorIs(suite.repo.Upsert(&user), global.ErrHandleTaken)
+}
+
+func TestRepositoryHandle(t *testing.T) {
+	suite.Run(t, new(RepositoryHandleTestSuite))
+}
@@ -1,8 +1,14 @@
 package user
 
 import (
+	"slices"
 	"strings"
+	"time"
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
 	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
@@ -16,15 +22,37 @@ type IService interface {
 	// เพิ่มการสร้างและอัพเดท
 	Create(claims model.Claims) (model.User, error)
 	Update(user *model.User) (model.User, error)
+	Patch(patch []byte, claims model.Claims) (model.User, error)
+	GetProfile(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error)
+	// Follow และ Unfollow เรียกซ้ำได้ผลเหมือนเดิม
+	Follow(userID string, claims model.Claims) error
+	Unfollow(userID string, claims model.Claims) error
+	GetFollowers(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)
+	GetFollowing(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error)
+	// Block Unblock Mute และ Unmute เรียกซ้ำได้ผลเหมือนเดิม
+	Block(userID string, claims model.Claims) error
+	Unblock(userID string, claims model.Claims) error
+	GetBlocked(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)
+	Mute(userID string, claims model.Claims) error
+	Unmute(userID string, claims model.Claims) error
+	GetMuted(query model.FollowQuery, claims model.Claims) (model.Users, int64, error)
+	// CheckBlocked คืน global.ErrBlocked เมื่อฝ่ายใดฝ่ายหนึ่ง block อีกฝ่าย service อื่นใช้ตรวจก่อนโต้ตอบกับเนื้อหาของผู้ใช้
+	CheckBlocked(userID string, otherID string) error
+	// ChangeHandle handle เดิมยังพามาหาผู้ใช้ได้อีก model.HandleRedirectPeriod
+	ChangeHandle(request dto.HandleRequest, claims model.Claims) (model.User, error)
 }
 
+type IFoodRecipeService foodrecipe.IService
+
 type Service struct {
-	Repository IRepository
+	Repository        IRepository
+	FoodRecipeService IFoodRecipeService
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
-		Repository: NewRepository(db),
+		Repository:        NewRepository(db),
+		FoodRecipeService: foodrecipe.NewService(db),
 	}
 }
 
@@ -34,15 +62,29 @@ func (service Service) UpsertWithClaims(claims model.Claims) (model.User, error)
 		return model.User{}, errors.Wrap(err, "claims invalid")
 	}
 
+	// login ระหว่างช่วงผ่อนผันถือว่ายกเลิกการลบบัญชี
+	if err := service.Repository.Restore(claims.ID); err != nil {
+		return model.User{}, errors.Wrap(err, "restore user")
+	}
+
 	user, err := service.Repository.GetByID(claims.ID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return model.User{}, errors.Wrap(err, "find user")
 	}
 
-	// Set claims information to user model
-	user = user.FromClaims(claims)
+	// ค่าเริ่มต้นของชื่อเล่นและรูปใช้กับผู้ใช้ใหม่เท่านั้น ผู้ใช้เดิมอาจแก้ไขเองแล้ว
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		user = user.FromClaims(claims)
+	} else {
+		user = user.FromClaimsUpdate(claims)
+	}
 
-	if err := service.Repository.Upsert(&user); err != nil {
+	if user.Handle != nil {
+		err = service.Repository.Upsert(&user)
+	} else {
+		err = service.saveWithGeneratedHandle(&user, claims, service.Repository.Upsert)
+	}
+	if err != nil {
 		return model.User{}, errors.Wrap(err, "upsert user")
 	}
 
@@ -60,9 +102,9 @@ func (service Service) GetByID(claims model.Claims) (model.User, error) {
 }
 
 func (service Service) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
-
-	if strings.ToLower(userID) == "self" {
-		userID = claims.ID
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return model.FoodRecipes{}, err
 	}
 
 	if _, err := service.Repository.GetByID(claims.ID); err != nil {
@@ -74,10 +116,14 @@ func (service Service) GetRecipes(userID string, claims model.Claims) (model.Foo
 		return model.FoodRecipes{}, errors.Wrap(err, "get recipes")
 	}
 
-	foodRecipes = foodRecipes.CalculateAverageRatings()
+	foodRecipes, err = service.FoodRecipeService.WithFavorites(foodRecipes.CalculateAverageRatings(), claims)
+	if err != nil {
+		return model.FoodRecipes{}, err
+	}
 
 	return foodRecipes, nil
 }
+
 // การสร้างผู้ใช้
 func (service Service) Create(claims model.Claims) (model.User, error) {
 
@@ -87,11 +133,15 @@ func (service Service) Create(claims model.Claims) (model.User, error) {
 	}
 
 	if user == (model.User{}) {
-		users, err := service.Repository.Create(user.FromClaim(claims))
+		created := user.FromClaim(claims)
+		err := service.saveWithGeneratedHandle(created, claims, func(user *model.User) error {
+			_, err := service.Repository.Create(user)
+			return err
+		})
 		if err != nil {
 			return model.User{}, err
 		}
-		return users, nil
+		return *created, nil
 	} else {
 		users, err := service.Repository.Update(user.FromClaimUpdate(claims))
 		if err != nil {
@@ -101,9 +151,24 @@ func (service Service) Create(claims model.Claims) (model.User, error) {
 	}
 
 }
+
 // การอัพเดทผู้ใช้
 func (service Service) Update(user *model.User) (model.User, error) {
 
+	// CreatedAt คือวันที่เข้าร่วม ห้ามหายไปเมื่อแก้ข้อมูลทั้งก้อน
+	existing, err := service.Repository.GetByID(user.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.User{}, errors.Wrap(err, "find user")
+	}
+	if err == nil {
+		user.CreatedAt = existing.CreatedAt
+		// handle เปลี่ยนผ่าน ChangeHandle เท่านั้น
+		user.Handle = existing.Handle
+		if user.Email == "" {
+			user.Email = existing.Email
+		}
+	}
+
 	users, err := service.Repository.Update(user)
 	if err != nil {
 		return model.User{}, err
@@ -111,3 +176,386 @@ func (service Service) Update(user *model.User) (model.User, error) {
 	return users, nil
 
 }
+
+// การแก้ไขผู้ใช้บางส่วนด้วย JSON Merge Patch
+func (service Service) Patch(patch []byte, claims model.Claims) (model.User, error) {
+	user, err := service.Repository.GetByID(claims.ID)
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "find user")
+	}
+
+	request := user.ToRequest()
+	if err := helper.MergePatch(&request, patch); err != nil {
+		return model.User{}, errors.Wrap(err, "request invalid")
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.User{}, errors.Wrap(err, "request invalid")
+	}
+
+	user.NickName = request.NickName
+	user.ImageUrl = &request.ImageUrl
+	user.Bio = request.Bio
+	user.ProfilePrivacy = model.ProfilePrivacy{}.FromRequest(request.Privacy)
+
+	updated, err := service.Repository.Update(&user)
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "update user")
+	}
+
+	return updated, nil
+}
+
+// GetProfile โปรไฟล์สาธารณะ การซ่อนช่องตาม privacy ทำตอนแปลงเป็น response
+func (service Service) GetProfile(userID string, query model.ProfileQuery, claims model.Claims) (model.Profile, error) {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return model.Profile{}, err
+	}
+
+	user, err := service.findActive(userID)
+	if err != nil {
+		return model.Profile{}, err
+	}
+
+	stats, err := service.Repository.GetStats(userID)
+	if err != nil {
+		return model.Profile{}, errors.Wrap(err, "get stats")
+	}
+
+	recipes, err := service.Repository.GetPublishedRecipes(userID, query)
+	if err != nil {
+		return model.Profile{}, errors.Wrap(err, "get recipes")
+	}
+
+	recipes = recipes.CalculateAverageRatings()
+
+	if claims.ID != "" {
+		if recipes, err = service.FoodRecipeService.WithFavorites(recipes, claims); err != nil {
+			return model.Profile{}, err
+		}
+	}
+
+	profile := model.Profile{
+		User:    user,
+		Stats:   stats,
+		Recipes: recipes,
+	}
+
+	if claims.ID != "" && claims.ID != userID {
+		isFollowing, err := service.Repository.IsFollowing(claims.ID, userID)
+		if err != nil {
+			return model.Profile{}, errors.Wrap(err, "find follow")
+		}
+		profile.IsFollowing = &isFollowing
+	}
+
+	return profile, nil
+}
+
+func (service Service) Follow(userID string, claims model.Claims) error {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return err
+	}
+
+	if userID == claims.ID {
+		return global.ErrFollowSelf
+	}
+
+	// ตรวจก่อนเพื่อตอบ 404 แทน foreign key error
+	if _, err := service.findActive(userID); err != nil {
+		return err
+	}
+
+	if err := service.CheckBlocked(claims.ID, userID); err != nil {
+		return err
+	}
+
+	follow := model.Follow{
+		FollowerID: claims.ID,
+		FolloweeID: userID,
+	}
+	notice := model.Notification{
+		RecipientID: userID,
+		ActorID:     claims.ID,
+		Type:        model.NotificationFollow,
+	}
+
+	if err := service.Repository.Follow(&follow, &notice); err != nil {
+		return errors.Wrap(err, "create follow")
+	}
+
+	return nil
+}
+
+func (service Service) Unfollow(userID string, claims model.Claims) error {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Repository.Unfollow(claims.ID, userID); err != nil {
+		return errors.Wrap(err, "delete follow")
+	}
+
+	return nil
+}
+
+func (service Service) GetFollowers(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := service.findActive(userID); err != nil {
+		return nil, 0, err
+	}
+
+	users, total, err := service.Repository.GetFollowers(userID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "find followers")
+	}
+
+	return users, total, nil
+}
+
+func (service Service) GetFollowing(userID string, query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := service.findActive(userID); err != nil {
+		return nil, 0, err
+	}
+
+	users, total, err := service.Repository.GetFollowing(userID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "find following")
+	}
+
+	return users, total, nil
+}
+
+func (service Service) Block(userID string, claims model.Claims) error {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return err
+	}
+
+	if userID == claims.ID {
+		return global.ErrBlockSelf
+	}
+
+	if _, err := service.findActive(userID); err != nil {
+		return err
+	}
+
+	block := model.UserBlock{
+		BlockerID: claims.ID,
+		BlockedID: userID,
+	}
+
+	if err := service.Repository.Block(&block); err != nil {
+		return errors.Wrap(err, "create block")
+	}
+
+	return nil
+}
+
+func (service Service) Unblock(userID string, claims model.Claims) error {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Repository.Unblock(claims.ID, userID); err != nil {
+		return errors.Wrap(err, "delete block")
+	}
+
+	return nil
+}
+
+func (service Service) GetBlocked(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	users, total, err := service.Repository.GetBlocked(claims.ID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "find blocked users")
+	}
+
+	return users, total, nil
+}
+
+func (service Service) Mute(userID string, claims model.Claims) error {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return err
+	}
+
+	if userID == claims.ID {
+		return global.ErrBlockSelf
+	}
+
+	if _, err := service.findActive(userID); err != nil {
+		return err
+	}
+
+	mute := model.UserMute{
+		MuterID: claims.ID,
+		MutedID: userID,
+	}
+
+	if err := service.Repository.Mute(&mute); err != nil {
+		return errors.Wrap(err, "create mute")
+	}
+
+	return nil
+}
+
+func (service Service) Unmute(userID string, claims model.Claims) error {
+	userID, err := service.resolveUserID(userID, claims)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Repository.Unmute(claims.ID, userID); err != nil {
+		return errors.Wrap(err, "delete mute")
+	}
+
+	return nil
+}
+
+func (service Service) GetMuted(query model.FollowQuery, claims model.Claims) (model.Users, int64, error) {
+	users, total, err := service.Repository.GetMuted(claims.ID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "find muted users")
+	}
+
+	return users, total, nil
+}
+
+func (service Service) CheckBlocked(userID string, otherID string) error {
+	if userID == otherID {
+		return nil
+	}
+
+	blocked, err := service.Repository.IsBlocked(userID, otherID)
+	if err != nil {
+		return errors.Wrap(err, "find block")
+	}
+
+	if blocked {
+		return global.ErrBlocked
+	}
+
+	return nil
+}
+
+func (service Service) ChangeHandle(request dto.HandleRequest, claims model.Claims) (model.User, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.User{}, errors.Wrap(err, "request invalid")
+	}
+
+	handle := strings.TrimPrefix(request.Handle, "@")
+	if err := model.ValidateHandle(handle); err != nil {
+		return model.User{}, err
+	}
+
+	user, err := service.findActive(claims.ID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	available, err := service.Repository.IsHandleAvailable(model.NormalizeHandle(handle), claims.ID)
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "find handle")
+	}
+	if !available {
+		return model.User{}, global.ErrHandleTaken
+	}
+
+	// เปลี่ยนแค่ตัวพิมพ์ไม่ต้องเก็บ handle เดิม
+	var redirect *model.HandleRedirect
+	if user.Handle != nil && model.NormalizeHandle(*user.Handle) != model.NormalizeHandle(handle) {
+		redirect = &model.HandleRedirect{
+			Handle:    model.NormalizeHandle(*user.Handle),
+			UserID:    claims.ID,
+			ExpiresAt: time.Now().Add(model.HandleRedirectPeriod),
+		}
+	}
+
+	if err := service.Repository.SetHandle(claims.ID, handle, redirect); err != nil {
+		return model.User{}, errors.Wrap(err, "update handle")
+	}
+
+	user.Handle = &handle
+
+	return user, nil
+}
+
+// saveWithGeneratedHandle ผู้อื่นอาจได้ handle ไปหลัง IsHandleAvailable จึงลอง candidate ถัดไปจนหมด
+func (service Service) saveWithGeneratedHandle(user *model.User, claims model.Claims, save func(user *model.User) error) error {
+	var taken []string
+
+	for {
+		handle, err := service.generateHandle(claims, taken...)
+		if err != nil {
+			return err
+		}
+
+		user.Handle = handle
+		err = save(user)
+		if handle == nil || !errors.Is(err, global.ErrHandleTaken) {
+			return err
+		}
+
+		taken = append(taken, *handle)
+	}
+}
+
+// generateHandle handle แรกของผู้ใช้จากชื่อ ถ้าทุกตัวเลือกถูกใช้แล้วคืน nil ให้ผู้ใช้ตั้งเองภายหลัง ข้ามตัวเลือกใน taken
+func (service Service) generateHandle(claims model.Claims, taken ...string) (*string, error) {
+	for _, candidate := range model.HandleCandidates(model.HandleFromName(claims.FirstName, claims.LastName), claims.ID) {
+		if model.ValidateHandle(candidate) != nil || slices.Contains(taken, candidate) {
+			continue
+		}
+
+		available, err := service.Repository.IsHandleAvailable(candidate, claims.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "find handle")
+		}
+		if available {
+			return &candidate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// resolveUserID path ของผู้ใช้รับได้ทั้ง self, @handle และ id
+func (service Service) resolveUserID(userID string, claims model.Claims) (string, error) {
+	switch {
+	case strings.ToLower(userID) == "self":
+		return claims.ID, nil
+	case strings.HasPrefix(userID, "@"):
+		user, err := service.Repository.GetByHandle(model.NormalizeHandle(userID))
+		if err != nil {
+			return "", errors.Wrap(err, "find user")
+		}
+		return user.ID, nil
+	default:
+		return userID, nil
+	}
+}
+
+// findActive ผู้ใช้ที่ถูกลบแล้วถือว่าไม่พบ ซึ่ง GetByID ไม่รวมแถวที่ถูก soft delete อยู่แล้ว
+func (service Service) findActive(userID string) (model.User, error) {
+	user, err := service.Repository.GetByID(userID)
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "find user")
+	}
+
+	return user, nil
+}
@@ -2,10 +2,14 @@ package user_test
 
 import (
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
-	"wongnok/internal/user"
+	"wongnok/internal/model/dto"
+	user "wongnok/internal/users"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -34,9 +38,13 @@ type ServiceUpsertWithClaimsTestSuite struct {
 	repo    *MockIRepository
 
 	// Mock data
-	respGetByID model.User
-	errGetByID  error
-	errUpsert   error
+	respGetByID          model.User
+	errGetByID           error
+	errUpsert            error
+	errRestore           error
+	takenHandles         []string
+	takenOnUpsert        []string
+	errIsHandleAvailable error
 }
 
 // This will run before each test
@@ -49,33 +57,159 @@ func (suite *ServiceUpsertWithClaimsTestSuite) SetupTest() {
 	suite.respGetByID = model.User{}
 	suite.errGetByID = nil
 	suite.errUpsert = nil
+	suite.errRestore = nil
+	suite.takenHandles = nil
+	suite.takenOnUpsert = nil
+	suite.errIsHandleAvailable = nil
+
+	suite.repo.On("Restore", mock.Anything).Return(func(string) error {
+		return suite.errRestore
+	})
+
+	suite.repo.On("IsHandleAvailable", mock.Anything, mock.Anything).Return(func(handle string, _ string) (bool, error) {
+		return !slices.Contains(suite.takenHandles, handle), suite.errIsHandleAvailable
+	})
 
 	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
 		return suite.respGetByID, suite.errGetByID
 	})
 
-	suite.repo.On("Upsert", mock.Anything).Return(func(*model.User) error {
+	suite.repo.On("Upsert", mock.Anything).Return(func(user *model.User) error {
+		if user.Handle != nil && slices.Contains(suite.takenOnUpsert, *user.Handle) {
+			return global.ErrHandleTaken
+		}
 		return suite.errUpsert
 	})
 }
 
 func (suite *ServiceUpsertWithClaimsTestSuite) TestReturnUserUpdated() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
 	claims := model.Claims{
 		ID:        "ID",
 		FirstName: "FirstName",
 		LastName:  "LastName",
 	}
 
+	imageUrl := "https://avatar.iran.liara.run/public/boy"
+	handle := "firstname_lastname"
 	expectedUser := model.User{
 		ID:        "ID",
 		FirstName: "FirstName",
 		LastName:  "LastName",
+		NickName:  "FirstName LastName",
+		Handle:    &handle,
+		ImageUrl:  &imageUrl,
 	}
 
 	user, err := suite.service.UpsertWithClaims(claims)
 	suite.NoError(err)
 
 	suite.Equal(expectedUser, user)
+	suite.repo.AssertCalled(suite.T(), "Restore", "ID")
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestKeepProfileEditedByUser() {
+	imageUrl := "https://example.com/me.png"
+	handle := "chef"
+	suite.respGetByID = model.User{
+		ID:        "ID",
+		FirstName: "OldFirstName",
+		LastName:  "OldLastName",
+		NickName:  "Chef",
+		Handle:    &handle,
+		ImageUrl:  &imageUrl,
+		Bio:       "Bio",
+	}
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "ID", FirstName: "FirstName", LastName: "LastName"})
+	suite.NoError(err)
+
+	suite.Equal("Chef", user.NickName)
+	suite.Equal("https://example.com/me.png", *user.ImageUrl)
+	suite.Equal("FirstName", user.FirstName)
+	suite.Equal("LastName", user.LastName)
+	suite.repo.AssertCalled(suite.T(), "Upsert", &user)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestKeepExistingHandle() {
+	handle := "Chef_Demo"
+	suite.respGetByID = model.User{ID: "ID", Handle: &handle}
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "ID", FirstName: "FirstName", LastName: "LastName"})
+	suite.NoError(err)
+
+	suite.Equal("Chef_Demo", *user.Handle)
+	suite.repo.AssertNotCalled(suite.T(), "IsHandleAvailable", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestGenerateHandleWithIDSuffixWhenTaken() {
+	suite.takenHandles = []string{"somchai_jaidee"}
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "38fa4e9e-27de", FirstName: "Somchai", LastName: "Jaidee"})
+	suite.NoError(err)
+
+	suite.Equal("somchai_jaidee_38fa4e9e", *user.Handle)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestRetryNextHandleWhenTakenOnUpsert() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+	// อีกคนได้ handle ไประหว่างที่ตรวจว่าว่างกับตอนบันทึก
+	suite.takenOnUpsert = []string{"somchai_jaidee"}
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "38fa4e9e-27de", FirstName: "Somchai", LastName: "Jaidee"})
+	suite.NoError(err)
+
+	suite.Equal("somchai_jaidee_38fa4e9e", *user.Handle)
+	suite.repo.AssertNumberOfCalls(suite.T(), "Upsert", 2)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestLeaveHandleEmptyWhenEveryCandidateTakenOnUpsert() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+	suite.takenOnUpsert = []string{"user", "user_id"}
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "ID", FirstName: "สมชาย", LastName: "ใจดี"})
+	suite.NoError(err)
+
+	suite.Nil(user.Handle)
+	suite.repo.AssertNumberOfCalls(suite.T(), "Upsert", 3)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestLeaveHandleEmptyWhenEveryCandidateTaken() {
+	suite.takenHandles = []string{"user", "user_id"}
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "ID", FirstName: "สมชาย", LastName: "ใจดี"})
+	suite.NoError(err)
+
+	suite.Nil(user.Handle)
+	suite.repo.AssertCalled(suite.T(), "Upsert", mock.Anything)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestErrorWhenIsHandleAvailable() {
+	suite.errIsHandleAvailable = assert.AnError
+
+	user, err := suite.service.UpsertWithClaims(model.Claims{ID: "ID", FirstName: "FirstName", LastName: "LastName"})
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.Empty(user)
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything)
+}
+
+func (suite *ServiceUpsertWithClaimsTestSuite) TestErrorWhenRestore() {
+	claims := model.Claims{
+		ID:        "ID",
+		FirstName: "FirstName",
+		LastName:  "LastName",
+	}
+
+	suite.errRestore = assert.AnError
+
+	user, err := suite.service.UpsertWithClaims(claims)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "restore user"))
+
+	suite.Empty(user)
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything)
 }
 
 func (suite *ServiceUpsertWithClaimsTestSuite) TestErrorWhenClaimsValidated() {
@@ -206,31 +340,40 @@ type ServiceGetRecipesTestSuite struct {
 	suite.Suite
 
 	// Dependencies
-	service user.IService
-	repo    *MockIRepository
+	service           user.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
 
 	// Mock data
-	respGetByID    model.User
-	errGetByID     error
-	respGetRecipes model.FoodRecipes
-	errGetRecipes  error
+	respGetByID      model.User
+	errGetByID       error
+	respGetRecipes   model.FoodRecipes
+	errGetRecipes    error
+	errWithFavorites error
 }
 
 func (suite *ServiceGetRecipesTestSuite) SetupTest() {
 	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
 	suite.service = &user.Service{
-		Repository: suite.repo,
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
 	}
 
 	suite.respGetByID = model.User{}
 	suite.respGetRecipes = model.FoodRecipes{}
 	suite.errGetByID = nil
 	suite.errGetRecipes = nil
+	suite.errWithFavorites = nil
 
 	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
 		return suite.respGetByID, suite.errGetByID
 	})
 
+	suite.foodRecipeService.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes, suite.errWithFavorites
+	})
+
 	suite.repo.On("GetRecipes", mock.Anything).Return(func(string) (model.FoodRecipes, error) {
 		return suite.respGetRecipes, suite.errGetRecipes
 	})
@@ -245,16 +388,9 @@ func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseFoodRecipes() {
 
 	expectedFoodRecipes := model.FoodRecipes{
 		model.FoodRecipe{
-			Model: gorm.Model{ID: 1},
-			Name:  "Omelet",
-			Ratings: model.Ratings{
-				model.Rating{
-					Score: 5,
-				},
-				model.Rating{
-					Score: 3,
-				},
-			},
+			Model:         gorm.Model{ID: 1},
+			Name:          "Omelet",
+			RatingSummary: model.RatingSummary{RatingCount: 2, RatingSum: 8},
 		},
 	}
 	suite.respGetRecipes = expectedFoodRecipes
@@ -266,6 +402,16 @@ func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseFoodRecipes() {
 	suite.Equal(float64(4), foodRecipes[0].AverageRating)
 	suite.repo.AssertCalled(suite.T(), "GetByID", "ID")
 	suite.repo.AssertCalled(suite.T(), "GetRecipes", "1")
+	suite.foodRecipeService.AssertCalled(suite.T(), "WithFavorites", expectedFoodRecipes, claims)
+}
+
+func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseErrorWithFavorites() {
+	suite.errWithFavorites = assert.AnError
+
+	foodRecipes, err := suite.service.GetRecipes("1", model.Claims{ID: "ID"})
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.Empty(foodRecipes)
 }
 
 func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseErrorGetByID() {
@@ -298,3 +444,791 @@ func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseErrorGetRecipes()
 func TestServiceGetRecipes(t *testing.T) {
 	suite.Run(t, new(ServiceGetRecipesTestSuite))
 }
+
+type ServicePatchTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service user.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID model.User
+	errGetByID  error
+	errUpdate   error
+}
+
+func (suite *ServicePatchTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &user.Service{
+		Repository: suite.repo,
+	}
+
+	imageUrl := "ImageUrl"
+	suite.respGetByID = model.User{
+		ID:        "ID",
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		NickName:  "NickName",
+		ImageUrl:  &imageUrl,
+	}
+	suite.errGetByID = nil
+	suite.errUpdate = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything).Return(func(user *model.User) (model.User, error) {
+		return *user, suite.errUpdate
+	})
+}
+
+func (suite *ServicePatchTestSuite) TestReturnUserPatched() {
+	claims := model.Claims{ID: "ID"}
+
+	result, err := suite.service.Patch([]byte(`{"nickName":"Updated"}`), claims)
+	suite.NoError(err)
+
+	imageUrl := "ImageUrl"
+	expectedUser := model.User{
+		ID:        "ID",
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		NickName:  "Updated",
+		ImageUrl:  &imageUrl,
+	}
+
+	suite.Equal(expectedUser, result)
+	suite.repo.AssertCalled(suite.T(), "GetByID", "ID")
+	suite.repo.AssertCalled(suite.T(), "Update", &expectedUser)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenMergedResultInvalid() {
+	claims := model.Claims{ID: "ID"}
+
+	result, err := suite.service.Patch([]byte(`{"imageUrl":null}`), claims)
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenPatchInvalid() {
+	claims := model.Claims{ID: "ID"}
+
+	result, err := suite.service.Patch([]byte(`"NickName"`), claims)
+	suite.ErrorIs(err, global.ErrInvalidPatch)
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenGetByID() {
+	claims := model.Claims{ID: "ID"}
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	result, err := suite.service.Patch([]byte(`{"nickName":"Updated"}`), claims)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.True(strings.HasPrefix(err.Error(), "find user"))
+
+	suite.Empty(result)
+}
+
+func (suite *ServicePatchTestSuite) TestErrorWhenUpdate() {
+	claims := model.Claims{ID: "ID"}
+	suite.errUpdate = assert.AnError
+
+	result, err := suite.service.Patch([]byte(`{"nickName":"Updated"}`), claims)
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "update user"))
+
+	suite.Empty(result)
+}
+
+func TestServicePatch(t *testing.T) {
+	suite.Run(t, new(ServicePatchTestSuite))
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service user.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID model.User
+	errGetByID  error
+	errUpdate   error
+}
+
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &user.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetByID = model.User{ID: "ID", CreatedAt: time.Date(2025, 7, 19, 0, 0, 0, 0, time.UTC)}
+	suite.errGetByID = nil
+	suite.errUpdate = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything).Return(func(user *model.User) (model.User, error) {
+		return *user, suite.errUpdate
+	})
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepJoinDate() {
+	result, err := suite.service.Update(&model.User{ID: "ID", Bio: "Bio"})
+	suite.NoError(err)
+
+	suite.Equal(model.User{ID: "ID", Bio: "Bio", CreatedAt: suite.respGetByID.CreatedAt}, result)
+	suite.repo.AssertCalled(suite.T(), "GetByID", "ID")
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepHandle() {
+	handle := "chef_demo"
+	suite.respGetByID.Handle = &handle
+
+	result, err := suite.service.Update(&model.User{ID: "ID"})
+	suite.NoError(err)
+
+	suite.Equal(&handle, result.Handle)
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepEmailWhenClaimMissing() {
+	suite.respGetByID.Email = "demo@example.com"
+
+	result, err := suite.service.Update(&model.User{ID: "ID"})
+	suite.NoError(err)
+
+	suite.Equal("demo@example.com", result.Email)
+}
+
+func (suite *ServiceUpdateTestSuite) TestCreateWhenUserNotFound() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	result, err := suite.service.Update(&model.User{ID: "ID"})
+	suite.NoError(err)
+
+	suite.Equal(model.User{ID: "ID"}, result)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenGetByID() {
+	suite.errGetByID = assert.AnError
+
+	result, err := suite.service.Update(&model.User{ID: "ID"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "find user"))
+
+	suite.Empty(result)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServiceGetProfileTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           user.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	respGetByID             model.User
+	errGetByID              error
+	respGetStats            model.UserStats
+	errGetStats             error
+	respGetPublishedRecipes model.FoodRecipes
+	errGetPublishedRecipes  error
+	errWithFavorites        error
+	respIsFollowing         bool
+	errIsFollowing          error
+	respGetByHandle         model.User
+	errGetByHandle          error
+}
+
+func (suite *ServiceGetProfileTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &user.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.respGetByID = model.User{ID: "UID", Bio: "Bio"}
+	suite.errGetByID = nil
+	suite.respGetStats = model.UserStats{RecipeCount: 1, RatingCount: 2, RatingSum: 8}
+	suite.errGetStats = nil
+	suite.respGetPublishedRecipes = model.FoodRecipes{
+		{Model: gorm.Model{ID: 1}, RatingSummary: model.RatingSummary{RatingCount: 2, RatingSum: 8}},
+	}
+	suite.errGetPublishedRecipes = nil
+	suite.errWithFavorites = nil
+	suite.respIsFollowing = true
+	suite.errIsFollowing = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("GetStats", mock.Anything).Return(func(string) (model.UserStats, error) {
+		return suite.respGetStats, suite.errGetStats
+	})
+	suite.repo.On("GetPublishedRecipes", mock.Anything, mock.Anything).Return(func(string, model.ProfileQuery) (model.FoodRecipes, error) {
+		return suite.respGetPublishedRecipes, suite.errGetPublishedRecipes
+	})
+	suite.foodRecipeService.On("WithFavorites", mock.Anything, mock.Anything).Return(func(recipes model.FoodRecipes, claims model.Claims) (model.FoodRecipes, error) {
+		return recipes, suite.errWithFavorites
+	})
+	suite.repo.On("IsFollowing", mock.Anything, mock.Anything).Return(func(string, string) (bool, error) {
+		return suite.respIsFollowing, suite.errIsFollowing
+	})
+
+	suite.respGetByHandle = model.User{ID: "UID"}
+	suite.errGetByHandle = nil
+
+	suite.repo.On("GetByHandle", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetByHandle, suite.errGetByHandle
+	})
+}
+
+func (suite *ServiceGetProfileTestSuite) TestReturnProfileForGuest() {
+	query := model.ProfileQuery{Page: 2, Limit: 10}
+
+	profile, err := suite.service.GetProfile("UID", query, model.Claims{})
+	suite.NoError(err)
+
+	suite.Equal(suite.respGetByID, profile.User)
+	suite.Equal(suite.respGetStats, profile.Stats)
+	suite.Equal(float64(4), profile.Recipes[0].AverageRating)
+	suite.repo.AssertCalled(suite.T(), "GetStats", "UID")
+	suite.repo.AssertCalled(suite.T(), "GetPublishedRecipes", "UID", query)
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "WithFavorites", mock.Anything, mock.Anything)
+	suite.Nil(profile.IsFollowing)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestFillFavoritesForViewer() {
+	claims := model.Claims{ID: "Viewer"}
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, claims)
+	suite.NoError(err)
+
+	suite.foodRecipeService.AssertCalled(suite.T(), "WithFavorites", mock.Anything, claims)
+	suite.True(*profile.IsFollowing)
+	suite.repo.AssertCalled(suite.T(), "IsFollowing", "Viewer", "UID")
+}
+
+func (suite *ServiceGetProfileTestSuite) TestResolveSelf() {
+	profile, err := suite.service.GetProfile("self", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "GetByID", "UID")
+	suite.Nil(profile.IsFollowing)
+	suite.repo.AssertNotCalled(suite.T(), "IsFollowing", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestResolveHandleCaseInsensitive() {
+	profile, err := suite.service.GetProfile("@Chef_Demo", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.NoError(err)
+
+	suite.Equal("UID", profile.User.ID)
+	suite.repo.AssertCalled(suite.T(), "GetByHandle", "chef_demo")
+	suite.repo.AssertCalled(suite.T(), "GetStats", "UID")
+}
+
+func (suite *ServiceGetProfileTestSuite) TestNotFoundWhenHandleUnknown() {
+	suite.errGetByHandle = gorm.ErrRecordNotFound
+
+	_, err := suite.service.GetProfile("@nobody", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestErrorWhenIsFollowing() {
+	suite.errIsFollowing = assert.AnError
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "find follow"))
+
+	suite.Empty(profile)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestNotFoundWhenUserDeleted() {
+	// repository ไม่คืนผู้ใช้ที่ถูก soft delete
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.Empty(profile)
+	suite.repo.AssertNotCalled(suite.T(), "GetStats", mock.Anything)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestErrorWhenGetByID() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.True(strings.HasPrefix(err.Error(), "find user"))
+
+	suite.Empty(profile)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestErrorWhenGetStats() {
+	suite.errGetStats = assert.AnError
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "get stats"))
+
+	suite.Empty(profile)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestErrorWhenGetPublishedRecipes() {
+	suite.errGetPublishedRecipes = assert.AnError
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "get recipes"))
+
+	suite.Empty(profile)
+}
+
+func (suite *ServiceGetProfileTestSuite) TestErrorWhenWithFavorites() {
+	suite.errWithFavorites = assert.AnError
+
+	profile, err := suite.service.GetProfile("UID", model.ProfileQuery{Page: 1, Limit: 20}, model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.Empty(profile)
+}
+
+func TestServiceGetProfile(t *testing.T) {
+	suite.Run(t, new(ServiceGetProfileTestSuite))
+}
+
+type ServiceFollowTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service user.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID      model.User
+	errGetByID       error
+	errFollow        error
+	errUnfollow      error
+	respGetFollowers model.Users
+	errGetFollowers  error
+	respGetFollowing model.Users
+	errGetFollowing  error
+	respIsBlocked    bool
+}
+
+func (suite *ServiceFollowTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &user.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetByID = model.User{ID: "UID"}
+	suite.errGetByID = nil
+	suite.errFollow = nil
+	suite.errUnfollow = nil
+	suite.respGetFollowers = model.Users{{ID: "Follower"}}
+	suite.errGetFollowers = nil
+	suite.respGetFollowing = model.Users{{ID: "Followee"}}
+	suite.errGetFollowing = nil
+	suite.respIsBlocked = false
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("IsBlocked", mock.Anything, mock.Anything).Return(func(string, string) (bool, error) {
+		return suite.respIsBlocked, nil
+	})
+	suite.repo.On("Follow", mock.Anything, mock.Anything).Return(func(*model.Follow, *model.Notification) error {
+		return suite.errFollow
+	})
+	suite.repo.On("Unfollow", mock.Anything, mock.Anything).Return(func(string, string) error {
+		return suite.errUnfollow
+	})
+	suite.repo.On("GetFollowers", mock.Anything, mock.Anything).Return(func(string, model.FollowQuery) (model.Users, int64, error) {
+		return suite.respGetFollowers, int64(len(suite.respGetFollowers)), suite.errGetFollowers
+	})
+	suite.repo.On("GetFollowing", mock.Anything, mock.Anything).Return(func(string, model.FollowQuery) (model.Users, int64, error) {
+		return suite.respGetFollowing, int64(len(suite.respGetFollowing)), suite.errGetFollowing
+	})
+}
+
+func (suite *ServiceFollowTestSuite) TestFollow() {
+	err := suite.service.Follow("UID", model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "GetByID", "UID")
+	suite.repo.AssertCalled(suite.T(), "Follow", &model.Follow{FollowerID: "Viewer", FolloweeID: "UID"}, &model.Notification{
+		RecipientID: "UID",
+		ActorID:     "Viewer",
+		Type:        model.NotificationFollow,
+	})
+}
+
+func (suite *ServiceFollowTestSuite) TestErrorWhenFollowSelf() {
+	err := suite.service.Follow("UID", model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrFollowSelf)
+
+	suite.repo.AssertNotCalled(suite.T(), "Follow", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceFollowTestSuite) TestErrorWhenFollowBlockedUser() {
+	suite.respIsBlocked = true
+
+	err := suite.service.Follow("UID", model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, global.ErrBlocked)
+
+	suite.repo.AssertCalled(suite.T(), "IsBlocked", "Viewer", "UID")
+	suite.repo.AssertNotCalled(suite.T(), "Follow", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceFollowTestSuite) TestNotFoundWhenFollowDeletedUser() {
+	// repository ไม่คืนผู้ใช้ที่ถูก soft delete
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	err := suite.service.Follow("UID", model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Follow", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceFollowTestSuite) TestErrorWhenFollow() {
+	suite.errFollow = assert.AnError
+
+	err := suite.service.Follow("UID", model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "create follow"))
+}
+
+func (suite *ServiceFollowTestSuite) TestUnfollow() {
+	err := suite.service.Unfollow("UID", model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Unfollow", "Viewer", "UID")
+}
+
+func (suite *ServiceFollowTestSuite) TestErrorWhenUnfollow() {
+	suite.errUnfollow = assert.AnError
+
+	err := suite.service.Unfollow("UID", model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "delete follow"))
+}
+
+func (suite *ServiceFollowTestSuite) TestGetFollowersOfSelf() {
+	query := model.FollowQuery{Page: 1, Limit: 20}
+
+	users, total, err := suite.service.GetFollowers("self", query, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(suite.respGetFollowers, users)
+	suite.Equal(int64(1), total)
+	suite.repo.AssertCalled(suite.T(), "GetFollowers", "UID", query)
+}
+
+func (suite *ServiceFollowTestSuite) TestErrorWhenGetFollowers() {
+	suite.errGetFollowers = assert.AnError
+
+	users, _, err := suite.service.GetFollowers("UID", model.FollowQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "find followers"))
+
+	suite.Empty(users)
+}
+
+func (suite *ServiceFollowTestSuite) TestGetFollowing() {
+	query := model.FollowQuery{Page: 2, Limit: 10}
+
+	users, total, err := suite.service.GetFollowing("UID", query, model.Claims{})
+	suite.NoError(err)
+
+	suite.Equal(suite.respGetFollowing, users)
+	suite.Equal(int64(1), total)
+	suite.repo.AssertCalled(suite.T(), "GetFollowing", "UID", query)
+}
+
+func (suite *ServiceFollowTestSuite) TestNotFoundWhenGetFollowingOfUnknownUser() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	users, _, err := suite.service.GetFollowing("UID", model.FollowQuery{Page: 1, Limit: 20}, model.Claims{})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.Empty(users)
+	suite.repo.AssertNotCalled(suite.T(), "GetFollowing", mock.Anything, mock.Anything)
+}
+
+func TestServiceFollow(t *testing.T) {
+	suite.Run(t, new(ServiceFollowTestSuite))
+}
+
+type ServiceBlockTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service user.IService
+	repo    *MockIRepository
+
+	// Mock data
+	errGetByID    error
+	respIsBlocked bool
+	errIsBlocked  error
+	errBlock      error
+	errMute       error
+	respGetMuted  model.Users
+}
+
+func (suite *ServiceBlockTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &user.Service{
+		Repository: suite.repo,
+	}
+
+	suite.errGetByID = nil
+	suite.respIsBlocked = false
+	suite.errIsBlocked = nil
+	suite.errBlock = nil
+	suite.errMute = nil
+	suite.respGetMuted = model.Users{{ID: "Muted"}}
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(id string) (model.User, error) {
+		return model.User{ID: id}, suite.errGetByID
+	})
+	suite.repo.On("IsBlocked", mock.Anything, mock.Anything).Return(func(string, string) (bool, error) {
+		return suite.respIsBlocked, suite.errIsBlocked
+	})
+	suite.repo.On("Block", mock.Anything).Return(func(*model.UserBlock) error {
+		return suite.errBlock
+	})
+	suite.repo.On("Unblock", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("Mute", mock.Anything).Return(func(*model.UserMute) error {
+		return suite.errMute
+	})
+	suite.repo.On("Unmute", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("GetMuted", mock.Anything, mock.Anything).Return(func(string, model.FollowQuery) (model.Users, int64, error) {
+		return suite.respGetMuted, int64(len(suite.respGetMuted)), nil
+	})
+}
+
+func (suite *ServiceBlockTestSuite) TestBlock() {
+	err := suite.service.Block("UID", model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Block", &model.UserBlock{BlockerID: "Viewer", BlockedID: "UID"})
+}
+
+func (suite *ServiceBlockTestSuite) TestErrorWhenBlockSelf() {
+	err := suite.service.Block("UID", model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrBlockSelf)
+
+	suite.repo.AssertNotCalled(suite.T(), "Block", mock.Anything)
+}
+
+func (suite *ServiceBlockTestSuite) TestNotFoundWhenBlockUnknownUser() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	err := suite.service.Block("UID", model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Block", mock.Anything)
+}
+
+func (suite *ServiceBlockTestSuite) TestErrorWhenBlock() {
+	suite.errBlock = assert.AnError
+
+	err := suite.service.Block("UID", model.Claims{ID: "Viewer"})
+	suite.ErrorIs(err, assert.AnError)
+	suite.True(strings.HasPrefix(err.Error(), "create block"))
+}
+
+func (suite *ServiceBlockTestSuite) TestUnblock() {
+	err := suite.service.Unblock("UID", model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Unblock", "Viewer", "UID")
+}
+
+func (suite *ServiceBlockTestSuite) TestMute() {
+	err := suite.service.Mute("UID", model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Mute", &model.UserMute{MuterID: "Viewer", MutedID: "UID"})
+}
+
+func (suite *ServiceBlockTestSuite) TestErrorWhenMuteSelf() {
+	err := suite.service.Mute("UID", model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrBlockSelf)
+
+	suite.repo.AssertNotCalled(suite.T(), "Mute", mock.Anything)
+}
+
+func (suite *ServiceBlockTestSuite) TestUnmute() {
+	err := suite.service.Unmute("UID", model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Unmute", "Viewer", "UID")
+}
+
+func (suite *ServiceBlockTestSuite) TestGetMutedOfCaller() {
+	query := model.FollowQuery{Page: 1, Limit: 20}
+
+	users, total, err := suite.service.GetMuted(query, model.Claims{ID: "Viewer"})
+	suite.NoError(err)
+
+	suite.Equal(suite.respGetMuted, users)
+	suite.Equal(int64(1), total)
+	suite.repo.AssertCalled(suite.T(), "GetMuted", "Viewer", query)
+}
+
+func (suite *ServiceBlockTestSuite) TestCheckBlocked() {
+	suite.NoError(suite.service.CheckBlocked("Viewer", "UID"))
+
+	suite.respIsBlocked = true
+	suite.ErrorIs(suite.service.CheckBlocked("Viewer", "UID"), global.ErrBlocked)
+}
+
+func (suite *ServiceBlockTestSuite) TestCheckBlockedSkipSelf() {
+	suite.respIsBlocked = true
+
+	suite.NoError(suite.service.CheckBlocked("UID", "UID"))
+	suite.repo.AssertNotCalled(suite.T(), "IsBlocked", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceBlockTestSuite) TestErrorWhenIsBlocked() {
+	suite.errIsBlocked = assert.AnError
+
+	err := suite.service.CheckBlocked("Viewer", "UID")
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceBlock(t *testing.T) {
+	suite.Run(t, new(ServiceBlockTestSuite))
+}
+
+type ServiceChangeHandleTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service user.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID           model.User
+	errGetByID            error
+	respIsHandleAvailable bool
+	errIsHandleAvailable  error
+	errSetHandle          error
+}
+
+func (suite *ServiceChangeHandleTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &user.Service{
+		Repository: suite.repo,
+	}
+
+	handle := "demo_tester"
+	suite.respGetByID = model.User{ID: "UID", Handle: &handle}
+	suite.errGetByID = nil
+	suite.respIsHandleAvailable = true
+	suite.errIsHandleAvailable = nil
+	suite.errSetHandle = nil
+
+	suite.repo.On("GetByID", mock.Anything).Return(func(string) (model.User, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("IsHandleAvailable", mock.Anything, mock.Anything).Return(func(string, string) (bool, error) {
+		return suite.respIsHandleAvailable, suite.errIsHandleAvailable
+	})
+	suite.repo.On("SetHandle", mock.Anything, mock.Anything, mock.Anything).Return(func(string, string, *model.HandleRedirect) error {
+		return suite.errSetHandle
+	})
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestChangeHandleKeepsRedirect() {
+	result, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "@Chef_Demo"}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal("Chef_Demo", *result.Handle)
+	suite.repo.AssertCalled(suite.T(), "IsHandleAvailable", "chef_demo", "UID")
+	suite.repo.AssertCalled(suite.T(), "SetHandle", "UID", "Chef_Demo", mock.MatchedBy(func(redirect *model.HandleRedirect) bool {
+		return redirect.Handle == "demo_tester" && redirect.UserID == "UID" &&
+			time.Until(redirect.ExpiresAt) > model.HandleRedirectPeriod-time.Minute
+	}))
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestNoRedirectWhenOnlyCaseChanges() {
+	_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "Demo_Tester"}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "SetHandle", "UID", "Demo_Tester", (*model.HandleRedirect)(nil))
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestNoRedirectWhenNoHandleYet() {
+	suite.respGetByID.Handle = nil
+
+	_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "chef_demo"}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "SetHandle", "UID", "chef_demo", (*model.HandleRedirect)(nil))
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestErrorWhenHandleInvalid() {
+	for _, handle := range []string{"", "ab", "1chef", "chef-demo", "เชฟ", strings.Repeat("a", 31)} {
+		_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: handle}, model.Claims{ID: "UID"})
+
+		suite.Error(err, handle)
+	}
+
+	suite.repo.AssertNotCalled(suite.T(), "SetHandle", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestErrorWhenHandleReserved() {
+	_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "Admin"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, global.ErrHandleReserved)
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestErrorWhenHandleTaken() {
+	suite.respIsHandleAvailable = false
+
+	_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "chef_demo"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, global.ErrHandleTaken)
+	suite.repo.AssertNotCalled(suite.T(), "SetHandle", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestErrorWhenSetHandle() {
+	suite.errSetHandle = assert.AnError
+
+	_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "chef_demo"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceChangeHandleTestSuite) TestErrorWhenHandleTakenWhileSaving() {
+	suite.errSetHandle = global.ErrHandleTaken
+
+	_, err := suite.service.ChangeHandle(dto.HandleRequest{Handle: "chef_demo"}, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, global.ErrHandleTaken)
+}
+
+func TestServiceChangeHandle(t *testing.T) {
+	suite.Run(t, new(ServiceChangeHandleTestSuite))
+}
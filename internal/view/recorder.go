@@ -0,0 +1,124 @@
+package view
+
+import (
+	"log"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRecorder interface {
+	Record(view model.RecipeView) bool
+	Close()
+}
+
+// Recorder รับวิวจาก request แล้วเขียนลงฐานข้อมูลเป็นชุดใน goroutine แยก
+// request จึงไม่ต้องรอฐานข้อมูล
+type Recorder struct {
+	Repository IRepository
+	Config     config.View
+
+	views   chan model.RecipeView
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func NewRecorder(db *gorm.DB, conf config.View) *Recorder {
+	return &Recorder{
+		Repository: NewRepository(db),
+		Config:     conf,
+		views:      make(chan model.RecipeView, conf.BufferSize),
+		done:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+}
+
+// Start เริ่ม goroutine ที่เขียนวิว ต้องเรียก Close ก่อนปิดโปรแกรมเพื่อเขียนวิวที่ค้างอยู่
+func (recorder *Recorder) Start() {
+	go recorder.run()
+}
+
+// Record ไม่รอ ถ้า buffer เต็มจะทิ้งวิวนั้นแล้วคืน false
+func (recorder *Recorder) Record(view model.RecipeView) bool {
+	select {
+	case <-recorder.done:
+		return false
+	default:
+	}
+
+	select {
+	case recorder.views <- view:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close หยุดรับวิวใหม่ แล้วรอจนเขียนวิวที่ค้างใน buffer เสร็จ
+func (recorder *Recorder) Close() {
+	close(recorder.done)
+	<-recorder.stopped
+}
+
+func (recorder *Recorder) run() {
+	defer close(recorder.stopped)
+
+	ticker := time.NewTicker(recorder.Config.FlushInterval)
+	defer ticker.Stop()
+
+	var (
+		batch      = make(model.RecipeViews, 0, recorder.Config.BatchSize)
+		lastPruned time.Time
+	)
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := recorder.Repository.Save(batch.Dedup(recorder.Config.DedupWindow), recorder.Config.DedupWindow); err != nil {
+			log.Println("Error when save recipe views:", err)
+		}
+		batch = batch[:0]
+
+		// key ที่เก่ากว่าช่วงกันวิวซ้ำไม่มีผลแล้ว จึงลบแค่ช่วงละครั้งก็พอ
+		if now := time.Now(); now.Sub(lastPruned) >= recorder.Config.DedupWindow {
+			err := recorder.Repository.Prune(
+				now.Add(-recorder.Config.DedupWindow),
+				now.Add(-recorder.Config.HourlyRetention),
+			)
+			if err != nil {
+				log.Println("Error when prune recipe views:", err)
+			}
+			lastPruned = now
+		}
+	}
+
+	add := func(view model.RecipeView) {
+		batch = append(batch, view)
+		if len(batch) >= recorder.Config.BatchSize {
+			flush()
+		}
+	}
+
+	for {
+		select {
+		case view := <-recorder.views:
+			add(view)
+		case <-ticker.C:
+			flush()
+		case <-recorder.done:
+			for {
+				select {
+				case view := <-recorder.views:
+					add(view)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package view_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/config"
+	"wongnok/internal/model"
+	"wongnok/internal/view"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewRecorder(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		recorder := view.NewRecorder(&gorm.DB{}, config.View{DedupWindow: time.Minute, BufferSize: 1})
+
+		value := reflect.Indirect(reflect.ValueOf(recorder))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RecorderTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	repo *MockIRepository
+
+	// Mock data
+	conf    config.View
+	errSave error
+	now     time.Time
+
+	// Helper
+	saved chan model.RecipeViews
+}
+
+func (suite *RecorderTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.conf = config.View{
+		DedupWindow:     30 * time.Minute,
+		FlushInterval:   time.Hour,
+		BatchSize:       3,
+		BufferSize:      10,
+		HourlyRetention: 720 * time.Hour,
+	}
+	suite.errSave = nil
+	suite.now = time.Now()
+	suite.saved = make(chan model.RecipeViews, 10)
+
+	suite.repo.On("Save", mock.Anything, mock.Anything).Return(func(views model.RecipeViews, window time.Duration) error {
+		suite.saved <- views
+		return suite.errSave
+	})
+	suite.repo.On("Prune", mock.Anything, mock.Anything).Return(nil)
+}
+
+func (suite *RecorderTestSuite) newRecorder() *view.Recorder {
+	recorder := view.NewRecorder(&gorm.DB{}, suite.conf)
+	recorder.Repository = suite.repo
+
+	return recorder
+}
+
+func (suite *RecorderTestSuite) views(viewers ...string) model.RecipeViews {
+	var views model.RecipeViews
+	for _, viewer := range viewers {
+		views = append(views, model.RecipeView{FoodRecipeID: 1, Viewer: viewer, ViewedAt: suite.now})
+	}
+
+	return views
+}
+
+func (suite *RecorderTestSuite) TestSaveInBatches() {
+	recorder := suite.newRecorder()
+	recorder.Start()
+
+	for _, view := range suite.views("u:a", "u:b", "u:c", "u:d") {
+		suite.True(recorder.Record(view))
+	}
+	recorder.Close()
+
+	suite.repo.AssertNumberOfCalls(suite.T(), "Save", 2)
+	suite.repo.AssertCalled(suite.T(), "Save", suite.views("u:a", "u:b", "u:c"), suite.conf.DedupWindow)
+	// Close ต้องเขียนวิวที่ยังไม่ครบชุดด้วย
+	suite.repo.AssertCalled(suite.T(), "Save", suite.views("u:d"), suite.conf.DedupWindow)
+}
+
+func (suite *RecorderTestSuite) TestDedupBeforeSave() {
+	recorder := suite.newRecorder()
+	recorder.Start()
+
+	for _, view := range suite.views("u:a", "u:a", "u:b") {
+		recorder.Record(view)
+	}
+	recorder.Close()
+
+	suite.repo.AssertCalled(suite.T(), "Save", suite.views("u:a", "u:b"), suite.conf.DedupWindow)
+}
+
+func (suite *RecorderTestSuite) TestSaveWhenFlushIntervalPassed() {
+	suite.conf.FlushInterval = 10 * time.Millisecond
+
+	recorder := suite.newRecorder()
+	recorder.Start()
+	defer recorder.Close()
+
+	recorder.Record(suite.views("u:a")[0])
+
+	select {
+	case views := <-suite.saved:
+		suite.Equal(suite.views("u:a"), views)
+	case <-time.After(time.Second):
+		suite.Fail("views were not saved after flush interval")
+	}
+}
+
+func (suite *RecorderTestSuite) TestPruneOncePerDedupWindow() {
+	recorder := suite.newRecorder()
+	recorder.Start()
+
+	for _, view := range suite.views("u:a", "u:b", "u:c", "u:d", "u:e", "u:f") {
+		recorder.Record(view)
+	}
+	recorder.Close()
+
+	suite.repo.AssertNumberOfCalls(suite.T(), "Save", 2)
+	suite.repo.AssertNumberOfCalls(suite.T(), "Prune", 1)
+}
+
+func (suite *RecorderTestSuite) TestKeepRunningWhenSaveFailed() {
+	suite.errSave = assert.AnError
+
+	recorder := suite.newRecorder()
+	recorder.Start()
+
+	for _, view := range suite.views("u:a", "u:b", "u:c", "u:d") {
+		recorder.Record(view)
+	}
+	recorder.Close()
+
+	suite.repo.AssertNumberOfCalls(suite.T(), "Save", 2)
+}
+
+func (suite *RecorderTestSuite) TestDropWhenBufferFull() {
+	suite.conf.BufferSize = 1
+
+	// ยังไม่ Start จึงไม่มีใครอ่านจาก buffer
+	recorder := suite.newRecorder()
+
+	suite.True(recorder.Record(suite.views("u:a")[0]))
+	suite.False(recorder.Record(suite.views("u:b")[0]))
+}
+
+func (suite *RecorderTestSuite) TestDropWhenClosed() {
+	recorder := suite.newRecorder()
+	recorder.Start()
+	recorder.Close()
+
+	suite.False(recorder.Record(suite.views("u:a")[0]))
+	suite.repo.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func TestRecorder(t *testing.T) {
+	suite.Run(t, new(RecorderTestSuite))
+}
@@ -0,0 +1,82 @@
+package view
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Save(views model.RecipeViews, window time.Duration) error
+	Prune(keysBefore time.Time, hourlyBefore time.Time) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Save บันทึก key กันวิวซ้ำ แล้วบวกยอดรายชั่วโมงและรายวันเฉพาะวิวที่ยังไม่เคยนับ
+// ทั้งหมดอยู่ใน transaction เดียว และใช้ INSERT เดียวต่อตาราง
+func (repo Repository) Save(views model.RecipeViews, window time.Duration) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	keys := make([][]any, 0, len(views))
+	for _, view := range views {
+		keys = append(keys, []any{view.FoodRecipeID, view.Viewer, view.WindowStart(window), view.ViewedAt.UTC()})
+	}
+
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		var counted model.RecipeViews
+
+		err := tx.Raw(
+			`INSERT INTO recipe_view_keys (food_recipe_id, viewer, window_start, viewed_at) VALUES ?
+			ON CONFLICT DO NOTHING
+			RETURNING food_recipe_id, viewer, viewed_at`,
+			keys,
+		).Scan(&counted).Error
+		if err != nil {
+			return err
+		}
+
+		if len(counted) == 0 {
+			return nil
+		}
+
+		if err := upsertCounts(tx, "recipe_view_hourly", "hour", counted.Rollup(model.ViewHour)); err != nil {
+			return err
+		}
+
+		return upsertCounts(tx, "recipe_view_daily", "day", counted.Rollup(model.ViewDay))
+	})
+}
+
+func upsertCounts(tx *gorm.DB, table string, column string, counts []model.RecipeViewCount) error {
+	rows := make([][]any, 0, len(counts))
+	for _, count := range counts {
+		rows = append(rows, []any{count.FoodRecipeID, count.Bucket, count.Views})
+	}
+
+	return tx.Exec(
+		`INSERT INTO `+table+` (food_recipe_id, `+column+`, views) VALUES ?
+		ON CONFLICT (food_recipe_id, `+column+`) DO UPDATE SET views = `+table+`.views + EXCLUDED.views`,
+		rows,
+	).Error
+}
+
+// Prune ลบ key ที่พ้นช่วงกันวิวซ้ำแล้ว และยอดรายชั่วโมงที่เก่ากว่าระยะเก็บ
+func (repo Repository) Prune(keysBefore time.Time, hourlyBefore time.Time) error {
+	if err := repo.DB.Exec("DELETE FROM recipe_view_keys WHERE window_start < ?", keysBefore.UTC()).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Exec("DELETE FROM recipe_view_hourly WHERE hour < ?", hourlyBefore.UTC()).Error
+}
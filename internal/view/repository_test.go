@@ -0,0 +1,161 @@
+package view_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/view"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := view.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	container *postgres.PostgresContainer
+	db        *gorm.DB
+	repo      view.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repo = &view.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	suite.NoError(suite.db.Exec("DELETE FROM recipe_view_keys").Error)
+	suite.NoError(suite.db.Exec("DELETE FROM recipe_view_hourly").Error)
+	suite.NoError(suite.db.Exec("DELETE FROM recipe_view_daily").Error)
+
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+func (suite *RepositoryTestSuite) hourlyViews() int {
+	var views int
+	suite.NoError(suite.db.Raw("SELECT COALESCE(SUM(views), 0) FROM recipe_view_hourly WHERE food_recipe_id = 1").Scan(&views).Error)
+
+	return views
+}
+
+func (suite *RepositoryTestSuite) dailyViews() int {
+	var views int
+	suite.NoError(suite.db.Raw("SELECT COALESCE(SUM(views), 0) FROM recipe_view_daily WHERE food_recipe_id = 1").Scan(&views).Error)
+
+	return views
+}
+
+func (suite *RepositoryTestSuite) TestSaveCountsEachViewerOncePerWindow() {
+	now := time.Now()
+	window := 30 * time.Minute
+
+	err := suite.repo.Save(model.RecipeViews{
+		{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: now},
+		{FoodRecipeID: 1, Viewer: "a:b", ViewedAt: now},
+	}, window)
+	suite.NoError(err)
+
+	// ผู้ชมเดิมในช่วงเดิมจาก batch ถัดไปต้องไม่ถูกนับซ้ำ
+	err = suite.repo.Save(model.RecipeViews{
+		{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: now},
+		{FoodRecipeID: 1, Viewer: "u:c", ViewedAt: now},
+	}, window)
+	suite.NoError(err)
+
+	suite.Equal(3, suite.hourlyViews())
+	suite.Equal(3, suite.dailyViews())
+}
+
+func (suite *RepositoryTestSuite) TestSaveSkipWhenAllViewsCounted() {
+	views := model.RecipeViews{{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: time.Now()}}
+
+	suite.NoError(suite.repo.Save(views, time.Hour))
+	suite.NoError(suite.repo.Save(views, time.Hour))
+
+	suite.Equal(1, suite.hourlyViews())
+}
+
+func (suite *RepositoryTestSuite) TestPruneRemovesOldRows() {
+	now := time.Now()
+	views := model.RecipeViews{
+		{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: now.Add(-48 * time.Hour)},
+		{FoodRecipeID: 1, Viewer: "u:a", ViewedAt: now},
+	}
+	suite.NoError(suite.repo.Save(views, time.Hour))
+
+	suite.NoError(suite.repo.Prune(now.Add(-time.Hour), now.Add(-24*time.Hour)))
+
+	var keys int64
+	suite.NoError(suite.db.Table("recipe_view_keys").Count(&keys).Error)
+	suite.Equal(int64(1), keys)
+	suite.Equal(1, suite.hourlyViews())
+	// ยอดรายวันไม่ถูกลบ
+	suite.Equal(2, suite.dailyViews())
+}
+
+func TestRepositorySave(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE difficulties
+    ADD COLUMN name_th VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN sort_order INT NOT NULL DEFAULT 0,
+    ADD COLUMN retired_at TIMESTAMP NULL;
+
+ALTER TABLE cooking_durations
+    ADD COLUMN name_th VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN sort_order INT NOT NULL DEFAULT 0,
+    ADD COLUMN retired_at TIMESTAMP NULL;
+
+UPDATE difficulties SET sort_order = id;
+UPDATE difficulties SET name_th = 'ง่าย' WHERE name = 'Easy';
+UPDATE difficulties SET name_th = 'ปานกลาง' WHERE name = 'Medium';
+UPDATE difficulties SET name_th = 'ยาก' WHERE name = 'Hard';
+
+UPDATE cooking_durations SET sort_order = id;
+UPDATE cooking_durations SET name_th = name || ' นาที' WHERE name <> '60+';
+UPDATE cooking_durations SET name_th = 'มากกว่า 60 นาที' WHERE name = '60+';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cooking_durations
+    DROP COLUMN retired_at,
+    DROP COLUMN sort_order,
+    DROP COLUMN name_th;
+
+ALTER TABLE difficulties
+    DROP COLUMN retired_at,
+    DROP COLUMN sort_order,
+    DROP COLUMN name_th;
+-- +goose StatementEnd
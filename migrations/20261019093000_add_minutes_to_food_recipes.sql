@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cooking_durations
+    ADD COLUMN min_minutes INT NOT NULL DEFAULT 0,
+    ADD COLUMN max_minutes INT NULL;
+
+UPDATE cooking_durations SET min_minutes = 5, max_minutes = 10 WHERE name = '5 - 10';
+UPDATE cooking_durations SET min_minutes = 11, max_minutes = 30 WHERE name = '11 - 30';
+UPDATE cooking_durations SET min_minutes = 31, max_minutes = 60 WHERE name = '31 - 60';
+UPDATE cooking_durations SET min_minutes = 61, max_minutes = NULL WHERE name = '60+';
+
+ALTER TABLE food_recipes
+    ADD COLUMN prep_minutes INT NOT NULL DEFAULT 0 CHECK (prep_minutes >= 0),
+    ADD COLUMN cook_minutes INT NOT NULL DEFAULT 0 CHECK (cook_minutes >= 0),
+    ADD COLUMN total_minutes INT NOT NULL DEFAULT 0;
+
+-- recipe เดิมมีแค่หมวดเวลา ใช้ค่ากลางของหมวดเป็นเวลาทำ (สูตรเดียวกับ CookingDuration.MidpointMinutes)
+UPDATE food_recipes
+SET
+    cook_minutes = CASE
+        WHEN cooking_durations.max_minutes IS NULL THEN cooking_durations.min_minutes + 30
+        ELSE (cooking_durations.min_minutes + cooking_durations.max_minutes + 1) / 2
+    END
+FROM cooking_durations
+WHERE cooking_durations.id = food_recipes.cooking_duration_id;
+
+UPDATE food_recipes SET total_minutes = prep_minutes + cook_minutes;
+
+CREATE INDEX IF NOT EXISTS idx_food_recipes_total_minutes ON food_recipes (total_minutes);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_food_recipes_total_minutes;
+
+ALTER TABLE food_recipes
+    DROP COLUMN total_minutes,
+    DROP COLUMN cook_minutes,
+    DROP COLUMN prep_minutes;
+
+ALTER TABLE cooking_durations
+    DROP COLUMN max_minutes,
+    DROP COLUMN min_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE food_recipes
+    ADD COLUMN allergens JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN diets JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_food_recipes_allergens ON food_recipes USING GIN (allergens jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_food_recipes_diets ON food_recipes USING GIN (diets jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_food_recipes_diets;
+DROP INDEX IF EXISTS idx_food_recipes_allergens;
+
+ALTER TABLE food_recipes
+    DROP COLUMN diets,
+    DROP COLUMN allergens;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- key สำหรับตัดวิวซ้ำ ลบทิ้งเมื่อพ้นช่วงเวลาแล้ว
+CREATE TABLE IF NOT EXISTS recipe_view_keys (
+    food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+    viewer VARCHAR(100) NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    viewed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (food_recipe_id, viewer, window_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_recipe_view_keys_window_start ON recipe_view_keys (window_start);
+
+CREATE TABLE IF NOT EXISTS recipe_view_hourly (
+    food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+    hour TIMESTAMPTZ NOT NULL,
+    views INT NOT NULL CHECK (views >= 0),
+    PRIMARY KEY (food_recipe_id, hour)
+);
+
+CREATE INDEX IF NOT EXISTS idx_recipe_view_hourly_hour ON recipe_view_hourly (hour);
+
+CREATE TABLE IF NOT EXISTS recipe_view_daily (
+    food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+    day DATE NOT NULL,
+    views INT NOT NULL CHECK (views >= 0),
+    PRIMARY KEY (food_recipe_id, day)
+);
+
+-- trending ดึงกิจกรรมล่าสุดจาก favorites และ ratings ตามเวลา
+CREATE INDEX IF NOT EXISTS idx_favorites_created_at ON favorites (created_at);
+CREATE INDEX IF NOT EXISTS idx_ratings_created_at ON ratings (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ratings_created_at;
+DROP INDEX IF EXISTS idx_favorites_created_at;
+DROP TABLE IF EXISTS recipe_view_daily;
+DROP TABLE IF EXISTS recipe_view_hourly;
+DROP TABLE IF EXISTS recipe_view_keys;
+-- +goose StatementEnd
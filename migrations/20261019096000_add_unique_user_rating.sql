@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- เก็บ rating ล่าสุดของแต่ละคนไว้ ที่เหลือลบแบบ soft delete
+UPDATE ratings
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id IN (
+    SELECT id
+    FROM (
+        SELECT
+            id,
+            ROW_NUMBER() OVER (
+                PARTITION BY food_recipe_id, user_id
+                ORDER BY updated_at DESC, id DESC
+            ) AS position
+        FROM ratings
+        WHERE deleted_at IS NULL
+    ) AS ranked
+    WHERE position > 1
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ratings_food_recipe_id_user_id ON ratings (food_recipe_id, user_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ratings_food_recipe_id_user_id;
+-- +goose StatementEnd
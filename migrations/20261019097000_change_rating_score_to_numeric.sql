@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- คะแนน 1 ถึง 5 ทีละครึ่งดาว ปัดค่าเดิมที่หลุดช่วงให้อยู่ในช่วงก่อนเพิ่ม CHECK
+UPDATE ratings SET score = LEAST(GREATEST(score, 1), 5);
+
+ALTER TABLE ratings ALTER COLUMN score TYPE NUMERIC(2, 1);
+
+ALTER TABLE ratings
+ADD CONSTRAINT chk_ratings_score CHECK (
+    score BETWEEN 1 AND 5
+    AND score * 2 = TRUNC(score * 2)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE ratings DROP CONSTRAINT IF EXISTS chk_ratings_score;
+
+ALTER TABLE ratings ALTER COLUMN score TYPE INT USING ROUND(score);
+-- +goose StatementEnd
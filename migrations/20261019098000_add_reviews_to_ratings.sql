@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ratings
+ADD COLUMN IF NOT EXISTS title VARCHAR(120) NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS body TEXT NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS photos JSONB NOT NULL DEFAULT '[]',
+ADD COLUMN IF NOT EXISTS cooked_on DATE,
+ADD COLUMN IF NOT EXISTS helpful_count INT NOT NULL DEFAULT 0;
+
+-- รายการรีวิวของสูตรเรียงจากใหม่ไปเก่าเป็นค่าเริ่มต้น
+CREATE INDEX IF NOT EXISTS idx_ratings_food_recipe_id_created_at ON ratings (food_recipe_id, created_at DESC) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ratings_food_recipe_id_created_at;
+
+ALTER TABLE ratings
+DROP COLUMN IF EXISTS helpful_count,
+DROP COLUMN IF EXISTS cooked_on,
+DROP COLUMN IF EXISTS photos,
+DROP COLUMN IF EXISTS body,
+DROP COLUMN IF EXISTS title;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE food_recipes
+ADD COLUMN IF NOT EXISTS rating_count INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_sum NUMERIC(12, 1) NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_star_1 INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_star_2 INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_star_3 INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_star_4 INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_star_5 INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS rating_updated_at TIMESTAMP;
+
+-- ตั้งค่าเริ่มต้นจาก rating ที่มีอยู่ ครึ่งดาวปัดลงแบบเดียวกับ model.RatingStar
+UPDATE food_recipes
+SET rating_count = summary.rating_count,
+    rating_sum = summary.rating_sum,
+    rating_star_1 = summary.rating_star_1,
+    rating_star_2 = summary.rating_star_2,
+    rating_star_3 = summary.rating_star_3,
+    rating_star_4 = summary.rating_star_4,
+    rating_star_5 = summary.rating_star_5,
+    rating_updated_at = summary.rating_updated_at
+FROM (
+    SELECT
+        food_recipe_id,
+        COUNT(*) AS rating_count,
+        SUM(score) AS rating_sum,
+        COUNT(*) FILTER (WHERE FLOOR(score) <= 1) AS rating_star_1,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 2) AS rating_star_2,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 3) AS rating_star_3,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 4) AS rating_star_4,
+        COUNT(*) FILTER (WHERE FLOOR(score) >= 5) AS rating_star_5,
+        MAX(updated_at) AS rating_updated_at
+    FROM ratings
+    WHERE deleted_at IS NULL
+    GROUP BY food_recipe_id
+) AS summary
+WHERE food_recipes.id = summary.food_recipe_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes
+DROP COLUMN IF EXISTS rating_updated_at,
+DROP COLUMN IF EXISTS rating_star_5,
+DROP COLUMN IF EXISTS rating_star_4,
+DROP COLUMN IF EXISTS rating_star_3,
+DROP COLUMN IF EXISTS rating_star_2,
+DROP COLUMN IF EXISTS rating_star_1,
+DROP COLUMN IF EXISTS rating_sum,
+DROP COLUMN IF EXISTS rating_count;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ratings
+ADD COLUMN IF NOT EXISTS unhelpful_count INT NOT NULL DEFAULT 0;
+
+-- ผู้ใช้ 1 คนโหวตได้ 1 ครั้งต่อ rating โหวตซ้ำจะแก้แถวเดิม
+CREATE TABLE IF NOT EXISTS rating_votes (
+    rating_id INT NOT NULL REFERENCES ratings ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (rating_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rating_votes;
+
+ALTER TABLE ratings
+DROP COLUMN IF EXISTS unhelpful_count;
+-- +goose StatementEnd
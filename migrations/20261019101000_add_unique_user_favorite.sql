@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- เก็บ favorite ที่เก่าที่สุดไว้ อันที่ซ้ำถือว่าถูกลบ
+UPDATE favorites
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE deleted_at IS NULL
+AND id NOT IN (
+    SELECT MIN(id)
+    FROM favorites
+    WHERE deleted_at IS NULL
+    GROUP BY user_id, food_recipe_id
+);
+
+-- ผู้ใช้ 1 คน favorite recipe เดียวกันได้ครั้งเดียว แถวที่ลบแล้วไม่นับ
+CREATE UNIQUE INDEX IF NOT EXISTS idx_favorites_user_id_food_recipe_id ON favorites (user_id, food_recipe_id) WHERE deleted_at IS NULL;
+
+-- ใช้นับ favoriteCount ของแต่ละ recipe
+CREATE INDEX IF NOT EXISTS idx_favorites_food_recipe_id ON favorites (food_recipe_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_favorites_food_recipe_id;
+
+DROP INDEX IF EXISTS idx_favorites_user_id_food_recipe_id;
+-- +goose StatementEnd
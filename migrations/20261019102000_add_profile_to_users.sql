@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS bio TEXT NOT NULL DEFAULT '';
+
+-- ค่าเริ่มต้นคือแสดงทุกช่องในโปรไฟล์สาธารณะ
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS hide_bio BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS hide_avatar BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS hide_joined_at BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS hide_recipe_count BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS hide_average_rating BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS hide_favorites_received BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- ใช้ดึง recipe ของผู้ใช้ในหน้าโปรไฟล์
+CREATE INDEX IF NOT EXISTS idx_food_recipes_user_id ON food_recipes (user_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_food_recipes_user_id;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS hide_favorites_received,
+    DROP COLUMN IF EXISTS hide_average_rating,
+    DROP COLUMN IF EXISTS hide_recipe_count,
+    DROP COLUMN IF EXISTS hide_joined_at,
+    DROP COLUMN IF EXISTS hide_avatar,
+    DROP COLUMN IF EXISTS hide_bio,
+    DROP COLUMN IF EXISTS bio;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    followee_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- ใช้นับและแสดงรายชื่อผู้ติดตาม
+CREATE INDEX IF NOT EXISTS idx_follows_followee_id_created_at ON follows (followee_id, created_at);
+
+-- feed อ่านรายการล่าสุดของแต่ละคนที่ติดตาม จึงต้องเรียงตาม created_at ใน index ด้วย
+CREATE INDEX IF NOT EXISTS idx_food_recipes_user_id_created_at ON food_recipes (user_id, created_at, id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_food_recipes_user_id;
+
+CREATE INDEX IF NOT EXISTS idx_ratings_user_id_created_at ON ratings (user_id, created_at, id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ratings_user_id_created_at;
+
+CREATE INDEX IF NOT EXISTS idx_food_recipes_user_id ON food_recipes (user_id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_food_recipes_user_id_created_at;
+
+DROP TABLE IF EXISTS follows;
+-- +goose StatementEnd
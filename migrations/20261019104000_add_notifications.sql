@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notifications (
+    id SERIAL PRIMARY KEY,
+    recipient_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    actor_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('rating', 'favorite', 'comment', 'follow')),
+    food_recipe_id INT REFERENCES food_recipes ON DELETE CASCADE,
+    rating_id INT REFERENCES ratings ON DELETE CASCADE,
+    comment_id INT REFERENCES comments ON DELETE CASCADE,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- รายการของผู้รับ ล่าสุดก่อน
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient_id_created_at ON notifications (recipient_id, created_at DESC, id DESC);
+
+-- นับที่ยังไม่อ่าน
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient_id_unread ON notifications (recipient_id) WHERE read_at IS NULL;
+
+-- ไม่มีแถวคือเปิดรับการแจ้งเตือนประเภทนั้น
+CREATE TABLE IF NOT EXISTS notification_mutes (
+    user_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('rating', 'favorite', 'comment', 'follow')),
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, type)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_mutes;
+
+DROP TABLE IF EXISTS notifications;
+-- +goose StatementEnd
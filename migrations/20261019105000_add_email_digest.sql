@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- มาจาก claim email ของ Keycloak ใช้ส่ง digest
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email VARCHAR(255) NOT NULL DEFAULT '';
+
+-- ไม่มีแถวคือไม่รับ digest
+CREATE TABLE IF NOT EXISTS digest_subscriptions (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    locale VARCHAR(2) NOT NULL DEFAULT 'th' CHECK (locale IN ('th', 'en')),
+    last_sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- หาผู้รับที่ถึงรอบ คนที่ยังไม่เคยได้รับก่อน
+CREATE INDEX IF NOT EXISTS idx_digest_subscriptions_last_sent_at ON digest_subscriptions (last_sent_at NULLS FIRST);
+
+CREATE TABLE IF NOT EXISTS outbox_emails (
+    id SERIAL PRIMARY KEY,
+    recipient_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    to_address VARCHAR(255) NOT NULL,
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMP,
+    failed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- อีเมลที่ยังรอส่ง
+CREATE INDEX IF NOT EXISTS idx_outbox_emails_pending ON outbox_emails (next_attempt_at) WHERE sent_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox_emails;
+
+DROP TABLE IF EXISTS digest_subscriptions;
+
+ALTER TABLE users DROP COLUMN IF EXISTS email;
+-- +goose StatementEnd
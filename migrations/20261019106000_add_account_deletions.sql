@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ผู้ใช้ถูก soft delete ทันทีที่ขอ แถวนี้ถูกลบไปพร้อมผู้ใช้ตอน purge หรือเมื่อ login เพื่อยกเลิก
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    recipes VARCHAR(10) NOT NULL CHECK (recipes IN ('transfer', 'delete')),
+    -- ผู้รับถูก purge ก่อน recipe จะตกเป็นของ placeholder
+    transfer_to_id VARCHAR(100) REFERENCES users ON DELETE SET NULL,
+    purge_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_deletions_purge_at ON account_deletions (purge_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS account_deletions;
+-- +goose StatementEnd
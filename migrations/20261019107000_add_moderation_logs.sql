@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ไม่อ้างอิง users เพื่อให้ log อยู่ต่อได้หลัง purge ซึ่งจะเปลี่ยน id เป็น placeholder
+CREATE TABLE IF NOT EXISTS moderation_logs (
+    id SERIAL PRIMARY KEY,
+    actor_id VARCHAR(100) NOT NULL,
+    roles JSONB NOT NULL DEFAULT '[]',
+    action VARCHAR(20) NOT NULL CHECK (action IN ('recipe_update', 'recipe_delete')),
+    food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+    owner_id VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_logs_food_recipe_id ON moderation_logs (food_recipe_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS moderation_logs;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_blocks (
+    blocker_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    blocked_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+-- ตรวจการ block ทั้งสองทิศทาง
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+CREATE TABLE IF NOT EXISTS user_mutes (
+    muter_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    muted_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (muter_id, muted_id),
+    CHECK (muter_id <> muted_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_mutes;
+
+DROP TABLE IF EXISTS user_blocks;
+-- +goose StatementEnd
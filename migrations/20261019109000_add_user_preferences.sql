@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id VARCHAR(100) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    language VARCHAR(2) NOT NULL CHECK (language IN ('en', 'th')),
+    units VARCHAR(10) NOT NULL CHECK (units IN ('metric', 'imperial')),
+    diets JSONB NOT NULL DEFAULT '[]',
+    disliked_ingredients JSONB NOT NULL DEFAULT '[]',
+    default_sort VARCHAR(10) NOT NULL DEFAULT '' CHECK (default_sort IN ('', 'name', 'rating')),
+    updated_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ผู้ใช้เดิมได้ handle ตอน login ครั้งถัดไป
+ALTER TABLE users ADD COLUMN IF NOT EXISTS handle VARCHAR(30);
+
+-- handle ไม่สนตัวพิมพ์ จึง unique ที่ lower(handle)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_handle ON users (lower(handle));
+
+-- handle เดิมหลังเปลี่ยน เก็บเป็นตัวพิมพ์เล็ก
+CREATE TABLE IF NOT EXISTS handle_redirects (
+    handle VARCHAR(30) PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_handle_redirects_user_id ON handle_redirects (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS handle_redirects;
+
+DROP INDEX IF EXISTS idx_users_handle;
+
+ALTER TABLE users DROP COLUMN IF EXISTS handle;
+-- +goose StatementEnd
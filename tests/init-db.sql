@@ -4,6 +4,17 @@ CREATE TABLE
         id VARCHAR(100) PRIMARY KEY,
         first_name VARCHAR(100) NOT NULL,
         last_name VARCHAR(100) NOT NULL,
+        email VARCHAR(255) NOT NULL DEFAULT '',
+        nick_name VARCHAR(100) NOT NULL DEFAULT '',
+        handle VARCHAR(30),
+        image_url VARCHAR(100),
+        bio TEXT NOT NULL DEFAULT '',
+        hide_bio BOOLEAN NOT NULL DEFAULT FALSE,
+        hide_avatar BOOLEAN NOT NULL DEFAULT FALSE,
+        hide_joined_at BOOLEAN NOT NULL DEFAULT FALSE,
+        hide_recipe_count BOOLEAN NOT NULL DEFAULT FALSE,
+        hide_average_rating BOOLEAN NOT NULL DEFAULT FALSE,
+        hide_favorites_received BOOLEAN NOT NULL DEFAULT FALSE,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
@@ -18,6 +29,13 @@ VALUES
         'Tester',
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP
+    ),
+    (
+        'b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11',
+        'Second',
+        'Rater',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
     );
 
 -- difficulties table
@@ -25,6 +43,9 @@ CREATE TABLE
     IF NOT EXISTS difficulties (
         id SERIAL PRIMARY KEY,
         name VARCHAR(255) NOT NULL,
+        name_th VARCHAR(255) NOT NULL DEFAULT '',
+        sort_order INT NOT NULL DEFAULT 0,
+        retired_at TIMESTAMP NULL,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
@@ -42,18 +63,23 @@ CREATE TABLE
     IF NOT EXISTS cooking_durations (
         id SERIAL PRIMARY KEY,
         name VARCHAR(100) NOT NULL,
+        name_th VARCHAR(100) NOT NULL DEFAULT '',
+        sort_order INT NOT NULL DEFAULT 0,
+        retired_at TIMESTAMP NULL,
+        min_minutes INT NOT NULL DEFAULT 0,
+        max_minutes INT NULL,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
     );
 
 INSERT INTO
-    cooking_durations (name, created_at, updated_at)
+    cooking_durations (name, min_minutes, max_minutes, created_at, updated_at)
 VALUES
-    ('5 - 10', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    ('11 - 30', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    ('31 - 60', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    ('60+', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    ('5 - 10', 5, 10, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('11 - 30', 11, 30, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('31 - 60', 31, 60, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('60+', 61, NULL, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
 
 -- food_recipes table
 CREATE TABLE
@@ -64,9 +90,23 @@ CREATE TABLE
         ingredient TEXT NOT NULL,
         instruction TEXT NOT NULL,
         image_url TEXT NULL,
+        prep_minutes INT NOT NULL DEFAULT 0,
+        cook_minutes INT NOT NULL DEFAULT 0,
+        total_minutes INT NOT NULL DEFAULT 0,
         cooking_duration_id INT NOT NULL REFERENCES cooking_durations,
         difficulty_id INT NOT NULL REFERENCES difficulties,
+        allergens JSONB NOT NULL DEFAULT '[]',
+        diets JSONB NOT NULL DEFAULT '[]',
+        rating_count INT NOT NULL DEFAULT 0,
+        rating_sum NUMERIC(12, 1) NOT NULL DEFAULT 0,
+        rating_star_1 INT NOT NULL DEFAULT 0,
+        rating_star_2 INT NOT NULL DEFAULT 0,
+        rating_star_3 INT NOT NULL DEFAULT 0,
+        rating_star_4 INT NOT NULL DEFAULT 0,
+        rating_star_5 INT NOT NULL DEFAULT 0,
+        rating_updated_at TIMESTAMP,
         user_id VARCHAR(100) REFERENCES users,
+        version INT NOT NULL DEFAULT 1,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
@@ -78,6 +118,8 @@ INSERT INTO
         description,
         ingredient,
         instruction,
+        cook_minutes,
+        total_minutes,
         cooking_duration_id,
         difficulty_id,
         user_id,
@@ -90,6 +132,8 @@ VALUES
         'Eggs fried?',
         'Eggs',
         'Cooking',
+        8,
+        8,
         1,
         1,
         '38fa4e9e-27de-42d5-a70f-9f01d41f32c2',
@@ -102,7 +146,16 @@ CREATE TABLE
     IF NOT EXISTS ratings (
         id SERIAL PRIMARY KEY,
         food_recipe_id INT NOT NULL REFERENCES food_recipes,
-        score INT NOT NULL,
+        score NUMERIC(2, 1) NOT NULL CONSTRAINT chk_ratings_score CHECK (
+            score BETWEEN 1 AND 5
+            AND score * 2 = TRUNC(score * 2)
+        ),
+        title VARCHAR(120) NOT NULL DEFAULT '',
+        body TEXT NOT NULL DEFAULT '',
+        photos JSONB NOT NULL DEFAULT '[]',
+        cooked_on DATE,
+        helpful_count INT NOT NULL DEFAULT 0,
+        unhelpful_count INT NOT NULL DEFAULT 0,
         user_id VARCHAR(100) NOT NULL REFERENCES users,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
@@ -128,7 +181,193 @@ VALUES
     (
         1,
         3,
-        '38fa4e9e-27de-42d5-a70f-9f01d41f32c2',
+        'b3a1c6e2-4f0d-4c8e-9a57-2d1e7f9c0a11',
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP
-    );
\ No newline at end of file
+    );
+
+-- ยอดรวมของ rating ด้านบน
+UPDATE food_recipes
+SET
+    rating_count = 2,
+    rating_sum = 8,
+    rating_star_3 = 1,
+    rating_star_5 = 1,
+    rating_updated_at = CURRENT_TIMESTAMP
+WHERE
+    id = 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ratings_food_recipe_id_user_id ON ratings (food_recipe_id, user_id) WHERE deleted_at IS NULL;
+
+-- rating votes table
+CREATE TABLE
+    IF NOT EXISTS rating_votes (
+        rating_id INT NOT NULL REFERENCES ratings ON DELETE CASCADE,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        helpful BOOLEAN NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (rating_id, user_id)
+    );
+
+-- favorites table
+CREATE TABLE
+    IF NOT EXISTS favorites (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        user_id VARCHAR(255) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_favorites_user_id_food_recipe_id ON favorites (user_id, food_recipe_id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_favorites_food_recipe_id ON favorites (food_recipe_id) WHERE deleted_at IS NULL;
+
+-- recipe views tables
+CREATE TABLE
+    IF NOT EXISTS recipe_view_keys (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        viewer VARCHAR(100) NOT NULL,
+        window_start TIMESTAMPTZ NOT NULL,
+        viewed_at TIMESTAMPTZ NOT NULL,
+        PRIMARY KEY (food_recipe_id, viewer, window_start)
+    );
+
+CREATE TABLE
+    IF NOT EXISTS recipe_view_hourly (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        hour TIMESTAMPTZ NOT NULL,
+        views INT NOT NULL CHECK (views >= 0),
+        PRIMARY KEY (food_recipe_id, hour)
+    );
+
+CREATE TABLE
+    IF NOT EXISTS recipe_view_daily (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        day DATE NOT NULL,
+        views INT NOT NULL CHECK (views >= 0),
+        PRIMARY KEY (food_recipe_id, day)
+    );
+
+-- follows table
+CREATE TABLE
+    IF NOT EXISTS follows (
+        follower_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        followee_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        created_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (follower_id, followee_id),
+        CHECK (follower_id <> followee_id)
+    );
+
+-- notifications tables
+CREATE TABLE
+    IF NOT EXISTS notifications (
+        id SERIAL PRIMARY KEY,
+        recipient_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        actor_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        type VARCHAR(20) NOT NULL CHECK (type IN ('rating', 'favorite', 'comment', 'follow')),
+        food_recipe_id INT REFERENCES food_recipes ON DELETE CASCADE,
+        rating_id INT REFERENCES ratings ON DELETE CASCADE,
+        comment_id INT,
+        read_at TIMESTAMP,
+        created_at TIMESTAMP NOT NULL
+    );
+
+CREATE TABLE
+    IF NOT EXISTS notification_mutes (
+        user_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        type VARCHAR(20) NOT NULL CHECK (type IN ('rating', 'favorite', 'comment', 'follow')),
+        created_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (user_id, type)
+    );
+
+-- email digest tables
+CREATE TABLE
+    IF NOT EXISTS digest_subscriptions (
+        user_id VARCHAR(100) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+        locale VARCHAR(2) NOT NULL DEFAULT 'th' CHECK (locale IN ('th', 'en')),
+        last_sent_at TIMESTAMP,
+        created_at TIMESTAMP NOT NULL
+    );
+
+CREATE TABLE
+    IF NOT EXISTS outbox_emails (
+        id SERIAL PRIMARY KEY,
+        recipient_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        to_address VARCHAR(255) NOT NULL,
+        subject TEXT NOT NULL,
+        text_body TEXT NOT NULL,
+        html_body TEXT NOT NULL,
+        attempts INT NOT NULL DEFAULT 0,
+        next_attempt_at TIMESTAMP NOT NULL,
+        last_error TEXT NOT NULL DEFAULT '',
+        sent_at TIMESTAMP,
+        failed_at TIMESTAMP,
+        created_at TIMESTAMP NOT NULL
+    );
+
+-- account deletions table
+CREATE TABLE
+    IF NOT EXISTS account_deletions (
+        user_id VARCHAR(100) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+        recipes VARCHAR(10) NOT NULL CHECK (recipes IN ('transfer', 'delete')),
+        transfer_to_id VARCHAR(100) REFERENCES users ON DELETE SET NULL,
+        purge_at TIMESTAMP NOT NULL,
+        created_at TIMESTAMP NOT NULL
+    );
+
+-- moderation logs table
+CREATE TABLE
+    IF NOT EXISTS moderation_logs (
+        id SERIAL PRIMARY KEY,
+        actor_id VARCHAR(100) NOT NULL,
+        roles JSONB NOT NULL DEFAULT '[]',
+        action VARCHAR(20) NOT NULL CHECK (action IN ('recipe_update', 'recipe_delete')),
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        owner_id VARCHAR(100) NOT NULL,
+        created_at TIMESTAMP NOT NULL
+    );
+
+-- user blocks and mutes tables
+CREATE TABLE
+    IF NOT EXISTS user_blocks (
+        blocker_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        blocked_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        created_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (blocker_id, blocked_id),
+        CHECK (blocker_id <> blocked_id)
+    );
+
+CREATE TABLE
+    IF NOT EXISTS user_mutes (
+        muter_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        muted_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        created_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (muter_id, muted_id),
+        CHECK (muter_id <> muted_id)
+    );
+
+-- user preferences table
+CREATE TABLE
+    IF NOT EXISTS user_preferences (
+        user_id VARCHAR(100) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+        language VARCHAR(2) NOT NULL CHECK (language IN ('en', 'th')),
+        units VARCHAR(10) NOT NULL CHECK (units IN ('metric', 'imperial')),
+        diets JSONB NOT NULL DEFAULT '[]',
+        disliked_ingredients JSONB NOT NULL DEFAULT '[]',
+        default_sort VARCHAR(10) NOT NULL DEFAULT '' CHECK (default_sort IN ('', 'name', 'rating')),
+        updated_at TIMESTAMP NOT NULL
+    );
+
+-- handles
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_handle ON users (lower(handle));
+
+CREATE TABLE
+    IF NOT EXISTS handle_redirects (
+        handle VARCHAR(30) PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users ON DELETE CASCADE,
+        expires_at TIMESTAMP NOT NULL,
+        created_at TIMESTAMP NOT NULL
+    );